@@ -1,8 +1,14 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"merchant_api/internal/admin/router"
+	"merchant_api/internal/admin/service"
+	"merchant_api/internal/pkg/fieldcrypt"
+	"merchant_api/internal/pkg/jwt"
+	"merchant_api/internal/pkg/payout"
+	"merchant_api/internal/pkg/utils"
 	"merchant_api/pkg/config"
 	"merchant_api/pkg/database"
 	"merchant_api/pkg/logger"
@@ -40,6 +46,32 @@ func main() {
 	}
 	logger.Info("Redis 连接成功")
 
+	// 设置可信代理
+	if err := utils.SetTrustedProxies(cfg.Server.TrustedProxies); err != nil {
+		logger.Fatal(fmt.Sprintf("设置可信代理失败: %v", err))
+	}
+
+	// 加载 JWT 签名密钥，平台运营令牌也由同一组密钥签发
+	if err := jwt.Init(cfg.JWT, cfg.Platform.TokenExpire); err != nil {
+		logger.Fatal(fmt.Sprintf("加载 JWT 密钥失败: %v", err))
+	}
+
+	// 加载敏感字段加密密钥
+	if err := fieldcrypt.Init(cfg.Crypto); err != nil {
+		logger.Fatal(fmt.Sprintf("加载字段加密密钥失败: %v", err))
+	}
+
+	// 初始化出款渠道
+	if err := payout.Init(cfg.Payout); err != nil {
+		logger.Fatal(fmt.Sprintf("初始化出款渠道失败: %v", err))
+	}
+
+	// 定期清理过期的操作日志
+	go service.RunAuditRetention(context.Background())
+
+	// 定期释放过期未扣减的库存预占
+	go service.RunReservationRelease(context.Background())
+
 	// 设置 Gin 模式
 	// gin.SetMode(cfg.Server.Admin.Mode)
 
@@ -3,6 +3,8 @@ package main
 import (
 	"fmt"
 	"merchant_api/internal/app/router"
+	"merchant_api/internal/pkg/jwt"
+	"merchant_api/internal/pkg/utils"
 	"merchant_api/pkg/config"
 	"merchant_api/pkg/database"
 	"merchant_api/pkg/logger"
@@ -40,6 +42,16 @@ func main() {
 	}
 	logger.Info("Redis 连接成功")
 
+	// 设置可信代理
+	if err := utils.SetTrustedProxies(cfg.Server.TrustedProxies); err != nil {
+		logger.Fatal(fmt.Sprintf("设置可信代理失败: %v", err))
+	}
+
+	// 加载 JWT 签名密钥
+	if err := jwt.Init(cfg.JWT); err != nil {
+		logger.Fatal(fmt.Sprintf("加载 JWT 密钥失败: %v", err))
+	}
+
 	// 设置 Gin 模式
 	// gin.SetMode(cfg.Server.App.Mode)
 
@@ -0,0 +1,81 @@
+// operator 创建平台运营账号，用于初始化第一个运营账号（之后可以在运营后台创建）。
+//
+// 密码从标准输入读取，避免出现在命令行历史中：
+//
+//	go run ./cmd/operator -account <账号> -name <姓名>
+//	echo '<密码>' | go run ./cmd/operator -account <账号> -name <姓名>
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"merchant_api/internal/admin/service"
+	"merchant_api/internal/pkg/fieldcrypt"
+	"merchant_api/pkg/config"
+	"merchant_api/pkg/database"
+	"os"
+	"strings"
+)
+
+func main() {
+	var (
+		configPath string
+		account    string
+		realName   string
+	)
+	flag.StringVar(&configPath, "config", "configs/config.yaml", "配置文件路径")
+	flag.StringVar(&account, "account", "", "运营账号（4-20 位）")
+	flag.StringVar(&realName, "name", "", "运营人员姓名")
+	flag.Parse()
+
+	// 与接口的参数校验保持一致
+	if n := len([]rune(account)); n < 4 || n > 20 {
+		fail("请使用 -account 指定 4-20 位的账号")
+	}
+	if n := len([]rune(realName)); n == 0 || n > 16 {
+		fail("请使用 -name 指定姓名（最多 16 个字）")
+	}
+
+	cfg, err := config.LoadConfig(configPath)
+	if err != nil {
+		fail("加载配置失败: %v", err)
+	}
+	if err := fieldcrypt.Init(cfg.Crypto); err != nil {
+		fail("加载加密密钥失败: %v", err)
+	}
+	if err := database.InitMySQL(cfg.Database.MySQL); err != nil {
+		fail("初始化数据库失败: %v", err)
+	}
+
+	fmt.Fprint(os.Stderr, "请输入密码: ")
+	password, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && password == "" {
+		fail("读取密码失败: %v", err)
+	}
+	password = strings.TrimRight(password, "\r\n")
+	if password == "" {
+		fail("密码不能为空")
+	}
+
+	// 操作日志中记录为命令行创建
+	ctx := service.WithAuditActor(context.Background(), service.AuditActor{
+		Account: service.PlatformAuditAccount("cli"),
+		Route:   "cmd/operator",
+	})
+	operator, err := service.NewPlatformOperatorService(ctx).Create(0, &service.CreateOperatorRequest{
+		Account:  account,
+		Password: password,
+		RealName: realName,
+	})
+	if err != nil {
+		fail("%v", err)
+	}
+	fmt.Printf("运营账号创建成功: operator_id=%d account=%s\n", operator.OperatorID, operator.Account)
+}
+
+func fail(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(1)
+}
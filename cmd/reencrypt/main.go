@@ -0,0 +1,310 @@
+// reencrypt 使用当前版本的数据密钥重新加密敏感字段，并重新计算盲索引。
+//
+// 用于：上线字段加密后加密已有的明文数据；轮换数据密钥（新增版本并修改 active_version 后运行，
+// 完成后可以从配置中删除旧版本）；更换盲索引密钥。可以重复运行，已是当前版本的数据不会修改。
+//
+//	go run ./cmd/reencrypt                  重新加密
+//	go run ./cmd/reencrypt -dry-run         只统计需要重新加密的行数
+//	go run ./cmd/reencrypt -genkey          生成新的数据密钥（使用当前主密钥加密）
+//	go run ./cmd/reencrypt -rewrap <主密钥>  使用新的主密钥重新加密配置中的所有密钥
+package main
+
+import (
+	"database/sql"
+	"flag"
+	"fmt"
+	"merchant_api/internal/pkg/fieldcrypt"
+	"merchant_api/pkg/config"
+	"merchant_api/pkg/database"
+	"os"
+	"sort"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// tableSpec 包含加密字段的表
+type tableSpec struct {
+	table        string
+	primaryKey   string
+	columns      []string          // 加密字段
+	blindIndexes map[string]string // 加密字段 -> 盲索引字段
+	nullableHash bool              // 字段为空时盲索引写入 NULL（否则写入空字符串）
+}
+
+var tables = []tableSpec{
+	{
+		table:        "mer_merchant",
+		primaryKey:   "mer_id",
+		columns:      []string{"real_name", "mer_phone"},
+		blindIndexes: map[string]string{"mer_phone": "mer_phone_hash"},
+	},
+	{
+		table:        "mer_merchant_admin",
+		primaryKey:   "merchant_admin_id",
+		columns:      []string{"real_name", "phone"},
+		blindIndexes: map[string]string{"phone": "phone_hash"},
+		nullableHash: true,
+	},
+	{
+		table:      "mer_merchant_payout_account",
+		primaryKey: "account_id",
+		columns:    []string{"holder_name", "account_no", "wallet_address"},
+	},
+	{
+		table:      "mer_merchant_withdrawal",
+		primaryKey: "withdrawal_id",
+		columns:    []string{"holder_name", "account_no", "wallet_address"},
+	},
+	{
+		table:      "mer_merchant_admin_totp",
+		primaryKey: "merchant_admin_id",
+		columns:    []string{"secret"},
+	},
+	{
+		table:      "mer_merchant_api_key",
+		primaryKey: "api_key_id",
+		columns:    []string{"secret"},
+	},
+}
+
+// stats 单个表的处理结果
+type stats struct {
+	scanned int
+	updated int
+	skipped int // 处理期间被其他请求修改，需要重新运行
+}
+
+func main() {
+	var (
+		configPath string
+		batchSize  int
+		dryRun     bool
+		genKey     bool
+		rewrap     string
+	)
+	flag.StringVar(&configPath, "config", "configs/config.yaml", "配置文件路径")
+	flag.IntVar(&batchSize, "batch", 500, "每批处理的行数")
+	flag.BoolVar(&dryRun, "dry-run", false, "只统计需要重新加密的行数，不修改数据")
+	flag.BoolVar(&genKey, "genkey", false, "生成新的数据密钥")
+	flag.StringVar(&rewrap, "rewrap", "", "新的主密钥（base64），使用新主密钥重新加密配置中的所有密钥")
+	flag.Parse()
+
+	cfg, err := config.LoadConfig(configPath)
+	if err != nil {
+		fail("加载配置失败: %v", err)
+	}
+	if err := fieldcrypt.Init(cfg.Crypto); err != nil {
+		fail("加载加密密钥失败: %v", err)
+	}
+
+	switch {
+	case genKey:
+		key, err := fieldcrypt.GenerateKey()
+		if err != nil {
+			fail("%v", err)
+		}
+		fmt.Println(key)
+		return
+	case rewrap != "":
+		printRewrapped(rewrap)
+		return
+	}
+
+	if err := database.InitMySQL(cfg.Database.MySQL); err != nil {
+		fail("初始化数据库失败: %v", err)
+	}
+	db := database.GetDB().Session(&gorm.Session{Logger: logger.Default.LogMode(logger.Warn)})
+
+	failed := false
+	for _, spec := range tables {
+		result, err := process(db, spec, batchSize, dryRun)
+		if err != nil {
+			fmt.Printf("%s: %v\n", spec.table, err)
+			failed = true
+			continue
+		}
+		action := "已更新"
+		if dryRun {
+			action = "需要更新"
+		}
+		fmt.Printf("%s: 扫描 %d 行，%s %d 行，跳过 %d 行\n", spec.table, result.scanned, action, result.updated, result.skipped)
+		if result.skipped > 0 {
+			failed = true
+		}
+	}
+	if failed {
+		fail("部分数据未处理完成，请重新运行")
+	}
+}
+
+// process 按主键分批处理一个表
+func process(db *gorm.DB, spec tableSpec, batchSize int, dryRun bool) (*stats, error) {
+	result := &stats{}
+	var lastID int64
+	for {
+		rows, err := loadBatch(db, spec, lastID, batchSize)
+		if err != nil {
+			return result, err
+		}
+		if len(rows) == 0 {
+			return result, nil
+		}
+
+		for _, row := range rows {
+			lastID = row.id
+			result.scanned++
+
+			updates, err := row.reencrypt(spec)
+			if err != nil {
+				return result, fmt.Errorf("%s=%d: %w", spec.primaryKey, row.id, err)
+			}
+			if len(updates) == 0 {
+				continue
+			}
+			if dryRun {
+				result.updated++
+				continue
+			}
+
+			// 只在数据未被修改时更新，避免覆盖处理期间的新数据
+			query := db.Table(spec.table).Where(spec.primaryKey+" = ?", row.id)
+			for column, value := range row.values {
+				query = query.Where(column+" <=> ?", value)
+			}
+			res := query.Updates(updates)
+			if res.Error != nil {
+				return result, fmt.Errorf("%s=%d: 更新失败: %w", spec.primaryKey, row.id, res.Error)
+			}
+			if res.RowsAffected == 0 {
+				result.skipped++
+				continue
+			}
+			result.updated++
+		}
+	}
+}
+
+// tableRow 一行的原始值（未经过序列化器），NULL 为 nil
+type tableRow struct {
+	id     int64
+	values map[string]interface{}
+}
+
+// loadBatch 读取主键大于 lastID 的一批数据
+func loadBatch(db *gorm.DB, spec tableSpec, lastID int64, batchSize int) ([]*tableRow, error) {
+	columns := append([]string{spec.primaryKey}, spec.columns...)
+	for _, column := range sortedKeys(spec.blindIndexes) {
+		columns = append(columns, spec.blindIndexes[column])
+	}
+
+	rows, err := db.Table(spec.table).
+		Select(columns).
+		Where(spec.primaryKey+" > ?", lastID).
+		Order(spec.primaryKey).
+		Limit(batchSize).
+		Rows()
+	if err != nil {
+		return nil, fmt.Errorf("查询失败: %w", err)
+	}
+	defer rows.Close()
+
+	var list []*tableRow
+	for rows.Next() {
+		var id int64
+		values := make([]sql.NullString, len(columns)-1)
+		dest := []interface{}{&id}
+		for i := range values {
+			dest = append(dest, &values[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, fmt.Errorf("读取失败: %w", err)
+		}
+
+		row := &tableRow{id: id, values: make(map[string]interface{}, len(values))}
+		for i, value := range values {
+			if value.Valid {
+				row.values[columns[i+1]] = value.String
+			} else {
+				row.values[columns[i+1]] = nil
+			}
+		}
+		list = append(list, row)
+	}
+	return list, rows.Err()
+}
+
+// reencrypt 计算需要更新的字段：未使用当前版本加密的字段重新加密，盲索引与明文不一致时重新计算
+func (r *tableRow) reencrypt(spec tableSpec) (map[string]interface{}, error) {
+	updates := make(map[string]interface{})
+	for _, column := range spec.columns {
+		raw, ok := r.values[column].(string)
+		if !ok {
+			continue
+		}
+		plain, err := fieldcrypt.Decrypt(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", column, err)
+		}
+
+		if !fieldcrypt.IsCurrent(raw) {
+			encrypted, err := fieldcrypt.Encrypt(plain)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", column, err)
+			}
+			updates[column] = encrypted
+		}
+
+		if hashColumn, ok := spec.blindIndexes[column]; ok {
+			hash, err := fieldcrypt.BlindIndex(plain)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", column, err)
+			}
+			var value interface{} = hash
+			if hash == "" && spec.nullableHash {
+				value = nil
+			}
+			if r.values[hashColumn] != value {
+				updates[hashColumn] = value
+			}
+		}
+	}
+	return updates, nil
+}
+
+// printRewrapped 输出使用新主密钥加密后的密钥配置
+func printRewrapped(newMasterKey string) {
+	dataKeys, blindIndexKey, err := fieldcrypt.Rewrap(newMasterKey)
+	if err != nil {
+		fail("%v", err)
+	}
+
+	versions := make([]int, 0, len(dataKeys))
+	for version := range dataKeys {
+		versions = append(versions, version)
+	}
+	sort.Ints(versions)
+
+	var b strings.Builder
+	b.WriteString("data_keys:\n")
+	for _, version := range versions {
+		fmt.Fprintf(&b, "  - version: %d\n    key: %s\n", version, dataKeys[version])
+	}
+	fmt.Fprintf(&b, "blind_index_key: %s\n", blindIndexKey)
+	fmt.Print(b.String())
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func fail(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(1)
+}
@@ -1,9 +1,13 @@
 package controller
 
 import (
+	"errors"
 	"merchant_api/internal/admin/service"
+	"merchant_api/internal/pkg/i18n"
+	"merchant_api/internal/pkg/jwt"
 	"merchant_api/internal/pkg/response"
 	"merchant_api/internal/pkg/utils"
+	"net/http"
 
 	"github.com/gin-gonic/gin"
 )
@@ -18,6 +22,10 @@ func NewAdminAuthController() *AdminAuthController {
 type LoginRequest struct {
 	Account  string `json:"account" binding:"required"`  // 账号或手机号
 	Password string `json:"password" binding:"required"` // 密码
+	Device   string `json:"device"`                      // 设备名称（可选，默认根据 User-Agent 识别）
+
+	CaptchaID   string `json:"captcha_id"`   // 验证码ID（多次登录失败后必填）
+	CaptchaCode string `json:"captcha_code"` // 验证码
 }
 
 // Login 管理员登录
@@ -30,14 +38,81 @@ func (ctrl *AdminAuthController) Login(c *gin.Context) {
 		return
 	}
 
-	// 获取客户端 IP
-	ip := utils.GetClientIP(c)
+	// 获取客户端信息
+	client := &service.ClientInfo{
+		IP:        utils.GetClientIP(c),
+		UserAgent: c.GetHeader("User-Agent"),
+		Device:    req.Device,
+	}
 
 	// 调用服务层
 	authService := service.NewAdminAuthService(c.Request.Context())
-	resp, err := authService.Login(req.Account, req.Password, ip)
+	captcha := &service.CaptchaInput{ID: req.CaptchaID, Code: req.CaptchaCode}
+	resp, err := authService.Login(req.Account, req.Password, captcha, client)
 	if err != nil {
+		loginError(c, err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// loginError 登录失败响应，锁定、验证码错误和商户停用返回国际化消息
+func loginError(c *gin.Context, err error) {
+	var locked *service.LoginLockedError
+	switch {
+	case errors.Is(err, service.ErrMerchantSuspended):
+		response.ForbiddenWithKey(c, "error.auth.merchant_suspended")
+	case errors.As(err, &locked):
+		key := "error.auth.account_locked"
+		if locked.IP {
+			key = "error.auth.ip_locked"
+		}
+		response.ErrorWithKey(c, http.StatusTooManyRequests, key, map[string]interface{}{
+			"Minutes": locked.Minutes(),
+		})
+	case errors.Is(err, service.ErrCaptchaRequired):
+		response.ErrorWithData(c, http.StatusUnauthorized, i18n.TSimple(c, "error.auth.captcha_required"), gin.H{"captcha_required": true})
+	case errors.Is(err, service.ErrCaptchaInvalid):
+		response.ErrorWithData(c, http.StatusUnauthorized, i18n.TSimple(c, "error.auth.captcha_invalid"), gin.H{"captcha_required": true})
+	default:
 		response.Error(c, 401, err.Error())
+	}
+}
+
+// Captcha 获取登录验证码
+func (ctrl *AdminAuthController) Captcha(c *gin.Context) {
+	svc := service.NewLoginSecurityService(c.Request.Context())
+	resp, err := svc.GenerateCaptcha()
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.internal")
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// RefreshRequest 刷新令牌请求
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+// Refresh 使用刷新令牌换取新的访问令牌
+func (ctrl *AdminAuthController) Refresh(c *gin.Context) {
+	var req RefreshRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	ip := utils.GetClientIP(c)
+
+	authService := service.NewAdminAuthService(c.Request.Context())
+	resp, err := authService.Refresh(req.RefreshToken, ip)
+	if err != nil {
+		loginError(c, err)
 		return
 	}
 
@@ -65,3 +140,15 @@ func (ctrl *AdminAuthController) Logout(c *gin.Context) {
 
 	response.SuccessWithKey(c, "success.logout", nil)
 }
+
+// JWKS 返回 JWT 验证公钥（RFC 7517 格式，不使用统一响应结构）
+func (ctrl *AdminAuthController) JWKS(c *gin.Context) {
+	ks := jwt.Default()
+	if ks == nil {
+		response.InternalServerErrorWithKey(c, "error.internal")
+		return
+	}
+
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, ks.JWKS())
+}
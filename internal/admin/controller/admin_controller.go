@@ -0,0 +1,222 @@
+package controller
+
+import (
+	"merchant_api/internal/admin/service"
+	"merchant_api/internal/pkg/response"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AdminController struct{}
+
+func NewAdminController() *AdminController {
+	return &AdminController{}
+}
+
+// Create 创建子账号
+func (ctrl *AdminController) Create(c *gin.Context) {
+	var req service.CreateAdminRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	callerID, err := getAdminID(c)
+	if err != nil {
+		response.UnauthorizedWithKey(c, "error.auth.invalid_token")
+		return
+	}
+
+	svc := service.NewAdminService(c.Request.Context())
+	admin, err := svc.Create(int32(callerID), &req, int32(merID))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.admin.create_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.admin.created", admin)
+}
+
+// Update 更新子账号
+func (ctrl *AdminController) Update(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.invalid_id", nil)
+		return
+	}
+
+	var req service.UpdateAdminRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	callerID, err := getAdminID(c)
+	if err != nil {
+		response.UnauthorizedWithKey(c, "error.auth.invalid_token")
+		return
+	}
+
+	svc := service.NewAdminService(c.Request.Context())
+	if err := svc.Update(int32(callerID), int32(id), &req, int32(merID)); err != nil {
+		response.BadRequestWithKey(c, "error.admin.update_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.admin.updated", nil)
+}
+
+// UpdateAdminStatusRequest 更新子账号状态请求
+type UpdateAdminStatusRequest struct {
+	Status *int32 `json:"status" binding:"required,oneof=0 1"`
+}
+
+// UpdateStatus 启用/禁用子账号
+func (ctrl *AdminController) UpdateStatus(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.invalid_id", nil)
+		return
+	}
+
+	var req UpdateAdminStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	callerID, err := getAdminID(c)
+	if err != nil {
+		response.UnauthorizedWithKey(c, "error.auth.invalid_token")
+		return
+	}
+
+	svc := service.NewAdminService(c.Request.Context())
+	if err := svc.UpdateStatus(int32(callerID), int32(id), *req.Status, int32(merID)); err != nil {
+		response.BadRequestWithKey(c, "error.admin.update_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.admin.updated", nil)
+}
+
+// Delete 删除子账号
+func (ctrl *AdminController) Delete(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.invalid_id", nil)
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	callerID, err := getAdminID(c)
+	if err != nil {
+		response.UnauthorizedWithKey(c, "error.auth.invalid_token")
+		return
+	}
+
+	svc := service.NewAdminService(c.Request.Context())
+	if err := svc.Delete(int32(callerID), int32(id), int32(merID)); err != nil {
+		response.BadRequestWithKey(c, "error.admin.delete_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.admin.deleted", nil)
+}
+
+// Get 获取子账号详情
+func (ctrl *AdminController) Get(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.invalid_id", nil)
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	svc := service.NewAdminService(c.Request.Context())
+	admin, err := svc.Get(int32(id), int32(merID))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.admin.not_found", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.Success(c, admin)
+}
+
+// List 获取子账号列表
+func (ctrl *AdminController) List(c *gin.Context) {
+	var req service.AdminListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	svc := service.NewAdminService(c.Request.Context())
+	list, total, err := svc.GetList(int32(merID), &req)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.admin.list_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.Success(c, gin.H{
+		"list":      list,
+		"total":     total,
+		"page":      req.Page,
+		"page_size": req.PageSize,
+	})
+}
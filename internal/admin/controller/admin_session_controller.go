@@ -0,0 +1,87 @@
+package controller
+
+import (
+	"errors"
+	"merchant_api/internal/admin/service"
+	"merchant_api/internal/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AdminSessionController struct{}
+
+func NewAdminSessionController() *AdminSessionController {
+	return &AdminSessionController{}
+}
+
+// List 获取当前管理员的会话列表
+func (ctrl *AdminSessionController) List(c *gin.Context) {
+	adminID, err := getAdminID(c)
+	if err != nil {
+		response.UnauthorizedWithKey(c, "error.auth.invalid_token")
+		return
+	}
+
+	svc := service.NewAdminSessionService(c.Request.Context())
+	sessions, err := svc.List(int32(adminID), c.GetString("session_id"))
+	if err != nil {
+		response.InternalServerError(c, err.Error())
+		return
+	}
+
+	response.Success(c, sessions)
+}
+
+// Revoke 吊销指定会话
+func (ctrl *AdminSessionController) Revoke(c *gin.Context) {
+	adminID, err := getAdminID(c)
+	if err != nil {
+		response.UnauthorizedWithKey(c, "error.auth.invalid_token")
+		return
+	}
+
+	svc := service.NewAdminSessionService(c.Request.Context())
+	if err := svc.Revoke(int32(adminID), c.Param("id")); err != nil {
+		response.BadRequestWithKey(c, "error.session.revoke_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.session.revoked", nil)
+}
+
+// RevokeOthers 吊销除当前会话外的所有会话
+func (ctrl *AdminSessionController) RevokeOthers(c *gin.Context) {
+	adminID, err := getAdminID(c)
+	if err != nil {
+		response.UnauthorizedWithKey(c, "error.auth.invalid_token")
+		return
+	}
+
+	svc := service.NewAdminSessionService(c.Request.Context())
+	count, err := svc.RevokeOthers(int32(adminID), c.GetString("session_id"))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.session.revoke_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.session.revoked", gin.H{
+		"revoked": count,
+	})
+}
+
+// Helper function to get admin_id from context safely
+func getAdminID(c *gin.Context) (uint, error) {
+	adminIDValue, exists := c.Get("admin_id")
+	if !exists {
+		return 0, errors.New("管理员ID不存在")
+	}
+	adminIDUint, ok := adminIDValue.(uint)
+	if !ok {
+		return 0, errors.New("管理员ID类型错误")
+	}
+	return adminIDUint, nil
+}
@@ -0,0 +1,94 @@
+package controller
+
+import (
+	"merchant_api/internal/admin/service"
+	"merchant_api/internal/pkg/response"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type APIKeyController struct{}
+
+func NewAPIKeyController() *APIKeyController {
+	return &APIKeyController{}
+}
+
+// Create 创建 API 密钥，secret 只在响应中返回一次
+func (ctrl *APIKeyController) Create(c *gin.Context) {
+	var req service.APIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	adminID, err := getAdminID(c)
+	if err != nil {
+		response.UnauthorizedWithKey(c, "error.auth.invalid_token")
+		return
+	}
+
+	svc := service.NewAPIKeyService(c.Request.Context())
+	resp, err := svc.Create(int32(adminID), int32(merID), &req)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.api_key.create_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.api_key.created", resp)
+}
+
+// List 获取当前商户的 API 密钥列表
+func (ctrl *APIKeyController) List(c *gin.Context) {
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	svc := service.NewAPIKeyService(c.Request.Context())
+	list, err := svc.List(int32(merID))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.api_key.list_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.Success(c, list)
+}
+
+// Revoke 吊销 API 密钥
+func (ctrl *APIKeyController) Revoke(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.invalid_id", nil)
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	svc := service.NewAPIKeyService(c.Request.Context())
+	if err := svc.Revoke(int32(id), int32(merID)); err != nil {
+		response.BadRequestWithKey(c, "error.api_key.revoke_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.api_key.revoked", nil)
+}
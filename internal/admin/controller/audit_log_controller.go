@@ -0,0 +1,47 @@
+package controller
+
+import (
+	"merchant_api/internal/admin/service"
+	"merchant_api/internal/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AuditLogController struct{}
+
+func NewAuditLogController() *AuditLogController {
+	return &AuditLogController{}
+}
+
+// List 查询当前商户的操作日志
+func (ctrl *AuditLogController) List(c *gin.Context) {
+	var req service.AuditLogListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	svc := service.NewAuditService(c.Request.Context())
+	list, total, err := svc.List(int32(merID), &req)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.audit.list_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.Success(c, gin.H{
+		"list":      list,
+		"total":     total,
+		"page":      req.Page,
+		"page_size": req.PageSize,
+	})
+}
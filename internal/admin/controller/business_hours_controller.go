@@ -0,0 +1,145 @@
+package controller
+
+import (
+	"merchant_api/internal/admin/service"
+	"merchant_api/internal/pkg/response"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BusinessHoursController struct{}
+
+func NewBusinessHoursController() *BusinessHoursController {
+	return &BusinessHoursController{}
+}
+
+// Get 获取营业时间
+func (ctrl *BusinessHoursController) Get(c *gin.Context) {
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	svc := service.NewBusinessHoursService(c.Request.Context())
+	schedule, err := svc.Get(int32(merID))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.business_hours.get_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.Success(c, schedule)
+}
+
+// Update 设置时区和每周营业时段
+func (ctrl *BusinessHoursController) Update(c *gin.Context) {
+	var req service.BusinessHoursRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	svc := service.NewBusinessHoursService(c.Request.Context())
+	schedule, err := svc.UpdateWeekly(int32(merID), &req)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.business_hours.update_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.business_hours.updated", schedule)
+}
+
+// Status 查询指定时间是否营业，at 为 RFC3339 时间，默认当前时间
+func (ctrl *BusinessHoursController) Status(c *gin.Context) {
+	at := time.Now()
+	if value := c.Query("at"); value != "" {
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+				"Error": err.Error(),
+			})
+			return
+		}
+		at = parsed
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	svc := service.NewBusinessHoursService(c.Request.Context())
+	open, err := svc.IsOpen(int32(merID), at)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.business_hours.get_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.Success(c, gin.H{
+		"open": open,
+		"at":   at,
+	})
+}
+
+// SetHoliday 设置节假日营业时间
+func (ctrl *BusinessHoursController) SetHoliday(c *gin.Context) {
+	var req service.BusinessHoliday
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	svc := service.NewBusinessHoursService(c.Request.Context())
+	holiday, err := svc.SetHoliday(int32(merID), &req)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.business_hours.holiday_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.business_hours.holiday_set", holiday)
+}
+
+// DeleteHoliday 删除节假日设置
+func (ctrl *BusinessHoursController) DeleteHoliday(c *gin.Context) {
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	svc := service.NewBusinessHoursService(c.Request.Context())
+	if err := svc.DeleteHoliday(int32(merID), c.Param("date")); err != nil {
+		response.BadRequestWithKey(c, "error.business_hours.holiday_delete_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.business_hours.holiday_deleted", nil)
+}
@@ -0,0 +1,243 @@
+package controller
+
+import (
+	"errors"
+	"merchant_api/internal/admin/service"
+	"merchant_api/internal/pkg/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type InventoryController struct{}
+
+func NewInventoryController() *InventoryController {
+	return &InventoryController{}
+}
+
+// List SKU 库存列表
+func (ctrl *InventoryController) List(c *gin.Context) {
+	var req service.InventoryListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	svc := service.NewInventoryService(c.Request.Context())
+	list, total, err := svc.List(int32(merID), &req)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.inventory.list_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.Success(c, gin.H{
+		"list":      list,
+		"total":     total,
+		"page":      req.Page,
+		"page_size": req.PageSize,
+	})
+}
+
+// Movements 库存变动记录
+func (ctrl *InventoryController) Movements(c *gin.Context) {
+	var req service.StockMovementListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	svc := service.NewInventoryService(c.Request.Context())
+	list, total, err := svc.Movements(int32(merID), &req)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.inventory.list_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.Success(c, gin.H{
+		"list":      list,
+		"total":     total,
+		"page":      req.Page,
+		"page_size": req.PageSize,
+	})
+}
+
+// Adjust 盘点调整库存
+func (ctrl *InventoryController) Adjust(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.invalid_id", nil)
+		return
+	}
+
+	var req service.StockAdjustRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	svc := service.NewInventoryService(c.Request.Context())
+	sku, err := svc.Adjust(int32(merID), int32(id), &req)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.inventory.adjust_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.inventory.adjusted", sku)
+}
+
+// SetLowStock 设置低库存预警值
+func (ctrl *InventoryController) SetLowStock(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.invalid_id", nil)
+		return
+	}
+
+	var req service.LowStockRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	svc := service.NewInventoryService(c.Request.Context())
+	if err := svc.SetLowStock(int32(merID), int32(id), *req.LowStock); err != nil {
+		response.BadRequestWithKey(c, "error.inventory.adjust_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.inventory.low_stock_updated", nil)
+}
+
+// Reservations 查询订单的库存预占
+func (ctrl *InventoryController) Reservations(c *gin.Context) {
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	svc := service.NewInventoryService(c.Request.Context())
+	list, err := svc.Reservations(int32(merID), c.Param("order_no"))
+	if err != nil {
+		ctrl.reservationError(c, err)
+		return
+	}
+
+	response.Success(c, list)
+}
+
+// Reserve 订单预占库存
+func (ctrl *InventoryController) Reserve(c *gin.Context) {
+	var req service.StockReserveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	svc := service.NewInventoryService(c.Request.Context())
+	list, err := svc.Reserve(int32(merID), &req)
+	if err != nil {
+		ctrl.reservationError(c, err)
+		return
+	}
+
+	response.SuccessWithKey(c, "success.inventory.reserved", list)
+}
+
+// Commit 订单扣减预占的库存
+func (ctrl *InventoryController) Commit(c *gin.Context) {
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	svc := service.NewInventoryService(c.Request.Context())
+	list, err := svc.Commit(int32(merID), c.Param("order_no"))
+	if err != nil {
+		ctrl.reservationError(c, err)
+		return
+	}
+
+	response.SuccessWithKey(c, "success.inventory.committed", list)
+}
+
+// Release 订单释放预占的库存
+func (ctrl *InventoryController) Release(c *gin.Context) {
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	svc := service.NewInventoryService(c.Request.Context())
+	list, err := svc.Release(int32(merID), c.Param("order_no"), "订单取消")
+	if err != nil {
+		ctrl.reservationError(c, err)
+		return
+	}
+
+	response.SuccessWithKey(c, "success.inventory.released", list)
+}
+
+// reservationError 库存不足返回业务码 409，预占不存在返回 404，便于下单方区分处理
+func (ctrl *InventoryController) reservationError(c *gin.Context, err error) {
+	data := map[string]interface{}{"Error": err.Error()}
+	switch {
+	case errors.Is(err, service.ErrInsufficientStock):
+		response.ErrorWithKey(c, http.StatusConflict, "error.inventory.insufficient_stock", data)
+	case errors.Is(err, service.ErrReservationNotFound):
+		response.NotFoundWithKey(c, "error.inventory.reservation_not_found")
+	default:
+		response.BadRequestWithKey(c, "error.inventory.reservation_failed", data)
+	}
+}
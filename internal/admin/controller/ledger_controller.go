@@ -0,0 +1,67 @@
+package controller
+
+import (
+	"merchant_api/internal/admin/service"
+	"merchant_api/internal/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+type LedgerController struct{}
+
+func NewLedgerController() *LedgerController {
+	return &LedgerController{}
+}
+
+// List 查询当前商户的资金流水
+func (ctrl *LedgerController) List(c *gin.Context) {
+	var req service.LedgerListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	svc := service.NewLedgerService(c.Request.Context())
+	list, total, err := svc.List(int32(merID), &req)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.ledger.list_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.Success(c, gin.H{
+		"list":      list,
+		"total":     total,
+		"page":      req.Page,
+		"page_size": req.PageSize,
+	})
+}
+
+// Check 对账：按资金流水重新计算余额并与商户余额比较
+func (ctrl *LedgerController) Check(c *gin.Context) {
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	svc := service.NewLedgerService(c.Request.Context())
+	result, err := svc.Check(int32(merID))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.ledger.check_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.Success(c, result)
+}
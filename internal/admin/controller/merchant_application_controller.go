@@ -0,0 +1,114 @@
+package controller
+
+import (
+	"merchant_api/internal/admin/service"
+	"merchant_api/internal/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+// applicationTokenHeader 申请人访问令牌请求头，令牌在创建申请时返回
+const applicationTokenHeader = "X-Application-Token"
+
+// MerchantApplicationController 商户入驻申请（申请人，无需登录）
+type MerchantApplicationController struct{}
+
+func NewMerchantApplicationController() *MerchantApplicationController {
+	return &MerchantApplicationController{}
+}
+
+// Create 创建入驻申请草稿
+func (ctrl *MerchantApplicationController) Create(c *gin.Context) {
+	var req service.CreateApplicationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	svc := service.NewMerchantApplicationService(c.Request.Context())
+	resp, err := svc.Create(&req)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.application.create_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.application.created", resp)
+}
+
+// Get 查看入驻申请及状态记录
+func (ctrl *MerchantApplicationController) Get(c *gin.Context) {
+	svc := service.NewMerchantApplicationService(c.Request.Context())
+	detail, err := svc.GetByToken(c.GetHeader(applicationTokenHeader))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.application.get_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.Success(c, detail)
+}
+
+// Update 保存入驻申请
+func (ctrl *MerchantApplicationController) Update(c *gin.Context) {
+	var req service.ApplicationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	svc := service.NewMerchantApplicationService(c.Request.Context())
+	detail, err := svc.Update(c.GetHeader(applicationTokenHeader), &req)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.application.save_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.application.saved", detail)
+}
+
+// UploadDocument 上传资质文件
+func (ctrl *MerchantApplicationController) UploadDocument(c *gin.Context) {
+	file, err := c.FormFile("file")
+	if err != nil {
+		response.BadRequestWithKey(c, "error.upload.file_retrieval_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	svc := service.NewMerchantApplicationService(c.Request.Context())
+	path, err := svc.UploadDocument(c.GetHeader(applicationTokenHeader), file)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.upload.failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.Success(c, gin.H{
+		"path": path,
+	})
+}
+
+// Submit 提交入驻申请
+func (ctrl *MerchantApplicationController) Submit(c *gin.Context) {
+	svc := service.NewMerchantApplicationService(c.Request.Context())
+	detail, err := svc.Submit(c.GetHeader(applicationTokenHeader))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.application.submit_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.application.submitted", detail)
+}
@@ -0,0 +1,98 @@
+package controller
+
+import (
+	"merchant_api/internal/admin/service"
+	"merchant_api/internal/pkg/response"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MerchantCategoryController struct{}
+
+func NewMerchantCategoryController() *MerchantCategoryController {
+	return &MerchantCategoryController{}
+}
+
+// List 商户分类列表
+func (ctrl *MerchantCategoryController) List(c *gin.Context) {
+	svc := service.NewMerchantCategoryService(c.Request.Context())
+	list, err := svc.List()
+	if err != nil {
+		response.BadRequestWithKey(c, "error.merchant_category.list_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.Success(c, list)
+}
+
+// Create 新增商户分类
+func (ctrl *MerchantCategoryController) Create(c *gin.Context) {
+	var req service.MerchantCategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	svc := service.NewMerchantCategoryService(c.Request.Context())
+	category, err := svc.Create(&req)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.merchant_category.create_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.merchant_category.created", category)
+}
+
+// Update 修改商户分类
+func (ctrl *MerchantCategoryController) Update(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.invalid_id", nil)
+		return
+	}
+
+	var req service.MerchantCategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	svc := service.NewMerchantCategoryService(c.Request.Context())
+	category, err := svc.Update(int32(id), &req)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.merchant_category.update_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.merchant_category.updated", category)
+}
+
+// Delete 删除商户分类
+func (ctrl *MerchantCategoryController) Delete(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.invalid_id", nil)
+		return
+	}
+
+	svc := service.NewMerchantCategoryService(c.Request.Context())
+	if err := svc.Delete(int32(id)); err != nil {
+		response.BadRequestWithKey(c, "error.merchant_category.delete_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.merchant_category.deleted", nil)
+}
@@ -0,0 +1,62 @@
+package controller
+
+import (
+	"merchant_api/internal/admin/service"
+	"merchant_api/internal/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MerchantController struct{}
+
+func NewMerchantController() *MerchantController {
+	return &MerchantController{}
+}
+
+// Profile 获取店铺资料
+func (ctrl *MerchantController) Profile(c *gin.Context) {
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	svc := service.NewMerchantService(c.Request.Context())
+	merchant, err := svc.GetProfile(int32(merID))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.merchant.profile_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.Success(c, merchant)
+}
+
+// UpdateProfile 更新店铺资料
+func (ctrl *MerchantController) UpdateProfile(c *gin.Context) {
+	var req service.MerchantProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	svc := service.NewMerchantService(c.Request.Context())
+	merchant, err := svc.UpdateProfile(int32(merID), &req)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.merchant.update_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.merchant.profile_updated", merchant)
+}
@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"merchant_api/internal/admin/service"
+	"merchant_api/internal/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MerchantSecurityController struct{}
+
+func NewMerchantSecurityController() *MerchantSecurityController {
+	return &MerchantSecurityController{}
+}
+
+// Get 获取商户安全设置
+func (ctrl *MerchantSecurityController) Get(c *gin.Context) {
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	svc := service.NewMerchantSecurityService(c.Request.Context())
+	setting, err := svc.Get(int32(merID))
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.internal")
+		return
+	}
+
+	response.Success(c, setting)
+}
+
+// Update 更新商户安全设置（仅商户主账号）
+func (ctrl *MerchantSecurityController) Update(c *gin.Context) {
+	var req service.MerchantSecurityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	callerID, err := getAdminID(c)
+	if err != nil {
+		response.UnauthorizedWithKey(c, "error.auth.invalid_token")
+		return
+	}
+
+	svc := service.NewMerchantSecurityService(c.Request.Context())
+	setting, err := svc.Update(int32(callerID), int32(merID), &req)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.merchant_security.update_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.merchant_security.updated", setting)
+}
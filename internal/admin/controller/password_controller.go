@@ -0,0 +1,114 @@
+package controller
+
+import (
+	"merchant_api/internal/admin/service"
+	"merchant_api/internal/pkg/response"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PasswordController struct{}
+
+func NewPasswordController() *PasswordController {
+	return &PasswordController{}
+}
+
+// ChangePasswordRequest 修改密码请求
+type ChangePasswordRequest struct {
+	OldPassword string `json:"old_password" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required"`
+}
+
+// ChangePassword 修改当前管理员密码，成功后所有会话失效，需要重新登录
+func (ctrl *PasswordController) ChangePassword(c *gin.Context) {
+	var req ChangePasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	adminID, err := getAdminID(c)
+	if err != nil {
+		response.UnauthorizedWithKey(c, "error.auth.invalid_token")
+		return
+	}
+
+	svc := service.NewPasswordService(c.Request.Context())
+	if err := svc.ChangePassword(int32(adminID), int32(merID), req.OldPassword, req.NewPassword); err != nil {
+		response.BadRequestWithKey(c, "error.password.change_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.password.changed", nil)
+}
+
+// IssueResetCode 为子账号生成一次性密码重置码
+func (ctrl *PasswordController) IssueResetCode(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.invalid_id", nil)
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	callerID, err := getAdminID(c)
+	if err != nil {
+		response.UnauthorizedWithKey(c, "error.auth.invalid_token")
+		return
+	}
+
+	svc := service.NewPasswordService(c.Request.Context())
+	resp, err := svc.IssueResetCode(int32(callerID), int32(id), int32(merID))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.password.reset_code_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// ResetPasswordRequest 重置密码请求
+type ResetPasswordRequest struct {
+	Account     string `json:"account" binding:"required"`
+	Code        string `json:"code" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required"`
+}
+
+// ResetPassword 使用重置码设置新密码（无需登录）
+func (ctrl *PasswordController) ResetPassword(c *gin.Context) {
+	var req ResetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	svc := service.NewPasswordService(c.Request.Context())
+	if err := svc.ResetPassword(req.Account, req.Code, req.NewPassword); err != nil {
+		response.BadRequestWithKey(c, "error.password.reset_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.password.reset", nil)
+}
@@ -0,0 +1,128 @@
+package controller
+
+import (
+	"merchant_api/internal/admin/service"
+	"merchant_api/internal/pkg/response"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PayoutAccountController struct{}
+
+func NewPayoutAccountController() *PayoutAccountController {
+	return &PayoutAccountController{}
+}
+
+// List 获取收款账户列表（账号和地址已脱敏）
+func (ctrl *PayoutAccountController) List(c *gin.Context) {
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	svc := service.NewPayoutAccountService(c.Request.Context())
+	list, err := svc.List(int32(merID))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.payout_account.list_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.Success(c, list)
+}
+
+// Create 新增收款账户
+func (ctrl *PayoutAccountController) Create(c *gin.Context) {
+	var req service.PayoutAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	adminID, err := getAdminID(c)
+	if err != nil {
+		response.UnauthorizedWithKey(c, "error.auth.invalid_token")
+		return
+	}
+
+	svc := service.NewPayoutAccountService(c.Request.Context())
+	account, err := svc.Create(int32(adminID), int32(merID), &req)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.payout_account.create_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.payout_account.created", account)
+}
+
+// Update 修改收款账户
+func (ctrl *PayoutAccountController) Update(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.invalid_id", nil)
+		return
+	}
+
+	var req service.PayoutAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	svc := service.NewPayoutAccountService(c.Request.Context())
+	account, err := svc.Update(int32(merID), int32(id), &req)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.payout_account.update_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.payout_account.updated", account)
+}
+
+// Delete 删除收款账户
+func (ctrl *PayoutAccountController) Delete(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.invalid_id", nil)
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	svc := service.NewPayoutAccountService(c.Request.Context())
+	if err := svc.Delete(int32(merID), int32(id)); err != nil {
+		response.BadRequestWithKey(c, "error.payout_account.delete_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.payout_account.deleted", nil)
+}
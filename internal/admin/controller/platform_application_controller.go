@@ -0,0 +1,146 @@
+package controller
+
+import (
+	"merchant_api/internal/admin/service"
+	"merchant_api/internal/pkg/response"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PlatformApplicationController 平台运营审核商户入驻申请
+type PlatformApplicationController struct{}
+
+func NewPlatformApplicationController() *PlatformApplicationController {
+	return &PlatformApplicationController{}
+}
+
+// List 入驻申请列表
+func (ctrl *PlatformApplicationController) List(c *gin.Context) {
+	var req service.ApplicationListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	svc := service.NewMerchantApplicationService(c.Request.Context())
+	list, total, err := svc.List(&req)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.application.list_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.Success(c, gin.H{
+		"list":      list,
+		"total":     total,
+		"page":      req.Page,
+		"page_size": req.PageSize,
+	})
+}
+
+// Get 入驻申请详情，包含状态记录及审核意见
+func (ctrl *PlatformApplicationController) Get(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.invalid_id", nil)
+		return
+	}
+
+	svc := service.NewMerchantApplicationService(c.Request.Context())
+	detail, err := svc.Get(int32(id))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.application.get_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.Success(c, detail)
+}
+
+// Document 下载资质文件，index 为 documents 中的序号（从 0 开始）
+func (ctrl *PlatformApplicationController) Document(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.invalid_id", nil)
+		return
+	}
+	index, err := strconv.Atoi(c.Param("index"))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.invalid_id", nil)
+		return
+	}
+
+	svc := service.NewMerchantApplicationService(c.Request.Context())
+	file, err := svc.DocumentFile(int32(id), index)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.application.document_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	c.File(file)
+}
+
+// StartReview 开始审核
+func (ctrl *PlatformApplicationController) StartReview(c *gin.Context) {
+	ctrl.review(c, "success.application.review_started", func(svc *service.MerchantApplicationService, operatorID, id int32, remark string) (*service.ApplicationDetail, error) {
+		return svc.StartReview(operatorID, id)
+	})
+}
+
+// Comment 添加审核意见
+func (ctrl *PlatformApplicationController) Comment(c *gin.Context) {
+	ctrl.review(c, "success.application.commented", (*service.MerchantApplicationService).Comment)
+}
+
+// Approve 审核通过，创建商户及主账号
+func (ctrl *PlatformApplicationController) Approve(c *gin.Context) {
+	ctrl.review(c, "success.application.approved", (*service.MerchantApplicationService).Approve)
+}
+
+// Reject 驳回
+func (ctrl *PlatformApplicationController) Reject(c *gin.Context) {
+	ctrl.review(c, "success.application.rejected", (*service.MerchantApplicationService).Reject)
+}
+
+func (ctrl *PlatformApplicationController) review(c *gin.Context, successKey string,
+	action func(svc *service.MerchantApplicationService, operatorID, id int32, remark string) (*service.ApplicationDetail, error)) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.invalid_id", nil)
+		return
+	}
+
+	var req service.ApplicationReviewRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+				"Error": err.Error(),
+			})
+			return
+		}
+	}
+
+	operatorID, err := getOperatorID(c)
+	if err != nil {
+		response.UnauthorizedWithKey(c, "error.auth.invalid_token")
+		return
+	}
+
+	svc := service.NewMerchantApplicationService(c.Request.Context())
+	detail, err := action(svc, int32(operatorID), int32(id), req.Remark)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.application.review_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, successKey, detail)
+}
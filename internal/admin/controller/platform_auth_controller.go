@@ -0,0 +1,76 @@
+package controller
+
+import (
+	"errors"
+	"merchant_api/internal/admin/service"
+	"merchant_api/internal/pkg/response"
+	"merchant_api/internal/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PlatformAuthController struct{}
+
+func NewPlatformAuthController() *PlatformAuthController {
+	return &PlatformAuthController{}
+}
+
+// PlatformLoginRequest 运营登录请求
+type PlatformLoginRequest struct {
+	Account  string `json:"account" binding:"required"`
+	Password string `json:"password" binding:"required"`
+
+	CaptchaID   string `json:"captcha_id"`   // 验证码ID（多次登录失败后必填）
+	CaptchaCode string `json:"captcha_code"` // 验证码
+}
+
+// Login 运营登录
+func (ctrl *PlatformAuthController) Login(c *gin.Context) {
+	var req PlatformLoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	svc := service.NewPlatformAuthService(c.Request.Context())
+	captcha := &service.CaptchaInput{ID: req.CaptchaID, Code: req.CaptchaCode}
+	resp, err := svc.Login(req.Account, req.Password, captcha, utils.GetClientIP(c))
+	if err != nil {
+		loginError(c, err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// Logout 运营登出
+func (ctrl *PlatformAuthController) Logout(c *gin.Context) {
+	authHeader := c.GetHeader("Authorization")
+	if authHeader == "" || len(authHeader) < 8 {
+		response.UnauthorizedWithKey(c, "error.auth.no_credentials")
+		return
+	}
+
+	svc := service.NewPlatformAuthService(c.Request.Context())
+	if err := svc.Logout(authHeader[7:]); err != nil {
+		response.Error(c, 500, err.Error())
+		return
+	}
+
+	response.SuccessWithKey(c, "success.logout", nil)
+}
+
+// getOperatorID 从上下文获取运营账号ID
+func getOperatorID(c *gin.Context) (uint, error) {
+	value, exists := c.Get("operator_id")
+	if !exists {
+		return 0, errors.New("运营账号ID不存在")
+	}
+	operatorID, ok := value.(uint)
+	if !ok {
+		return 0, errors.New("运营账号ID类型错误")
+	}
+	return operatorID, nil
+}
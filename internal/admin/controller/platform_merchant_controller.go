@@ -0,0 +1,255 @@
+package controller
+
+import (
+	"merchant_api/internal/admin/service"
+	"merchant_api/internal/pkg/response"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PlatformMerchantController struct{}
+
+func NewPlatformMerchantController() *PlatformMerchantController {
+	return &PlatformMerchantController{}
+}
+
+// List 商户列表
+func (ctrl *PlatformMerchantController) List(c *gin.Context) {
+	var req service.PlatformMerchantListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	svc := service.NewPlatformMerchantService(c.Request.Context())
+	list, total, err := svc.List(&req)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.platform_merchant.list_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.Success(c, gin.H{
+		"list":      list,
+		"total":     total,
+		"page":      req.Page,
+		"page_size": req.PageSize,
+	})
+}
+
+// Get 商户详情
+func (ctrl *PlatformMerchantController) Get(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.invalid_id", nil)
+		return
+	}
+
+	svc := service.NewPlatformMerchantService(c.Request.Context())
+	merchant, err := svc.Get(int32(id))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.platform_merchant.get_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.Success(c, merchant)
+}
+
+// Create 创建商户（待审核）
+func (ctrl *PlatformMerchantController) Create(c *gin.Context) {
+	var req service.CreateMerchantRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	svc := service.NewPlatformMerchantService(c.Request.Context())
+	merchant, err := svc.Create(&req)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.platform_merchant.create_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.platform_merchant.created", merchant)
+}
+
+// Update 修改商户信息及分类
+func (ctrl *PlatformMerchantController) Update(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.invalid_id", nil)
+		return
+	}
+
+	var req service.UpdateMerchantRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	svc := service.NewPlatformMerchantService(c.Request.Context())
+	merchant, err := svc.Update(int32(id), &req)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.platform_merchant.update_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.platform_merchant.updated", merchant)
+}
+
+// Approve 审核通过
+func (ctrl *PlatformMerchantController) Approve(c *gin.Context) {
+	ctrl.audit(c, true)
+}
+
+// Reject 审核驳回
+func (ctrl *PlatformMerchantController) Reject(c *gin.Context) {
+	ctrl.audit(c, false)
+}
+
+func (ctrl *PlatformMerchantController) audit(c *gin.Context, approve bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.invalid_id", nil)
+		return
+	}
+
+	var req service.MerchantAuditRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	operatorID, err := getOperatorID(c)
+	if err != nil {
+		response.UnauthorizedWithKey(c, "error.auth.invalid_token")
+		return
+	}
+
+	svc := service.NewPlatformMerchantService(c.Request.Context())
+	successKey := "success.platform_merchant.approved"
+	audit := svc.Approve
+	if !approve {
+		successKey = "success.platform_merchant.rejected"
+		audit = svc.Reject
+	}
+	merchant, err := audit(int32(operatorID), int32(id), &req)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.platform_merchant.audit_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, successKey, merchant)
+}
+
+// Lock 锁定商户
+func (ctrl *PlatformMerchantController) Lock(c *gin.Context) {
+	ctrl.setLocked(c, true)
+}
+
+// Unlock 解锁商户
+func (ctrl *PlatformMerchantController) Unlock(c *gin.Context) {
+	ctrl.setLocked(c, false)
+}
+
+func (ctrl *PlatformMerchantController) setLocked(c *gin.Context, locked bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.invalid_id", nil)
+		return
+	}
+
+	svc := service.NewPlatformMerchantService(c.Request.Context())
+	merchant, err := svc.SetLocked(int32(id), locked)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.platform_merchant.update_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	successKey := "success.platform_merchant.unlocked"
+	if locked {
+		successKey = "success.platform_merchant.locked"
+	}
+	response.SuccessWithKey(c, successKey, merchant)
+}
+
+// CreateOwner 创建商户主账号（level 0，拥有全部权限）
+func (ctrl *PlatformMerchantController) CreateOwner(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.invalid_id", nil)
+		return
+	}
+
+	var req service.CreateOwnerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	svc := service.NewAdminService(c.Request.Context())
+	admin, err := svc.CreateOwner(int32(id), &req)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.platform_merchant.owner_create_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.platform_merchant.owner_created", admin)
+}
+
+// Admins 商户的管理员列表
+func (ctrl *PlatformMerchantController) Admins(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.invalid_id", nil)
+		return
+	}
+
+	var req service.AdminListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	svc := service.NewAdminService(c.Request.Context())
+	list, total, err := svc.GetList(int32(id), &req)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.admin.list_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.Success(c, gin.H{
+		"list":      list,
+		"total":     total,
+		"page":      req.Page,
+		"page_size": req.PageSize,
+	})
+}
@@ -0,0 +1,117 @@
+package controller
+
+import (
+	"merchant_api/internal/admin/service"
+	"merchant_api/internal/pkg/response"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PlatformOperatorController struct{}
+
+func NewPlatformOperatorController() *PlatformOperatorController {
+	return &PlatformOperatorController{}
+}
+
+// List 运营账号列表
+func (ctrl *PlatformOperatorController) List(c *gin.Context) {
+	svc := service.NewPlatformOperatorService(c.Request.Context())
+	list, err := svc.List()
+	if err != nil {
+		response.BadRequestWithKey(c, "error.operator.list_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.Success(c, list)
+}
+
+// Create 创建运营账号
+func (ctrl *PlatformOperatorController) Create(c *gin.Context) {
+	var req service.CreateOperatorRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	callerID, err := getOperatorID(c)
+	if err != nil {
+		response.UnauthorizedWithKey(c, "error.auth.invalid_token")
+		return
+	}
+
+	svc := service.NewPlatformOperatorService(c.Request.Context())
+	operator, err := svc.Create(int32(callerID), &req)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.operator.create_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.operator.created", operator)
+}
+
+// UpdateStatus 启用/禁用运营账号
+func (ctrl *PlatformOperatorController) UpdateStatus(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.invalid_id", nil)
+		return
+	}
+
+	var req UpdateAdminStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	callerID, err := getOperatorID(c)
+	if err != nil {
+		response.UnauthorizedWithKey(c, "error.auth.invalid_token")
+		return
+	}
+
+	svc := service.NewPlatformOperatorService(c.Request.Context())
+	if err := svc.UpdateStatus(int32(callerID), int32(id), *req.Status); err != nil {
+		response.BadRequestWithKey(c, "error.operator.update_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.operator.updated", nil)
+}
+
+// ChangePassword 修改当前运营账号的密码，修改后需要重新登录
+func (ctrl *PlatformOperatorController) ChangePassword(c *gin.Context) {
+	var req service.OperatorPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	operatorID, err := getOperatorID(c)
+	if err != nil {
+		response.UnauthorizedWithKey(c, "error.auth.invalid_token")
+		return
+	}
+
+	svc := service.NewPlatformOperatorService(c.Request.Context())
+	if err := svc.ChangePassword(int32(operatorID), &req); err != nil {
+		response.BadRequestWithKey(c, "error.password.change_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.password.changed", nil)
+}
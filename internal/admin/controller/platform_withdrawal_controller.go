@@ -0,0 +1,136 @@
+package controller
+
+import (
+	"merchant_api/internal/admin/service"
+	"merchant_api/internal/pkg/response"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PlatformWithdrawalController struct{}
+
+func NewPlatformWithdrawalController() *PlatformWithdrawalController {
+	return &PlatformWithdrawalController{}
+}
+
+// WithdrawalReviewRequest 提现审核请求
+type WithdrawalReviewRequest struct {
+	Remark string `json:"remark" binding:"max=255"`
+}
+
+// List 查询全部商户的提现记录，可按 mer_id 筛选
+func (ctrl *PlatformWithdrawalController) List(c *gin.Context) {
+	var req service.WithdrawalListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	svc := service.NewWithdrawalService(c.Request.Context())
+	list, total, err := svc.List(0, &req)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.withdrawal.list_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.Success(c, gin.H{
+		"list":      list,
+		"total":     total,
+		"page":      req.Page,
+		"page_size": req.PageSize,
+	})
+}
+
+// Get 获取提现详情
+func (ctrl *PlatformWithdrawalController) Get(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.invalid_id", nil)
+		return
+	}
+
+	svc := service.NewWithdrawalService(c.Request.Context())
+	withdrawal, err := svc.Get(0, int32(id))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.withdrawal.get_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.Success(c, withdrawal)
+}
+
+// Approve 审核通过
+func (ctrl *PlatformWithdrawalController) Approve(c *gin.Context) {
+	ctrl.review(c, true)
+}
+
+// Reject 审核驳回，冻结金额退回可用余额
+func (ctrl *PlatformWithdrawalController) Reject(c *gin.Context) {
+	ctrl.review(c, false)
+}
+
+func (ctrl *PlatformWithdrawalController) review(c *gin.Context, approve bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.invalid_id", nil)
+		return
+	}
+
+	var req WithdrawalReviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	operatorID, err := getOperatorID(c)
+	if err != nil {
+		response.UnauthorizedWithKey(c, "error.auth.invalid_token")
+		return
+	}
+
+	svc := service.NewWithdrawalService(c.Request.Context())
+	successKey := "success.withdrawal.approved"
+	review := svc.Approve
+	if !approve {
+		successKey = "success.withdrawal.rejected"
+		review = svc.Reject
+	}
+	withdrawal, err := review(int32(operatorID), int32(id), req.Remark)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.withdrawal.review_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, successKey, withdrawal)
+}
+
+// Submit 将已审核的提现提交到出款渠道
+func (ctrl *PlatformWithdrawalController) Submit(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.invalid_id", nil)
+		return
+	}
+
+	svc := service.NewWithdrawalService(c.Request.Context())
+	withdrawal, err := svc.Submit(int32(id))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.withdrawal.submit_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.withdrawal.submitted", withdrawal)
+}
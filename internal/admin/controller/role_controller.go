@@ -0,0 +1,226 @@
+package controller
+
+import (
+	"merchant_api/internal/admin/service"
+	"merchant_api/internal/pkg/response"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RoleController struct{}
+
+func NewRoleController() *RoleController {
+	return &RoleController{}
+}
+
+// Create 创建角色
+func (ctrl *RoleController) Create(c *gin.Context) {
+	var req service.RoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	callerID, err := getAdminID(c)
+	if err != nil {
+		response.UnauthorizedWithKey(c, "error.auth.invalid_token")
+		return
+	}
+
+	svc := service.NewRoleService(c.Request.Context())
+	role, err := svc.Create(int32(callerID), &req, int32(merID))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.role.create_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.role.created", role)
+}
+
+// Update 更新角色
+func (ctrl *RoleController) Update(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.invalid_id", nil)
+		return
+	}
+
+	var req service.RoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	callerID, err := getAdminID(c)
+	if err != nil {
+		response.UnauthorizedWithKey(c, "error.auth.invalid_token")
+		return
+	}
+
+	svc := service.NewRoleService(c.Request.Context())
+	if err := svc.Update(int32(callerID), int32(id), &req, int32(merID)); err != nil {
+		response.BadRequestWithKey(c, "error.role.update_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.role.updated", nil)
+}
+
+// Delete 删除角色
+func (ctrl *RoleController) Delete(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.invalid_id", nil)
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	svc := service.NewRoleService(c.Request.Context())
+	if err := svc.Delete(int32(id), int32(merID)); err != nil {
+		response.BadRequestWithKey(c, "error.role.delete_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.role.deleted", nil)
+}
+
+// Get 获取角色详情
+func (ctrl *RoleController) Get(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.invalid_id", nil)
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	svc := service.NewRoleService(c.Request.Context())
+	role, err := svc.Get(int32(id), int32(merID))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.role.not_found", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.Success(c, role)
+}
+
+// List 获取角色列表
+func (ctrl *RoleController) List(c *gin.Context) {
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	svc := service.NewRoleService(c.Request.Context())
+	list, err := svc.GetList(int32(merID))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.role.list_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.Success(c, list)
+}
+
+// AssignRolesRequest 分配角色请求
+type AssignRolesRequest struct {
+	RoleIDs []int32 `json:"role_ids" binding:"required"`
+}
+
+// AssignRoles 为管理员分配角色
+func (ctrl *RoleController) AssignRoles(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.invalid_id", nil)
+		return
+	}
+
+	var req AssignRolesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	callerID, err := getAdminID(c)
+	if err != nil {
+		response.UnauthorizedWithKey(c, "error.auth.invalid_token")
+		return
+	}
+
+	svc := service.NewRoleService(c.Request.Context())
+	if err := svc.AssignRoles(int32(callerID), int32(id), req.RoleIDs, int32(merID)); err != nil {
+		response.BadRequestWithKey(c, "error.role.assign_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.role.assigned", nil)
+}
+
+// Permissions 获取可分配的权限目录
+func (ctrl *RoleController) Permissions(c *gin.Context) {
+	response.Success(c, service.PermissionCatalog)
+}
+
+// MyPermissions 获取当前管理员拥有的权限
+func (ctrl *RoleController) MyPermissions(c *gin.Context) {
+	adminID, err := getAdminID(c)
+	if err != nil {
+		response.UnauthorizedWithKey(c, "error.auth.invalid_token")
+		return
+	}
+
+	svc := service.NewPermissionService(c.Request.Context())
+	perms, err := svc.GetAdminPermissions(int32(adminID))
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.internal")
+		return
+	}
+
+	response.Success(c, perms)
+}
@@ -0,0 +1,42 @@
+package controller
+
+import (
+	"merchant_api/internal/admin/service"
+	"merchant_api/internal/pkg/response"
+
+	"github.com/gin-gonic/gin"
+)
+
+type StepUpController struct{}
+
+func NewStepUpController() *StepUpController {
+	return &StepUpController{}
+}
+
+// Verify 敏感操作前重新验证密码（及两步验证码），通过后当前会话在有效期内可以执行敏感操作
+func (ctrl *StepUpController) Verify(c *gin.Context) {
+	var req service.StepUpRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	adminID, err := getAdminID(c)
+	if err != nil {
+		response.UnauthorizedWithKey(c, "error.auth.invalid_token")
+		return
+	}
+
+	svc := service.NewStepUpService(c.Request.Context())
+	resp, err := svc.Verify(int32(adminID), c.GetString("session_id"), &req)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.step_up.failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.step_up.verified", resp)
+}
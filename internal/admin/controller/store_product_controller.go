@@ -2,11 +2,16 @@ package controller
 
 import (
 	"errors"
+	"fmt"
 	"merchant_api/internal/admin/service"
+	"merchant_api/internal/middleware"
 	"merchant_api/internal/pkg/response"
+	"merchant_api/pkg/logger"
+	"net/http"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
 )
 
 type StoreProductController struct{}
@@ -67,6 +72,21 @@ func (ctrl *StoreProductController) Update(c *gin.Context) {
 	}
 
 	svc := service.NewStoreProductService(c.Request.Context())
+
+	// 没有改价权限的管理员不能修改价格
+	if !middleware.HasPermission(c, service.PermProductPrice) {
+		if err := svc.CheckPriceUnchanged(int32(id), &req, int32(merID)); err != nil {
+			if errors.Is(err, service.ErrPriceChangeForbidden) {
+				response.ForbiddenWithKey(c, "error.auth.insufficient_permissions")
+				return
+			}
+			response.BadRequestWithKey(c, "error.product.update_failed", map[string]interface{}{
+				"Error": err.Error(),
+			})
+			return
+		}
+	}
+
 	if err := svc.Update(int32(id), &req, int32(merID)); err != nil {
 		response.BadRequestWithKey(c, "error.product.update_failed", map[string]interface{}{
 			"Error": err.Error(),
@@ -241,6 +261,181 @@ func (ctrl *StoreProductController) UpdateSoldOutStatus(c *gin.Context) {
 	response.SuccessWithKey(c, "success.product.soldout_updated", nil)
 }
 
+// Bulk 批量操作商品
+func (ctrl *StoreProductController) Bulk(c *gin.Context) {
+	var req service.BulkProductRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	// 批量删除、调价还需要对应的单项权限
+	if perm := service.BulkActionPermission(req.Action); perm != "" && !middleware.HasPermission(c, perm) {
+		response.ForbiddenWithKey(c, "error.auth.insufficient_permissions")
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	svc := service.NewStoreProductService(c.Request.Context())
+	result, err := svc.Bulk(int32(merID), &req)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.product.bulk_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.product.bulk_done", result)
+}
+
+// SpecMatrix 按规格组生成全部规格组合
+func (ctrl *StoreProductController) SpecMatrix(c *gin.Context) {
+	var req service.SpecMatrixRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	svc := service.NewStoreProductService(c.Request.Context())
+	list, err := svc.SpecMatrix(&req)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.product.spec_invalid", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.Success(c, list)
+}
+
+// Export 按列表的筛选条件导出商品，format 可选 csv、xlsx、json
+func (ctrl *StoreProductController) Export(c *gin.Context) {
+	var req service.ProductExportRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	c.Header("Content-Type", service.ProductExportContentType(req.Format))
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, service.ProductExportFileName(req.Format)))
+	c.Status(http.StatusOK)
+
+	svc := service.NewStoreProductService(c.Request.Context())
+	if err := svc.Export(int32(merID), &req, c.Writer); err != nil {
+		// 已经开始输出文件时无法再返回错误信息，直接断开连接，
+		// 分块传输没有正常结束，客户端会认为下载失败而不是得到不完整的文件
+		if c.Writer.Written() {
+			logger.Error("导出商品失败", zap.Uint("mer_id", merID), zap.Error(err))
+			c.Abort()
+			if conn, _, herr := c.Writer.Hijack(); herr == nil {
+				conn.Close()
+			}
+			return
+		}
+		c.Writer.Header().Del("Content-Disposition")
+		c.Writer.Header().Del("Content-Type")
+		response.BadRequestWithKey(c, "error.product.export_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+	}
+}
+
+// ImportRequest 商品导入参数
+type ImportRequest struct {
+	DryRun bool `form:"dry_run"` // 只校验不写入
+}
+
+// Import 从 CSV 或 XLSX 文件导入商品
+func (ctrl *StoreProductController) Import(c *gin.Context) {
+	var req ImportRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		response.BadRequestWithKey(c, "error.upload.file_retrieval_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	// 没有改价权限的管理员导入时不能修改已有商品的价格
+	canChangePrice := middleware.HasPermission(c, service.PermProductPrice)
+
+	svc := service.NewStoreProductService(c.Request.Context())
+	result, job, err := svc.Import(int32(merID), file, req.DryRun, canChangePrice)
+	if err != nil {
+		if errors.Is(err, service.ErrProductImportRunning) {
+			response.ErrorWithKey(c, http.StatusConflict, "error.product.import_running", nil)
+			return
+		}
+		response.BadRequestWithKey(c, "error.product.import_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	switch {
+	case job != nil:
+		response.SuccessWithKey(c, "success.product.import_started", job)
+	case req.DryRun:
+		response.SuccessWithKey(c, "success.product.import_validated", result)
+	default:
+		response.SuccessWithKey(c, "success.product.import_done", result)
+	}
+}
+
+// ImportJob 查询后台导入任务进度
+func (ctrl *StoreProductController) ImportJob(c *gin.Context) {
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	svc := service.NewStoreProductService(c.Request.Context())
+	job, err := svc.GetImportJob(int32(merID), c.Param("job_id"))
+	if err != nil {
+		if errors.Is(err, service.ErrProductImportJobNotFound) {
+			response.NotFoundWithKey(c, "error.product.import_job_not_found")
+			return
+		}
+		response.BadRequestWithKey(c, "error.product.import_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.Success(c, job)
+}
+
 // Helper function to get mer_id from context safely
 func getMerID(c *gin.Context) (uint, error) {
 	merIDValue, exists := c.Get("mer_id")
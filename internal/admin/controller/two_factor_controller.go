@@ -0,0 +1,247 @@
+package controller
+
+import (
+	"errors"
+	"merchant_api/internal/admin/service"
+	"merchant_api/internal/pkg/response"
+	"merchant_api/internal/pkg/utils"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TwoFactorController struct{}
+
+func NewTwoFactorController() *TwoFactorController {
+	return &TwoFactorController{}
+}
+
+// TwoFactorChallengeRequest 登录验证挑战请求
+type TwoFactorChallengeRequest struct {
+	ChallengeToken string `json:"challenge_token" binding:"required"`
+}
+
+// TwoFactorVerifyRequest 登录第二步验证请求
+type TwoFactorVerifyRequest struct {
+	ChallengeToken string `json:"challenge_token" binding:"required"`
+	Code           string `json:"code" binding:"required"` // 验证码或恢复码
+}
+
+// TwoFactorCodeRequest 验证码请求
+type TwoFactorCodeRequest struct {
+	Code string `json:"code" binding:"required"`
+}
+
+// TwoFactorDisableRequest 关闭两步验证请求
+type TwoFactorDisableRequest struct {
+	Password string `json:"password" binding:"required"`
+	Code     string `json:"code" binding:"required"` // 验证码或恢复码
+}
+
+// Enroll 登录时绑定：商户要求两步验证但尚未绑定时，获取绑定信息
+func (ctrl *TwoFactorController) Enroll(c *gin.Context) {
+	var req TwoFactorChallengeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	svc := service.NewTwoFactorService(c.Request.Context())
+	resp, err := svc.EnrollChallenge(req.ChallengeToken, utils.GetClientIP(c))
+	if err != nil {
+		response.Error(c, 401, err.Error())
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// Verify 登录第二步：校验验证码后签发令牌
+func (ctrl *TwoFactorController) Verify(c *gin.Context) {
+	var req TwoFactorVerifyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	svc := service.NewTwoFactorService(c.Request.Context())
+	resp, err := svc.VerifyLogin(req.ChallengeToken, req.Code, utils.GetClientIP(c))
+	if err != nil {
+		if errors.Is(err, service.ErrTwoFactorCodeInvalid) {
+			response.UnauthorizedWithKey(c, "error.two_factor.code_invalid")
+			return
+		}
+		loginError(c, err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// Status 获取当前管理员的两步验证状态
+func (ctrl *TwoFactorController) Status(c *gin.Context) {
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	adminID, err := getAdminID(c)
+	if err != nil {
+		response.UnauthorizedWithKey(c, "error.auth.invalid_token")
+		return
+	}
+
+	svc := service.NewTwoFactorService(c.Request.Context())
+	status, err := svc.Status(int32(adminID), int32(merID))
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.internal")
+		return
+	}
+
+	response.Success(c, status)
+}
+
+// Setup 获取绑定信息（密钥和二维码地址）
+func (ctrl *TwoFactorController) Setup(c *gin.Context) {
+	adminID, err := getAdminID(c)
+	if err != nil {
+		response.UnauthorizedWithKey(c, "error.auth.invalid_token")
+		return
+	}
+
+	svc := service.NewTwoFactorService(c.Request.Context())
+	resp, err := svc.Setup(int32(adminID))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.two_factor.setup_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// Enable 使用验证码确认绑定，返回恢复码
+func (ctrl *TwoFactorController) Enable(c *gin.Context) {
+	var req TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	adminID, err := getAdminID(c)
+	if err != nil {
+		response.UnauthorizedWithKey(c, "error.auth.invalid_token")
+		return
+	}
+
+	svc := service.NewTwoFactorService(c.Request.Context())
+	codes, err := svc.Enable(int32(adminID), req.Code)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.two_factor.enable_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.two_factor.enabled", gin.H{"recovery_codes": codes})
+}
+
+// Disable 关闭两步验证
+func (ctrl *TwoFactorController) Disable(c *gin.Context) {
+	var req TwoFactorDisableRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	adminID, err := getAdminID(c)
+	if err != nil {
+		response.UnauthorizedWithKey(c, "error.auth.invalid_token")
+		return
+	}
+
+	svc := service.NewTwoFactorService(c.Request.Context())
+	if err := svc.Disable(int32(adminID), int32(merID), req.Password, req.Code); err != nil {
+		response.BadRequestWithKey(c, "error.two_factor.disable_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.two_factor.disabled", nil)
+}
+
+// RegenerateRecoveryCodes 重新生成恢复码
+func (ctrl *TwoFactorController) RegenerateRecoveryCodes(c *gin.Context) {
+	var req TwoFactorCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	adminID, err := getAdminID(c)
+	if err != nil {
+		response.UnauthorizedWithKey(c, "error.auth.invalid_token")
+		return
+	}
+
+	svc := service.NewTwoFactorService(c.Request.Context())
+	codes, err := svc.RegenerateRecoveryCodes(int32(adminID), req.Code)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.two_factor.recovery_codes_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.Success(c, gin.H{"recovery_codes": codes})
+}
+
+// Reset 重置子账号的两步验证
+func (ctrl *TwoFactorController) Reset(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.invalid_id", nil)
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	callerID, err := getAdminID(c)
+	if err != nil {
+		response.UnauthorizedWithKey(c, "error.auth.invalid_token")
+		return
+	}
+
+	svc := service.NewTwoFactorService(c.Request.Context())
+	if err := svc.Reset(int32(callerID), int32(id), int32(merID)); err != nil {
+		response.BadRequestWithKey(c, "error.two_factor.reset_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.two_factor.reset", nil)
+}
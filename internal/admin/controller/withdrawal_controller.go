@@ -0,0 +1,129 @@
+package controller
+
+import (
+	"merchant_api/internal/admin/service"
+	"merchant_api/internal/pkg/response"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type WithdrawalController struct{}
+
+func NewWithdrawalController() *WithdrawalController {
+	return &WithdrawalController{}
+}
+
+// Create 申请提现
+func (ctrl *WithdrawalController) Create(c *gin.Context) {
+	var req service.WithdrawalRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	callerID, err := getAdminID(c)
+	if err != nil {
+		response.UnauthorizedWithKey(c, "error.auth.invalid_token")
+		return
+	}
+
+	svc := service.NewWithdrawalService(c.Request.Context())
+	withdrawal, err := svc.Create(int32(callerID), int32(merID), &req)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.withdrawal.create_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.SuccessWithKey(c, "success.withdrawal.created", withdrawal)
+}
+
+// List 查询当前商户的提现记录
+func (ctrl *WithdrawalController) List(c *gin.Context) {
+	var req service.WithdrawalListRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	svc := service.NewWithdrawalService(c.Request.Context())
+	list, total, err := svc.List(int32(merID), &req)
+	if err != nil {
+		response.BadRequestWithKey(c, "error.withdrawal.list_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.Success(c, gin.H{
+		"list":      list,
+		"total":     total,
+		"page":      req.Page,
+		"page_size": req.PageSize,
+	})
+}
+
+// Get 获取提现详情
+func (ctrl *WithdrawalController) Get(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.invalid_id", nil)
+		return
+	}
+
+	merID, err := getMerID(c)
+	if err != nil {
+		response.InternalServerErrorWithKey(c, "error.merchant_id_missing")
+		return
+	}
+
+	svc := service.NewWithdrawalService(c.Request.Context())
+	withdrawal, err := svc.Get(int32(merID), int32(id))
+	if err != nil {
+		response.BadRequestWithKey(c, "error.withdrawal.get_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.Success(c, withdrawal)
+}
+
+// Callback 出款渠道结果通知（渠道签名校验，不需要登录）
+func (ctrl *WithdrawalController) Callback(c *gin.Context) {
+	body, err := c.GetRawData()
+	if err != nil {
+		response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	svc := service.NewWithdrawalService(c.Request.Context())
+	if err := svc.HandleCallback(c.Param("provider"), c.Request.Header, body); err != nil {
+		response.BadRequestWithKey(c, "error.withdrawal.callback_failed", map[string]interface{}{
+			"Error": err.Error(),
+		})
+		return
+	}
+
+	response.Success(c, nil)
+}
@@ -2,6 +2,7 @@ package router
 
 import (
 	"merchant_api/internal/admin/controller"
+	"merchant_api/internal/admin/service"
 	"merchant_api/internal/middleware"
 	pkgi18n "merchant_api/internal/pkg/i18n"
 	"merchant_api/pkg/logger"
@@ -39,47 +40,259 @@ func SetupRouter() *gin.Engine {
 	// 初始化控制器
 	authController := controller.NewAdminAuthController()
 
+	// JWT 公钥（供其他服务验证令牌）
+	r.GET("/.well-known/jwks.json", authController.JWKS)
+	passwordController := controller.NewPasswordController()
+	twoFactorController := controller.NewTwoFactorController()
+
 	// API 路由组
 	api := r.Group("/mer_admin")
+	api.Use(middleware.AuditContext())
 	{
 		// 认证路由（无需登录）
 		auth := api.Group("/auth")
 		{
-			auth.POST("/login", authController.Login)   // 登录
-			auth.POST("/logout", authController.Logout) // 登出
+			auth.GET("/captcha", authController.Captcha)  // 登录验证码
+			auth.POST("/login", authController.Login)     // 登录
+			auth.POST("/refresh", authController.Refresh) // 刷新令牌
+			auth.POST("/logout", authController.Logout)   // 登出
+
+			auth.POST("/password/reset", passwordController.ResetPassword) // 使用重置码重置密码
+
+			auth.POST("/2fa/enroll", twoFactorController.Enroll) // 登录时绑定两步验证
+			auth.POST("/2fa/verify", twoFactorController.Verify) // 登录第二步验证
+		}
+
+		// 商户入驻申请（无需登录，创建后使用 X-Application-Token 访问）
+		applicationController := controller.NewMerchantApplicationController()
+		apply := api.Group("/apply")
+		{
+			apply.POST("", applicationController.Create)
+			apply.GET("", applicationController.Get)
+			apply.PUT("", applicationController.Update)
+			apply.POST("/documents", applicationController.UploadDocument)
+			apply.POST("/submit", applicationController.Submit)
 		}
 
+		// 出款渠道回调（校验渠道签名，无需登录）
+		api.POST("/payout/callback/:provider", controller.NewWithdrawalController().Callback)
+
 		// 需要认证的路由
 		authorized := api.Group("")
 		authorized.Use(middleware.AdminAuthMiddleware())
+		{
+			sessionController := controller.NewAdminSessionController()
+			session := authorized.Group("/sessions")
+			{
+				session.GET("", sessionController.List)            // 当前管理员的会话列表
+				session.DELETE("/:id", sessionController.Revoke)   // 吊销指定会话
+				session.DELETE("", sessionController.RevokeOthers) // 吊销其他所有会话
+			}
+
+			authorized.PUT("/account/password", passwordController.ChangePassword)       // 修改当前管理员密码
+			authorized.POST("/account/step_up", controller.NewStepUpController().Verify) // 敏感操作前二次验证
+
+			twoFactor := authorized.Group("/account/2fa")
+			{
+				twoFactor.GET("", twoFactorController.Status)                                  // 两步验证状态
+				twoFactor.POST("/setup", twoFactorController.Setup)                            // 获取绑定信息
+				twoFactor.POST("/enable", twoFactorController.Enable)                          // 确认绑定并启用
+				twoFactor.POST("/disable", twoFactorController.Disable)                        // 关闭
+				twoFactor.POST("/recovery_codes", twoFactorController.RegenerateRecoveryCodes) // 重新生成恢复码
+			}
+
+			merchantController := controller.NewMerchantController()
+			authorized.GET("/merchant/profile", merchantController.Profile)
+			authorized.PUT("/merchant/profile", middleware.RequirePermission(service.PermMerchantWrite), merchantController.UpdateProfile)
+
+			businessHoursController := controller.NewBusinessHoursController()
+			authorized.GET("/merchant/business_hours", businessHoursController.Get)
+			authorized.GET("/merchant/business_hours/status", businessHoursController.Status) // 指定时间是否营业
+			authorized.PUT("/merchant/business_hours", middleware.RequirePermission(service.PermMerchantWrite), businessHoursController.Update)
+			authorized.POST("/merchant/holidays", middleware.RequirePermission(service.PermMerchantWrite), businessHoursController.SetHoliday)
+			authorized.DELETE("/merchant/holidays/:date", middleware.RequirePermission(service.PermMerchantWrite), businessHoursController.DeleteHoliday)
+
+			merchantSecurityController := controller.NewMerchantSecurityController()
+			authorized.GET("/merchant/security", merchantSecurityController.Get)
+			authorized.PUT("/merchant/security", merchantSecurityController.Update) // 仅商户主账号
+
+			roleController := controller.NewRoleController()
+			authorized.GET("/permissions/mine", roleController.MyPermissions)
+			authorized.GET("/permissions", middleware.RequirePermission(service.PermRoleManage), roleController.Permissions)
+			role := authorized.Group("/roles")
+			role.Use(middleware.RequirePermission(service.PermRoleManage))
+			{
+				role.POST("", roleController.Create)
+				role.GET("", roleController.List)
+				role.GET("/:id", roleController.Get)
+				role.PUT("/:id", roleController.Update)
+				role.DELETE("/:id", roleController.Delete)
+			}
+
+			adminController := controller.NewAdminController()
+			admin := authorized.Group("/admins")
+			{
+				admin.POST("", middleware.RequirePermission(service.PermAdminWrite), adminController.Create)
+				admin.GET("", middleware.RequirePermission(service.PermAdminRead), adminController.List)
+				admin.GET("/:id", middleware.RequirePermission(service.PermAdminRead), adminController.Get)
+				admin.PUT("/:id", middleware.RequirePermission(service.PermAdminWrite), adminController.Update)
+				admin.PATCH("/:id/status", middleware.RequirePermission(service.PermAdminWrite), adminController.UpdateStatus)
+				admin.DELETE("/:id", middleware.RequirePermission(service.PermAdminWrite), adminController.Delete)
+				admin.POST("/:id/reset_code", middleware.RequirePermission(service.PermAdminWrite), passwordController.IssueResetCode)
+				admin.DELETE("/:id/2fa", middleware.RequirePermission(service.PermAdminWrite), twoFactorController.Reset)
+				admin.PUT("/:id/roles", middleware.RequirePermission(service.PermRoleManage), roleController.AssignRoles)
+			}
+
+			apiKeyController := controller.NewAPIKeyController()
+			apiKey := authorized.Group("/api_keys")
+			apiKey.Use(middleware.RequirePermission(service.PermAPIKeyManage))
+			{
+				apiKey.POST("", apiKeyController.Create)
+				apiKey.GET("", apiKeyController.List)
+				apiKey.DELETE("/:id", apiKeyController.Revoke)
+			}
+
+			ledgerController := controller.NewLedgerController()
+			withdrawalController := controller.NewWithdrawalController()
+			payoutAccountController := controller.NewPayoutAccountController()
+			finance := authorized.Group("/finance")
+			{
+				finance.GET("/ledger", middleware.RequirePermission(service.PermFinanceRead), ledgerController.List)
+				finance.GET("/ledger/check", middleware.RequirePermission(service.PermFinanceRead), ledgerController.Check) // 对账
+				finance.POST("/withdrawals", middleware.RequirePermission(service.PermFinanceWithdraw), withdrawalController.Create)
+				finance.GET("/withdrawals", middleware.RequirePermission(service.PermFinanceRead), withdrawalController.List)
+				finance.GET("/withdrawals/:id", middleware.RequirePermission(service.PermFinanceRead), withdrawalController.Get)
+
+				// 收款账户，新增、修改、删除需要二次验证
+				payoutAccount := finance.Group("/payout_accounts")
+				{
+					payoutAccount.GET("", middleware.RequirePermission(service.PermFinanceRead), payoutAccountController.List)
+					payoutAccount.POST("", middleware.RequirePermission(service.PermFinanceAccount), middleware.RequireStepUp(), payoutAccountController.Create)
+					payoutAccount.PUT("/:id", middleware.RequirePermission(service.PermFinanceAccount), middleware.RequireStepUp(), payoutAccountController.Update)
+					payoutAccount.DELETE("/:id", middleware.RequirePermission(service.PermFinanceAccount), middleware.RequireStepUp(), payoutAccountController.Delete)
+				}
+			}
+
+			auditLogController := controller.NewAuditLogController()
+			authorized.GET("/audit_logs", middleware.RequirePermission(service.PermAuditRead), auditLogController.List)
+		}
+
+		// 商品及库存相关路由，同时支持管理员令牌和 API 密钥（ERP/POS 等系统对接）
+		integration := api.Group("")
+		integration.Use(middleware.AdminOrAPIKeyAuthMiddleware())
 		{
 			uploadController := controller.NewUploadController()
-			authorized.POST("/upload/image", uploadController.UploadImage)
+			integration.POST("/upload/image", middleware.RequirePermission(service.PermUploadImage), uploadController.UploadImage)
 
 			storeCategoryController := controller.NewStoreCategoryController()
-			storeCategory := authorized.Group("/store_category")
+			storeCategory := integration.Group("/store_category")
 			{
-				storeCategory.GET("/options", storeCategoryController.GetOptions)
-				storeCategory.POST("", storeCategoryController.Create)
-				storeCategory.GET("", storeCategoryController.List)
-				storeCategory.GET("/:id", storeCategoryController.Get)
-				storeCategory.PUT("/:id", storeCategoryController.Update)
-				storeCategory.DELETE("/:id", storeCategoryController.Delete)
+				storeCategory.GET("/options", middleware.RequirePermission(service.PermCategoryRead), storeCategoryController.GetOptions)
+				storeCategory.POST("", middleware.RequirePermission(service.PermCategoryWrite), storeCategoryController.Create)
+				storeCategory.GET("", middleware.RequirePermission(service.PermCategoryRead), storeCategoryController.List)
+				storeCategory.GET("/:id", middleware.RequirePermission(service.PermCategoryRead), storeCategoryController.Get)
+				storeCategory.PUT("/:id", middleware.RequirePermission(service.PermCategoryWrite), storeCategoryController.Update)
+				storeCategory.DELETE("/:id", middleware.RequirePermission(service.PermCategoryWrite), storeCategoryController.Delete)
 			}
 
 			storeProductController := controller.NewStoreProductController()
-			product := authorized.Group("/product")
+			product := integration.Group("/product")
+			{
+				product.POST("", middleware.RequirePermission(service.PermProductWrite), storeProductController.Create)
+				product.POST("/spec_matrix", middleware.RequirePermission(service.PermProductWrite), storeProductController.SpecMatrix)
+				product.POST("/bulk", middleware.RequirePermission(service.PermProductWrite), storeProductController.Bulk)
+				product.POST("/import", middleware.RequirePermission(service.PermProductWrite), storeProductController.Import)
+				product.GET("/import/:job_id", middleware.RequirePermission(service.PermProductWrite), storeProductController.ImportJob)
+				product.GET("", middleware.RequirePermission(service.PermProductRead), storeProductController.List)
+				product.GET("/export", middleware.RequirePermission(service.PermProductRead), storeProductController.Export)
+				product.GET("/:id", middleware.RequirePermission(service.PermProductRead), storeProductController.Get)
+				product.PUT("/:id", middleware.RequirePermission(service.PermProductWrite), storeProductController.Update)
+				product.DELETE("/:id", middleware.RequirePermission(service.PermProductDelete), storeProductController.Delete)
+				product.PATCH("/:id/listing", middleware.RequirePermission(service.PermProductWrite), storeProductController.UpdateListingStatus)
+				product.PATCH("/:id/sold-out", middleware.RequirePermission(service.PermProductWrite), storeProductController.UpdateSoldOutStatus)
+			}
+
+			inventoryController := controller.NewInventoryController()
+			inventory := integration.Group("/inventory")
 			{
-				product.POST("", storeProductController.Create)
-				product.GET("", storeProductController.List)
-				product.GET("/:id", storeProductController.Get)
-				product.PUT("/:id", storeProductController.Update)
-				product.DELETE("/:id", storeProductController.Delete)
-				product.PATCH("/:id/listing", storeProductController.UpdateListingStatus)
-				product.PATCH("/:id/sold-out", storeProductController.UpdateSoldOutStatus)
+				inventory.GET("", middleware.RequirePermission(service.PermInventoryRead), inventoryController.List)
+				inventory.GET("/movements", middleware.RequirePermission(service.PermInventoryRead), inventoryController.Movements)
+				inventory.POST("/skus/:id/adjust", middleware.RequirePermission(service.PermInventoryWrite), inventoryController.Adjust)
+				inventory.PATCH("/skus/:id/low_stock", middleware.RequirePermission(service.PermInventoryWrite), inventoryController.SetLowStock)
+				inventory.POST("/reservations", middleware.RequirePermission(service.PermInventoryReserve), inventoryController.Reserve)
+				inventory.GET("/reservations/:order_no", middleware.RequirePermission(service.PermInventoryRead), inventoryController.Reservations)
+				inventory.POST("/reservations/:order_no/commit", middleware.RequirePermission(service.PermInventoryReserve), inventoryController.Commit)
+				inventory.POST("/reservations/:order_no/release", middleware.RequirePermission(service.PermInventoryReserve), inventoryController.Release)
 			}
 		}
 
+		// 平台运营后台，使用独立的运营账号与令牌，商户令牌不能访问
+		platformAuthController := controller.NewPlatformAuthController()
+		platform := api.Group("/platform")
+		{
+			platform.GET("/auth/captcha", authController.Captcha)
+			platform.POST("/auth/login", platformAuthController.Login)
+			platform.POST("/auth/logout", platformAuthController.Logout)
+
+			console := platform.Group("")
+			console.Use(middleware.PlatformAuthMiddleware())
+			{
+				operatorController := controller.NewPlatformOperatorController()
+				console.PUT("/account/password", operatorController.ChangePassword)
+				operator := console.Group("/operators")
+				{
+					operator.GET("", operatorController.List)
+					operator.POST("", operatorController.Create)
+					operator.PATCH("/:id/status", operatorController.UpdateStatus)
+				}
+
+				platformMerchantController := controller.NewPlatformMerchantController()
+				merchant := console.Group("/merchants")
+				{
+					merchant.GET("", platformMerchantController.List)
+					merchant.POST("", platformMerchantController.Create)
+					merchant.GET("/:id", platformMerchantController.Get)
+					merchant.PUT("/:id", platformMerchantController.Update)
+					merchant.POST("/:id/approve", platformMerchantController.Approve)
+					merchant.POST("/:id/reject", platformMerchantController.Reject)
+					merchant.POST("/:id/lock", platformMerchantController.Lock)
+					merchant.POST("/:id/unlock", platformMerchantController.Unlock)
+					merchant.GET("/:id/admins", platformMerchantController.Admins)
+					merchant.POST("/:id/admins", platformMerchantController.CreateOwner) // 创建商户主账号
+				}
+
+				merchantCategoryController := controller.NewMerchantCategoryController()
+				merchantCategory := console.Group("/merchant_categories")
+				{
+					merchantCategory.GET("", merchantCategoryController.List)
+					merchantCategory.POST("", merchantCategoryController.Create)
+					merchantCategory.PUT("/:id", merchantCategoryController.Update)
+					merchantCategory.DELETE("/:id", merchantCategoryController.Delete)
+				}
+
+				platformApplicationController := controller.NewPlatformApplicationController()
+				application := console.Group("/applications")
+				{
+					application.GET("", platformApplicationController.List)
+					application.GET("/:id", platformApplicationController.Get)
+					application.GET("/:id/documents/:index", platformApplicationController.Document)
+					application.POST("/:id/review", platformApplicationController.StartReview)
+					application.POST("/:id/comment", platformApplicationController.Comment)
+					application.POST("/:id/approve", platformApplicationController.Approve)
+					application.POST("/:id/reject", platformApplicationController.Reject)
+				}
+
+				platformWithdrawalController := controller.NewPlatformWithdrawalController()
+				withdrawal := console.Group("/withdrawals")
+				{
+					withdrawal.GET("", platformWithdrawalController.List)
+					withdrawal.GET("/:id", platformWithdrawalController.Get)
+					withdrawal.POST("/:id/approve", platformWithdrawalController.Approve)
+					withdrawal.POST("/:id/reject", platformWithdrawalController.Reject)
+					withdrawal.POST("/:id/submit", platformWithdrawalController.Submit)
+				}
+			}
+		}
 	}
 
 	return r
@@ -2,10 +2,12 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"merchant_api/internal/dao"
 	"merchant_api/internal/model"
+	"merchant_api/internal/pkg/fieldcrypt"
 	"merchant_api/internal/pkg/jwt"
 	"merchant_api/internal/pkg/utils"
 	"merchant_api/pkg/config"
@@ -14,6 +16,16 @@ import (
 	"time"
 
 	redisv8 "github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Redis 键
+const (
+	adminTokenKeyFmt          = "admin:token:%s"           // 访问令牌 -> accessTokenData
+	adminRefreshKeyFmt        = "admin:refresh:%s"         // 刷新令牌 -> refreshTokenData
+	adminSessionRefreshKeyFmt = "admin:session:%s:refresh" // 会话当前有效的刷新令牌
+	adminSessionTokensKeyFmt  = "admin:session:%s:tokens"  // 会话签发过的访问令牌
 )
 
 type AdminAuthService struct {
@@ -28,28 +40,56 @@ func NewAdminAuthService(ctx context.Context) *AdminAuthService {
 
 // LoginResponse 登录响应
 type LoginResponse struct {
-	Token     string                  `json:"token"`
-	AdminInfo *model.MerMerchantAdmin `json:"admin_info"`
-	ExpiresIn int                     `json:"expires_in"`
+	Token            string                  `json:"token"`
+	RefreshToken     string                  `json:"refresh_token"`
+	AdminInfo        *model.MerMerchantAdmin `json:"admin_info"`
+	ExpiresIn        int                     `json:"expires_in"`
+	RefreshExpiresIn int                     `json:"refresh_expires_in"`
+
+	// 需要两步验证时只返回以下字段，使用 challenge_token 调用 /auth/2fa/verify 换取令牌
+	TwoFactorRequired bool     `json:"two_factor_required,omitempty"`
+	TwoFactorEnroll   bool     `json:"two_factor_enroll,omitempty"` // 商户要求两步验证但尚未绑定，需要先绑定
+	ChallengeToken    string   `json:"challenge_token,omitempty"`
+	RecoveryCodes     []string `json:"recovery_codes,omitempty"` // 登录时完成绑定，返回恢复码（只返回一次）
+}
+
+// accessTokenData 访问令牌在 Redis 中的存储结构，IP 绑定策略在签发时确定
+type accessTokenData struct {
+	AdminID      int32  `json:"admin_id"`
+	MerID        int32  `json:"mer_id"`
+	SessionID    string `json:"session_id"`
+	IP           string `json:"ip"`
+	IPBindPolicy string `json:"ip_bind_policy"`
+}
+
+// refreshTokenData 刷新令牌在 Redis 中的存储结构
+type refreshTokenData struct {
+	AdminID      int32  `json:"admin_id"`
+	MerID        int32  `json:"mer_id"`
+	SessionID    string `json:"session_id"`
+	IP           string `json:"ip"`
+	IPBindPolicy string `json:"ip_bind_policy"`
 }
 
 // Login 管理员登录
-func (s *AdminAuthService) Login(account, password, ip string) (*LoginResponse, error) {
+// 同一账号或 IP 在统计窗口内多次失败后需要验证码，继续失败则临时锁定，见 LoginSecurityService
+func (s *AdminAuthService) Login(account, password string, captcha *CaptchaInput, client *ClientInfo) (*LoginResponse, error) {
 	// 初始化 DAO
 	dao.SetDefault(database.GetDB())
-	adminDAO := dao.MerMerchantAdmin
-
-	// 查询管理员（支持 account 或 phone 登录）
-	admin, err := adminDAO.WithContext(s.ctx).
-		Where(
-			adminDAO.Account.Eq(account),
-		).
-		Or(adminDAO.Phone.Eq(account)).
-		Where(adminDAO.IsDel.Eq(0)).
-		First()
+	security := NewLoginSecurityService(s.ctx)
 
+	admin, err := s.findLoginAdmin(account)
 	if err != nil {
-		return nil, errors.New("账号或密码错误")
+		return nil, err
+	}
+
+	// 检查锁定状态和验证码
+	if err := security.Check(account, admin, client.IP, captcha); err != nil {
+		return nil, err
+	}
+
+	if admin == nil {
+		return nil, s.loginFailed(security, account, nil, client.IP)
 	}
 
 	// 检查账号状态
@@ -59,28 +99,75 @@ func (s *AdminAuthService) Login(account, password, ip string) (*LoginResponse,
 
 	// 验证密码
 	if !utils.CheckPassword(password, admin.Pwd) {
-		return nil, errors.New("账号或密码错误")
+		return nil, s.loginFailed(security, account, admin, client.IP)
 	}
 
-	// 生成 JWT Token
-	cfg := config.GlobalConfig
-	token, err := jwt.GenerateToken(
-		uint(admin.MerchantAdminID),
-		uint(admin.MerID),
-		admin.Account,
-		"admin",
-		cfg.JWT.Secret,
-		cfg.JWT.Expire,
-	)
+	// 密码正确后再检查商户状态，避免未登录时探测商户是否被停用
+	if err := NewMerchantService(s.ctx).CheckActive(admin.MerID); err != nil {
+		return nil, err
+	}
+
+	// 已启用两步验证，或商户要求两步验证时，返回验证挑战，由 /auth/2fa/verify 完成登录
+	challenge, err := NewTwoFactorService(s.ctx).BeginLogin(admin, client)
 	if err != nil {
-		return nil, fmt.Errorf("生成 Token 失败: %w", err)
+		return nil, err
+	}
+	if challenge != nil {
+		return challenge, nil
+	}
+
+	return s.completeLogin(admin, client)
+}
+
+// findLoginAdmin 按账号或手机号查询未删除的管理员（手机号加密保存，按盲索引查询），不存在时返回 nil
+func (s *AdminAuthService) findLoginAdmin(account string) (*model.MerMerchantAdmin, error) {
+	phoneHash, err := fieldcrypt.BlindIndex(account)
+	if err != nil {
+		return nil, fmt.Errorf("登录失败: %w", err)
+	}
+	adminDAO := dao.MerMerchantAdmin
+	admin, err := adminDAO.WithContext(s.ctx).
+		Where(
+			adminDAO.Where(adminDAO.Account.Eq(account)).Or(adminDAO.PhoneHash.Eq(phoneHash)),
+			adminDAO.IsDel.Eq(0),
+		).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		// 数据库故障不能按密码错误处理，否则会累计失败次数锁定正常用户
+		return nil, fmt.Errorf("查询管理员失败: %w", err)
+	}
+	return admin, nil
+}
+
+// completeLogin 身份验证通过后开启新会话并签发令牌
+func (s *AdminAuthService) completeLogin(admin *model.MerMerchantAdmin, client *ClientInfo) (*LoginResponse, error) {
+	// 两步验证期间商户可能被停用
+	if err := NewMerchantService(s.ctx).CheckActive(admin.MerID); err != nil {
+		return nil, err
+	}
+
+	dao.SetDefault(database.GetDB())
+	adminDAO := dao.MerMerchantAdmin
+
+	if err := NewLoginSecurityService(s.ctx).RecordSuccess(admin.Account, admin); err != nil {
+		fmt.Printf("清除登录失败记录失败: %v\n", err)
 	}
 
-	// 存储 Token 到 Redis (key: admin:token:{token}, value: {admin_id}:{ip})
-	redisKey := fmt.Sprintf("admin:token:%s", token)
-	redisValue := fmt.Sprintf("%d:%s", admin.MerchantAdminID, ip)
+	// 签发访问令牌和刷新令牌（每次登录开启一个新的会话/令牌族）
+	var resp *LoginResponse
+	sessionID := uuid.New().String()
 	rdb := redis.GetRedis()
-	err = rdb.Set(s.ctx, redisKey, redisValue, time.Duration(cfg.JWT.Expire)*time.Second).Err()
+	_, err := rdb.TxPipelined(s.ctx, func(pipe redisv8.Pipeliner) error {
+		var err error
+		resp, err = s.issueTokens(pipe, admin, client.IP, sessionID)
+		if err != nil {
+			return err
+		}
+		return NewAdminSessionService(s.ctx).createSession(pipe, admin.MerchantAdminID, sessionID, client)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("存储 Token 失败: %w", err)
 	}
@@ -91,7 +178,7 @@ func (s *AdminAuthService) Login(account, password, ip string) (*LoginResponse,
 	_, err = adminDAO.WithContext(s.ctx).
 		Where(adminDAO.MerchantAdminID.Eq(admin.MerchantAdminID)).
 		Updates(map[string]interface{}{
-			"last_ip":     ip,
+			"last_ip":     client.IP,
 			"last_time":   now,
 			"login_count": loginCount,
 		})
@@ -100,27 +187,205 @@ func (s *AdminAuthService) Login(account, password, ip string) (*LoginResponse,
 		fmt.Printf("更新登录信息失败: %v\n", err)
 	}
 
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		MerID:      admin.MerID,
+		AdminID:    admin.MerchantAdminID,
+		Account:    admin.Account,
+		IP:         client.IP,
+		Action:     AuditActionLogin,
+		EntityType: AuditEntityAdmin,
+		EntityID:   int64(admin.MerchantAdminID),
+	})
+
 	// 隐藏密码
 	admin.Pwd = ""
+	resp.AdminInfo = admin
+
+	return resp, nil
+}
+
+// loginFailed 记录登录失败，本次失败触发锁定时返回锁定错误
+func (s *AdminAuthService) loginFailed(security *LoginSecurityService, account string, admin *model.MerMerchantAdmin, ip string) error {
+	if err := security.RecordFailure(account, admin, ip); err != nil {
+		var locked *LoginLockedError
+		if errors.As(err, &locked) {
+			return err
+		}
+		fmt.Printf("记录登录失败失败: %v\n", err)
+	}
+	return errors.New("账号或密码错误")
+}
+
+// issueTokens 为会话签发一对访问令牌和刷新令牌，Redis 写入通过 pipe 完成
+func (s *AdminAuthService) issueTokens(pipe redisv8.Pipeliner, admin *model.MerMerchantAdmin, ip, sessionID string) (*LoginResponse, error) {
+	cfg := config.GlobalConfig
+	token, err := jwt.GenerateToken(
+		uint(admin.MerchantAdminID),
+		uint(admin.MerID),
+		admin.Account,
+		jwt.RoleAdmin,
+		sessionID,
+		cfg.JWT.Expire,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("生成 Token 失败: %w", err)
+	}
+
+	setting, err := NewMerchantSecurityService(s.ctx).Get(admin.MerID)
+	if err != nil {
+		return nil, err
+	}
+	tokenData, err := json.Marshal(accessTokenData{
+		AdminID:      admin.MerchantAdminID,
+		MerID:        admin.MerID,
+		SessionID:    sessionID,
+		IP:           ip,
+		IPBindPolicy: setting.IPBindPolicy,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("生成 Token 失败: %w", err)
+	}
+
+	refreshToken, err := utils.RandomToken(32)
+	if err != nil {
+		return nil, fmt.Errorf("生成刷新令牌失败: %w", err)
+	}
+	refreshData, err := json.Marshal(refreshTokenData{
+		AdminID:      admin.MerchantAdminID,
+		MerID:        admin.MerID,
+		SessionID:    sessionID,
+		IP:           ip,
+		IPBindPolicy: setting.IPBindPolicy,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("生成刷新令牌失败: %w", err)
+	}
+
+	accessTTL := time.Duration(cfg.JWT.Expire) * time.Second
+	refreshTTL := time.Duration(cfg.JWT.RefreshExpire) * time.Second
+	tokensKey := fmt.Sprintf(adminSessionTokensKeyFmt, sessionID)
+
+	// 访问令牌 (key: admin:token:{token}, value: accessTokenData)
+	pipe.Set(s.ctx, fmt.Sprintf(adminTokenKeyFmt, token), tokenData, accessTTL)
+	pipe.SAdd(s.ctx, tokensKey, token)
+	pipe.Expire(s.ctx, tokensKey, refreshTTL)
+
+	// 刷新令牌，旧的刷新令牌保留到过期，用于识别重放
+	pipe.Set(s.ctx, fmt.Sprintf(adminRefreshKeyFmt, refreshToken), refreshData, refreshTTL)
+	pipe.Set(s.ctx, fmt.Sprintf(adminSessionRefreshKeyFmt, sessionID), refreshToken, refreshTTL)
 
 	return &LoginResponse{
-		Token:     token,
-		AdminInfo: admin,
-		ExpiresIn: cfg.JWT.Expire,
+		Token:            token,
+		RefreshToken:     refreshToken,
+		ExpiresIn:        cfg.JWT.Expire,
+		RefreshExpiresIn: cfg.JWT.RefreshExpire,
 	}, nil
 }
 
+// Refresh 使用刷新令牌换取新的令牌对（刷新令牌轮换）
+// 已被使用过的刷新令牌再次出现时，视为令牌泄露，整个会话（令牌族）被吊销
+func (s *AdminAuthService) Refresh(refreshToken, ip string) (*LoginResponse, error) {
+	rdb := redis.GetRedis()
+	sessionService := NewAdminSessionService(s.ctx)
+
+	raw, err := rdb.Get(s.ctx, fmt.Sprintf(adminRefreshKeyFmt, refreshToken)).Result()
+	if err != nil {
+		if err == redisv8.Nil {
+			return nil, errors.New("刷新令牌无效或已过期")
+		}
+		return nil, fmt.Errorf("验证刷新令牌失败: %w", err)
+	}
+
+	var data refreshTokenData
+	if err := json.Unmarshal([]byte(raw), &data); err != nil {
+		return nil, errors.New("刷新令牌数据格式错误")
+	}
+
+	// 与访问令牌相同，按签发时的 IP 绑定策略验证 IP，防止被盗的刷新令牌在别处换取新令牌
+	if !ipBindAllowed(data.IPBindPolicy, data.IP, ip) {
+		return nil, errors.New("登录 IP 已变更，请重新登录")
+	}
+
+	// 查询管理员，确认账号仍然可用
+	dao.SetDefault(database.GetDB())
+	adminDAO := dao.MerMerchantAdmin
+	admin, err := adminDAO.WithContext(s.ctx).
+		Where(adminDAO.MerchantAdminID.Eq(data.AdminID)).
+		Where(adminDAO.IsDel.Eq(0)).
+		First()
+	if err != nil || admin.Status != 1 {
+		_ = sessionService.revokeSession(data.AdminID, data.SessionID)
+		return nil, errors.New("账号不存在或已被禁用")
+	}
+	if err := NewMerchantService(s.ctx).CheckActive(admin.MerID); err != nil {
+		if errors.Is(err, ErrMerchantSuspended) {
+			_ = sessionService.revokeSession(data.AdminID, data.SessionID)
+		}
+		return nil, err
+	}
+
+	// 只有会话当前的刷新令牌可以使用，通过 WATCH 保证并发下只轮换一次
+	var resp *LoginResponse
+	reused := false
+	pointerKey := fmt.Sprintf(adminSessionRefreshKeyFmt, data.SessionID)
+	err = rdb.Watch(s.ctx, func(tx *redisv8.Tx) error {
+		current, err := tx.Get(s.ctx, pointerKey).Result()
+		if err != nil && err != redisv8.Nil {
+			return err
+		}
+		if current != refreshToken {
+			reused = true
+			return nil
+		}
+
+		_, err = tx.TxPipelined(s.ctx, func(pipe redisv8.Pipeliner) error {
+			var err error
+			resp, err = s.issueTokens(pipe, admin, ip, data.SessionID)
+			if err != nil {
+				return err
+			}
+			sessionService.extendIndex(pipe, data.AdminID)
+			return nil
+		})
+		return err
+	}, pointerKey)
+	if err == redisv8.TxFailedErr {
+		reused = true
+	} else if err != nil {
+		return nil, fmt.Errorf("刷新令牌失败: %w", err)
+	}
+
+	if reused {
+		if err := sessionService.revokeSession(data.AdminID, data.SessionID); err != nil {
+			return nil, fmt.Errorf("吊销会话失败: %w", err)
+		}
+		return nil, errors.New("刷新令牌已被使用，会话已失效，请重新登录")
+	}
+
+	if err := sessionService.Touch(data.AdminID, data.SessionID, ip); err != nil {
+		fmt.Printf("更新会话信息失败: %v\n", err)
+	}
+
+	admin.Pwd = ""
+	resp.AdminInfo = admin
+	return resp, nil
+}
+
 // VerifyToken 验证 Token
 func (s *AdminAuthService) VerifyToken(token, currentIP string) (*jwt.Claims, error) {
 	// 验证 JWT Token
-	cfg := config.GlobalConfig
-	claims, err := jwt.ParseToken(token, cfg.JWT.Secret)
-	if err != nil {
+	claims, err := jwt.ParseToken(token)
+	if err != nil || claims.Role != jwt.RoleAdmin {
 		return nil, errors.New("Token 无效或已过期")
 	}
 
+	// 检查商户状态（带缓存），商户被停用后已签发的令牌立即失效
+	if err := NewMerchantService(s.ctx).CheckActive(int32(claims.MerID)); err != nil {
+		return nil, err
+	}
+
 	// 从 Redis 验证 Token
-	redisKey := fmt.Sprintf("admin:token:%s", token)
+	redisKey := fmt.Sprintf(adminTokenKeyFmt, token)
 	rdb := redis.GetRedis()
 	redisValue, err := rdb.Get(s.ctx, redisKey).Result()
 	if err != nil {
@@ -130,33 +395,51 @@ func (s *AdminAuthService) VerifyToken(token, currentIP string) (*jwt.Claims, er
 		return nil, fmt.Errorf("验证 Token 失败: %w", err)
 	}
 
-	// 验证 IP 地址
-	// redisValue 格式: {admin_id}:{ip}
-	var storedAdminID int
-	var storedIP string
-	_, err = fmt.Sscanf(redisValue, "%d:%s", &storedAdminID, &storedIP)
-	if err != nil {
+	var data accessTokenData
+	if err := json.Unmarshal([]byte(redisValue), &data); err != nil {
 		return nil, errors.New("Token 数据格式错误")
 	}
 
-	if storedIP != currentIP {
+	if uint(data.AdminID) != claims.UserID {
+		return nil, errors.New("Token 数据不匹配")
+	}
+
+	// 按商户的 IP 绑定策略验证 IP 地址
+	if !ipBindAllowed(data.IPBindPolicy, data.IP, currentIP) {
 		return nil, errors.New("登录 IP 已变更，请重新登录")
 	}
 
-	if uint(storedAdminID) != claims.UserID {
-		return nil, errors.New("Token 数据不匹配")
+	// 更新会话最后活跃时间，失败不影响请求
+	if err := NewAdminSessionService(s.ctx).Touch(int32(claims.UserID), claims.SessionID, currentIP); err != nil {
+		fmt.Printf("更新会话信息失败: %v\n", err)
 	}
 
 	return claims, nil
 }
 
-// Logout 登出
+// Logout 登出（同时吊销该令牌所属会话，并从会话索引中移除）
 func (s *AdminAuthService) Logout(token string) error {
-	redisKey := fmt.Sprintf("admin:token:%s", token)
+	redisKey := fmt.Sprintf(adminTokenKeyFmt, token)
 	rdb := redis.GetRedis()
 	err := rdb.Del(s.ctx, redisKey).Err()
 	if err != nil {
 		return fmt.Errorf("登出失败: %w", err)
 	}
+
+	claims, err := jwt.ParseToken(token)
+	if err == nil && claims.Role == jwt.RoleAdmin {
+		if err := NewAdminSessionService(s.ctx).revokeSession(int32(claims.UserID), claims.SessionID); err != nil {
+			return fmt.Errorf("登出失败: %w", err)
+		}
+
+		NewAuditService(s.ctx).Log(&AuditEntry{
+			MerID:      int32(claims.MerID),
+			AdminID:    int32(claims.UserID),
+			Account:    claims.Username,
+			Action:     AuditActionLogout,
+			EntityType: AuditEntityAdmin,
+			EntityID:   int64(claims.UserID),
+		})
+	}
 	return nil
 }
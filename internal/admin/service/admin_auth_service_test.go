@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"merchant_api/internal/dao"
+	"merchant_api/pkg/database"
+	"strings"
+	"testing"
+)
+
+func TestFindLoginAdminGroupsAccountOrPhone(t *testing.T) {
+	initTestCrypto(t)
+	var queries []string
+	newFakeDB(t, func(query string, args []driver.Value) ([]string, [][]driver.Value) {
+		queries = append(queries, query)
+		return nil, nil
+	})
+	dao.SetDefault(database.GetDB())
+
+	admin, err := NewAdminAuthService(context.Background()).findLoginAdmin("13800138000")
+	if err != nil || admin != nil {
+		t.Fatalf("findLoginAdmin() = %v, %v, want nil, nil", admin, err)
+	}
+	if len(queries) != 1 {
+		t.Fatalf("got %d queries, want 1", len(queries))
+	}
+	// 账号和手机号的 OR 需要在括号内，否则已删除的管理员可以用手机号登录
+	want := "WHERE (`mer_merchant_admin`.`account` = ? OR `mer_merchant_admin`.`phone_hash` = ?) AND `mer_merchant_admin`.`is_del` = ?"
+	if !strings.Contains(queries[0], want) {
+		t.Errorf("query = %s\nwant condition %s", queries[0], want)
+	}
+}
+
+func TestFindLoginAdminPropagatesQueryError(t *testing.T) {
+	initTestCrypto(t)
+	fake := newFakeDB(t, nil)
+	fake.queryErr = errors.New("connection refused")
+	dao.SetDefault(database.GetDB())
+
+	// 查询失败不能按账号不存在处理，否则会记为登录失败
+	admin, err := NewAdminAuthService(context.Background()).findLoginAdmin("admin")
+	if err == nil || admin != nil {
+		t.Errorf("findLoginAdmin() = %v, %v, want error", admin, err)
+	}
+}
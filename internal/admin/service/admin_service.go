@@ -0,0 +1,383 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"merchant_api/internal/dao"
+	"merchant_api/internal/model"
+	"merchant_api/internal/pkg/fieldcrypt"
+	"merchant_api/internal/pkg/utils"
+	"merchant_api/pkg/database"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 管理员等级
+const (
+	AdminLevelPlatform = 0 // 平台创建（商户主账号）
+	AdminLevelMerchant = 1 // 商户创建（子账号）
+)
+
+type AdminService struct {
+	ctx context.Context
+}
+
+func NewAdminService(ctx context.Context) *AdminService {
+	dao.SetDefault(database.GetDB())
+	return &AdminService{ctx: ctx}
+}
+
+// CreateAdminRequest 创建子账号请求
+type CreateAdminRequest struct {
+	Account  string  `json:"account" binding:"required,min=4,max=32"`
+	Password string  `json:"password" binding:"required"`
+	RealName string  `json:"real_name" binding:"required,max=16"`
+	Phone    *string `json:"phone"`
+	RoleIDs  []int32 `json:"role_ids"`
+	Status   *int32  `json:"status" binding:"omitempty,oneof=0 1"`
+}
+
+// UpdateAdminRequest 更新子账号请求
+type UpdateAdminRequest struct {
+	RealName string  `json:"real_name" binding:"required,max=16"`
+	Phone    *string `json:"phone"`
+	Password *string `json:"password"` // 不为空时重置密码
+}
+
+// AdminListRequest 子账号列表请求
+type AdminListRequest struct {
+	Page     int    `form:"page,default=1"`
+	PageSize int    `form:"page_size,default=20"`
+	Status   *int32 `form:"status"`
+	Keyword  string `form:"keyword"` // 账号
+}
+
+// CreateOwnerRequest 平台创建商户主账号请求，主账号拥有全部权限，不分配角色
+type CreateOwnerRequest struct {
+	Account  string  `json:"account" binding:"required,min=4,max=32"`
+	Password string  `json:"password" binding:"required"`
+	RealName string  `json:"real_name" binding:"required,max=16"`
+	Phone    *string `json:"phone"`
+}
+
+// Create 创建子账号，同时分配角色时操作人还需有 role:manage 权限，且角色权限不超出操作人自己的权限
+func (s *AdminService) Create(callerID int32, req *CreateAdminRequest, merID int32) (*model.MerMerchantAdmin, error) {
+	if len(req.RoleIDs) > 0 {
+		perms, err := NewPermissionService(s.ctx).GetAdminPermissions(callerID)
+		if err != nil {
+			return nil, err
+		}
+		if !HasPermission(perms, PermRoleManage) {
+			return nil, errors.New("没有分配角色的权限")
+		}
+		if err := NewRoleService(s.ctx).checkAssignable(callerID, req.RoleIDs, merID); err != nil {
+			return nil, err
+		}
+	}
+	return s.create(req, merID, AdminLevelMerchant)
+}
+
+// CreateOwner 平台为商户创建主账号（level 0）
+func (s *AdminService) CreateOwner(merID int32, req *CreateOwnerRequest) (*model.MerMerchantAdmin, error) {
+	if _, err := NewMerchantService(s.ctx).GetProfile(merID); err != nil {
+		return nil, err
+	}
+	admin, err := s.create(&CreateAdminRequest{
+		Account:  req.Account,
+		Password: req.Password,
+		RealName: req.RealName,
+		Phone:    req.Phone,
+	}, merID, AdminLevelPlatform)
+	if err != nil {
+		return nil, err
+	}
+
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		MerID:      merID,
+		Action:     AuditActionMerchantOwner,
+		EntityType: AuditEntityAdmin,
+		EntityID:   int64(admin.MerchantAdminID),
+		After:      admin,
+	})
+	return admin, nil
+}
+
+// create 创建管理员
+func (s *AdminService) create(req *CreateAdminRequest, merID int32, level int32) (*model.MerMerchantAdmin, error) {
+	if err := s.checkAccountAvailable(req.Account, req.Phone, 0); err != nil {
+		return nil, err
+	}
+	passwordService := NewPasswordService(s.ctx)
+	if err := passwordService.ValidatePolicy(req.Password); err != nil {
+		return nil, err
+	}
+
+	pwd, err := utils.HashPassword(req.Password)
+	if err != nil {
+		return nil, fmt.Errorf("密码加密失败: %w", err)
+	}
+	phoneHash, err := adminPhoneHash(req.Phone)
+	if err != nil {
+		return nil, err
+	}
+
+	admin := &model.MerMerchantAdmin{
+		MerID:     merID,
+		Account:   req.Account,
+		Pwd:       pwd,
+		RealName:  req.RealName,
+		Phone:     req.Phone,
+		PhoneHash: phoneHash,
+		Level:     level,
+		Roles:     stringPtr(formatRoleIDs(uniqueInt32(req.RoleIDs))),
+		Status:    1,
+		CreateAt:  time.Now(),
+	}
+	if req.Status != nil {
+		admin.Status = *req.Status
+	}
+
+	err = dao.Q.Transaction(func(tx *dao.Query) error {
+		if err := tx.MerMerchantAdmin.WithContext(s.ctx).Create(admin); err != nil {
+			return fmt.Errorf("创建管理员失败: %w", err)
+		}
+		return passwordService.saveHistory(tx, admin.MerchantAdminID, pwd)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	admin.Pwd = ""
+	return admin, nil
+}
+
+// Update 更新子账号，重置密码时需满足密码策略，并使已登录的会话全部失效
+func (s *AdminService) Update(callerID, adminID int32, req *UpdateAdminRequest, merID int32) error {
+	target, err := s.loadEditable(callerID, adminID, merID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.checkAccountAvailable("", req.Phone, adminID); err != nil {
+		return err
+	}
+
+	passwordService := NewPasswordService(s.ctx)
+	resetPassword := req.Password != nil && *req.Password != ""
+	if resetPassword {
+		if err := passwordService.ValidatePolicy(*req.Password); err != nil {
+			return err
+		}
+	}
+
+	// map 更新不经过序列化器，需要手动加密
+	realName, err := fieldcrypt.Encrypt(req.RealName)
+	if err != nil {
+		return fmt.Errorf("加密姓名失败: %w", err)
+	}
+	var phone interface{}
+	if req.Phone != nil {
+		if phone, err = fieldcrypt.Encrypt(*req.Phone); err != nil {
+			return fmt.Errorf("加密手机号失败: %w", err)
+		}
+	}
+	phoneHash, err := adminPhoneHash(req.Phone)
+	if err != nil {
+		return err
+	}
+
+	a := dao.MerMerchantAdmin
+	if _, err := a.WithContext(s.ctx).
+		Where(a.MerchantAdminID.Eq(adminID)).
+		Updates(map[string]interface{}{
+			"real_name":  realName,
+			"phone":      phone,
+			"phone_hash": phoneHash,
+		}); err != nil {
+		return fmt.Errorf("更新子账号失败: %w", err)
+	}
+
+	if resetPassword {
+		return passwordService.setPassword(target, *req.Password)
+	}
+	return nil
+}
+
+// UpdateStatus 启用/禁用子账号，禁用后已登录的会话全部失效
+func (s *AdminService) UpdateStatus(callerID, adminID int32, status int32, merID int32) error {
+	if callerID == adminID {
+		return errors.New("不能修改自己的状态")
+	}
+	if _, err := s.loadEditable(callerID, adminID, merID); err != nil {
+		return err
+	}
+
+	a := dao.MerMerchantAdmin
+	if _, err := a.WithContext(s.ctx).
+		Where(a.MerchantAdminID.Eq(adminID)).
+		Update(a.Status, status); err != nil {
+		return fmt.Errorf("更新状态失败: %w", err)
+	}
+
+	if status != 1 {
+		if _, err := NewAdminSessionService(s.ctx).RevokeAll(adminID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Delete 删除子账号（软删除），已登录的会话全部失效
+func (s *AdminService) Delete(callerID, adminID int32, merID int32) error {
+	if callerID == adminID {
+		return errors.New("不能删除自己")
+	}
+	if _, err := s.loadEditable(callerID, adminID, merID); err != nil {
+		return err
+	}
+
+	a := dao.MerMerchantAdmin
+	if _, err := a.WithContext(s.ctx).
+		Where(a.MerchantAdminID.Eq(adminID)).
+		Update(a.IsDel, 1); err != nil {
+		return fmt.Errorf("删除子账号失败: %w", err)
+	}
+
+	if _, err := NewAdminSessionService(s.ctx).RevokeAll(adminID); err != nil {
+		return err
+	}
+	return NewPermissionService(s.ctx).InvalidateAdmins(adminID)
+}
+
+// Get 获取子账号详情
+func (s *AdminService) Get(adminID int32, merID int32) (*model.MerMerchantAdmin, error) {
+	admin, err := s.load(adminID, merID)
+	if err != nil {
+		return nil, err
+	}
+	admin.Pwd = ""
+	return admin, nil
+}
+
+// GetList 获取商户的管理员列表
+func (s *AdminService) GetList(merID int32, req *AdminListRequest) ([]*model.MerMerchantAdmin, int64, error) {
+	a := dao.MerMerchantAdmin
+
+	query := a.WithContext(s.ctx).
+		Where(a.MerID.Eq(merID)).
+		Where(a.IsDel.Eq(0))
+
+	if req.Status != nil {
+		query = query.Where(a.Status.Eq(*req.Status))
+	}
+	if req.Keyword != "" {
+		query = query.Where(a.Account.Like("%" + req.Keyword + "%"))
+	}
+
+	total, err := query.Count()
+	if err != nil {
+		return nil, 0, fmt.Errorf("查询管理员总数失败: %w", err)
+	}
+
+	list, err := query.
+		Order(a.Level, a.MerchantAdminID.Desc()).
+		Limit(req.PageSize).
+		Offset((req.Page - 1) * req.PageSize).
+		Find()
+	if err != nil {
+		return nil, 0, fmt.Errorf("查询管理员列表失败: %w", err)
+	}
+
+	for _, admin := range list {
+		admin.Pwd = ""
+	}
+	return list, total, nil
+}
+
+// load 查询商户下未删除的管理员
+func (s *AdminService) load(adminID int32, merID int32) (*model.MerMerchantAdmin, error) {
+	a := dao.MerMerchantAdmin
+	admin, err := a.WithContext(s.ctx).
+		Where(a.MerchantAdminID.Eq(adminID), a.MerID.Eq(merID), a.IsDel.Eq(0)).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("管理员不存在或无权访问")
+		}
+		return nil, fmt.Errorf("查询管理员失败: %w", err)
+	}
+	return admin, nil
+}
+
+// loadEditable 查询可被当前管理员修改的管理员：商户创建的子账号不能修改平台创建的管理员
+func (s *AdminService) loadEditable(callerID, adminID int32, merID int32) (*model.MerMerchantAdmin, error) {
+	caller, err := s.load(callerID, merID)
+	if err != nil {
+		return nil, err
+	}
+	target, err := s.load(adminID, merID)
+	if err != nil {
+		return nil, err
+	}
+	if caller.Level != AdminLevelPlatform && target.Level == AdminLevelPlatform {
+		return nil, errors.New("无权修改平台创建的管理员")
+	}
+	return target, nil
+}
+
+// checkAccountAvailable 账号和手机号均可用于登录，需全局唯一
+func (s *AdminService) checkAccountAvailable(account string, phone *string, excludeID int32) error {
+	a := dao.MerMerchantAdmin
+
+	if account != "" {
+		count, err := a.WithContext(s.ctx).
+			Where(a.Account.Eq(account), a.IsDel.Eq(0)).
+			Count()
+		if err != nil {
+			return fmt.Errorf("查询账号失败: %w", err)
+		}
+		if count > 0 {
+			return errors.New("账号已存在")
+		}
+	}
+
+	if phone != nil && *phone != "" {
+		if !utils.IsValidPhone(*phone) {
+			return errors.New("手机号格式错误")
+		}
+		phoneHash, err := adminPhoneHash(phone)
+		if err != nil {
+			return err
+		}
+		count, err := a.WithContext(s.ctx).
+			Where(a.PhoneHash.Eq(*phoneHash), a.IsDel.Eq(0), a.MerchantAdminID.Neq(excludeID)).
+			Count()
+		if err != nil {
+			return fmt.Errorf("查询手机号失败: %w", err)
+		}
+		if count > 0 {
+			return errors.New("手机号已被使用")
+		}
+	}
+
+	return nil
+}
+
+// adminPhoneHash 计算管理员手机号的盲索引，手机号为空时返回 nil
+func adminPhoneHash(phone *string) (*string, error) {
+	if phone == nil || *phone == "" {
+		return nil, nil
+	}
+	hash, err := fieldcrypt.BlindIndex(*phone)
+	if err != nil {
+		return nil, fmt.Errorf("计算手机号索引失败: %w", err)
+	}
+	return &hash, nil
+}
+
+// stringPtr 返回字符串指针
+func stringPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,261 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"merchant_api/pkg/config"
+	"merchant_api/pkg/redis"
+	"sort"
+	"strings"
+	"time"
+
+	redisv8 "github.com/go-redis/redis/v8"
+)
+
+// Redis 键
+const (
+	adminSessionsKeyFmt     = "admin:sessions:%d"      // 管理员会话索引 hash: {session_id} -> SessionInfo
+	adminSessionTouchKeyFmt = "admin:session:%s:touch" // 会话活跃时间更新节流标记
+)
+
+// sessionTouchInterval 会话最后活跃时间的最小更新间隔
+const sessionTouchInterval = time.Minute
+
+// ClientInfo 登录客户端信息
+type ClientInfo struct {
+	IP        string `json:"ip"`
+	UserAgent string `json:"user_agent"`
+	Device    string `json:"device"`
+}
+
+// SessionInfo 会话信息
+type SessionInfo struct {
+	SessionID string    `json:"session_id"`
+	Device    string    `json:"device"`
+	IP        string    `json:"ip"`
+	UserAgent string    `json:"user_agent"`
+	LoginTime time.Time `json:"login_time"`
+	LastTime  time.Time `json:"last_time"`
+	Current   bool      `json:"current"`
+}
+
+type AdminSessionService struct {
+	ctx context.Context
+}
+
+func NewAdminSessionService(ctx context.Context) *AdminSessionService {
+	return &AdminSessionService{ctx: ctx}
+}
+
+// createSession 将新会话写入管理员的会话索引
+func (s *AdminSessionService) createSession(pipe redisv8.Pipeliner, adminID int32, sessionID string, client *ClientInfo) error {
+	now := time.Now()
+	device := client.Device
+	if device == "" {
+		device = detectDevice(client.UserAgent)
+	}
+
+	data, err := json.Marshal(SessionInfo{
+		SessionID: sessionID,
+		Device:    device,
+		IP:        client.IP,
+		UserAgent: client.UserAgent,
+		LoginTime: now,
+		LastTime:  now,
+	})
+	if err != nil {
+		return err
+	}
+
+	pipe.HSet(s.ctx, fmt.Sprintf(adminSessionsKeyFmt, adminID), sessionID, data)
+	s.extendIndex(pipe, adminID)
+	return nil
+}
+
+// extendIndex 把会话索引的过期时间延长到刷新令牌的有效期，登录和刷新时都要调用，
+// 否则刷新续期的会话会从索引中消失，无法列出，也不会被 RevokeAll 吊销
+func (s *AdminSessionService) extendIndex(pipe redisv8.Pipeliner, adminID int32) {
+	pipe.Expire(s.ctx, fmt.Sprintf(adminSessionsKeyFmt, adminID),
+		time.Duration(config.GlobalConfig.JWT.RefreshExpire)*time.Second)
+}
+
+// Touch 更新会话的最后活跃时间和 IP（按 sessionTouchInterval 节流）
+func (s *AdminSessionService) Touch(adminID int32, sessionID, ip string) error {
+	if sessionID == "" {
+		return nil
+	}
+
+	rdb := redis.GetRedis()
+	ok, err := rdb.SetNX(s.ctx, fmt.Sprintf(adminSessionTouchKeyFmt, sessionID), 1, sessionTouchInterval).Result()
+	if err != nil || !ok {
+		return err
+	}
+
+	indexKey := fmt.Sprintf(adminSessionsKeyFmt, adminID)
+	raw, err := rdb.HGet(s.ctx, indexKey, sessionID).Result()
+	if err != nil {
+		if err == redisv8.Nil {
+			return nil
+		}
+		return err
+	}
+
+	var info SessionInfo
+	if err := json.Unmarshal([]byte(raw), &info); err != nil {
+		return err
+	}
+	info.LastTime = time.Now()
+	if ip != "" {
+		info.IP = ip
+	}
+
+	data, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+	return rdb.HSet(s.ctx, indexKey, sessionID, data).Err()
+}
+
+// List 获取管理员的所有有效会话，currentSessionID 对应的会话标记为当前会话
+func (s *AdminSessionService) List(adminID int32, currentSessionID string) ([]*SessionInfo, error) {
+	rdb := redis.GetRedis()
+	indexKey := fmt.Sprintf(adminSessionsKeyFmt, adminID)
+
+	entries, err := rdb.HGetAll(s.ctx, indexKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("查询会话失败: %w", err)
+	}
+
+	sessions := make([]*SessionInfo, 0, len(entries))
+	for sessionID, raw := range entries {
+		// 刷新令牌已过期的会话视为失效，顺便清理索引
+		exists, err := rdb.Exists(s.ctx, fmt.Sprintf(adminSessionRefreshKeyFmt, sessionID)).Result()
+		if err != nil {
+			return nil, fmt.Errorf("查询会话失败: %w", err)
+		}
+		if exists == 0 {
+			rdb.HDel(s.ctx, indexKey, sessionID)
+			continue
+		}
+
+		var info SessionInfo
+		if err := json.Unmarshal([]byte(raw), &info); err != nil {
+			continue
+		}
+		info.Current = sessionID == currentSessionID
+		sessions = append(sessions, &info)
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastTime.After(sessions[j].LastTime)
+	})
+
+	return sessions, nil
+}
+
+// Revoke 吊销管理员的指定会话
+func (s *AdminSessionService) Revoke(adminID int32, sessionID string) error {
+	rdb := redis.GetRedis()
+	exists, err := rdb.HExists(s.ctx, fmt.Sprintf(adminSessionsKeyFmt, adminID), sessionID).Result()
+	if err != nil {
+		return fmt.Errorf("查询会话失败: %w", err)
+	}
+	if !exists {
+		return errors.New("会话不存在")
+	}
+
+	return s.revokeSession(adminID, sessionID)
+}
+
+// RevokeOthers 吊销除当前会话外的所有会话，返回吊销数量
+func (s *AdminSessionService) RevokeOthers(adminID int32, currentSessionID string) (int, error) {
+	return s.revokeWhere(adminID, func(sessionID string) bool {
+		return sessionID != currentSessionID
+	})
+}
+
+// RevokeAll 吊销管理员的所有会话，返回吊销数量
+func (s *AdminSessionService) RevokeAll(adminID int32) (int, error) {
+	return s.revokeWhere(adminID, func(string) bool { return true })
+}
+
+func (s *AdminSessionService) revokeWhere(adminID int32, match func(sessionID string) bool) (int, error) {
+	rdb := redis.GetRedis()
+	sessionIDs, err := rdb.HKeys(s.ctx, fmt.Sprintf(adminSessionsKeyFmt, adminID)).Result()
+	if err != nil {
+		return 0, fmt.Errorf("查询会话失败: %w", err)
+	}
+
+	count := 0
+	for _, sessionID := range sessionIDs {
+		if !match(sessionID) {
+			continue
+		}
+		if err := s.revokeSession(adminID, sessionID); err != nil {
+			return count, fmt.Errorf("吊销会话失败: %w", err)
+		}
+		count++
+	}
+	return count, nil
+}
+
+// revokeSession 吊销整个会话：删除会话签发的所有访问令牌、当前刷新令牌和会话索引
+func (s *AdminSessionService) revokeSession(adminID int32, sessionID string) error {
+	if sessionID == "" {
+		return nil
+	}
+
+	rdb := redis.GetRedis()
+	tokensKey := fmt.Sprintf(adminSessionTokensKeyFmt, sessionID)
+	pointerKey := fmt.Sprintf(adminSessionRefreshKeyFmt, sessionID)
+
+	tokens, err := rdb.SMembers(s.ctx, tokensKey).Result()
+	if err != nil && err != redisv8.Nil {
+		return err
+	}
+	currentRefresh, err := rdb.Get(s.ctx, pointerKey).Result()
+	if err != nil && err != redisv8.Nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(tokens)+4)
+	for _, token := range tokens {
+		keys = append(keys, fmt.Sprintf(adminTokenKeyFmt, token))
+	}
+	if currentRefresh != "" {
+		keys = append(keys, fmt.Sprintf(adminRefreshKeyFmt, currentRefresh))
+	}
+	keys = append(keys, tokensKey, pointerKey, fmt.Sprintf(adminSessionTouchKeyFmt, sessionID))
+
+	_, err = rdb.TxPipelined(s.ctx, func(pipe redisv8.Pipeliner) error {
+		pipe.Del(s.ctx, keys...)
+		pipe.HDel(s.ctx, fmt.Sprintf(adminSessionsKeyFmt, adminID), sessionID)
+		return nil
+	})
+	return err
+}
+
+// detectDevice 根据 User-Agent 粗略识别设备类型
+func detectDevice(userAgent string) string {
+	ua := strings.ToLower(userAgent)
+	switch {
+	case ua == "":
+		return "Unknown"
+	case strings.Contains(ua, "iphone"):
+		return "iPhone"
+	case strings.Contains(ua, "ipad"):
+		return "iPad"
+	case strings.Contains(ua, "android"):
+		return "Android"
+	case strings.Contains(ua, "windows"):
+		return "Windows"
+	case strings.Contains(ua, "mac os"):
+		return "Mac"
+	case strings.Contains(ua, "linux"):
+		return "Linux"
+	default:
+		return "Unknown"
+	}
+}
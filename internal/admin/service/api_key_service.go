@@ -0,0 +1,292 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"merchant_api/internal/dao"
+	"merchant_api/internal/model"
+	"merchant_api/internal/pkg/utils"
+	"merchant_api/pkg/database"
+	"merchant_api/pkg/redis"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Redis 键
+const (
+	apiKeyNonceKeyFmt = "admin:api_key:nonce:%s:%s" // 已使用的请求随机数，防止重放
+	apiKeyTouchKeyFmt = "admin:api_key:%d:touch"    // 最后使用时间更新节流标记
+)
+
+const (
+	apiKeyAccessKeyPrefix = "ak_"
+	apiKeySignatureSkew   = 5 * time.Minute // 请求时间戳允许的误差
+	apiKeyTouchInterval   = time.Minute     // 最后使用时间的最小更新间隔
+)
+
+// APIKeyScopes 可以授权给 API 密钥的权限码（账号、角色、日志等管理权限只能由管理员使用）
+var APIKeyScopes = []string{
+	PermCategoryRead,
+	PermCategoryWrite,
+	PermProductRead,
+	PermProductWrite,
+	PermProductPrice,
+	PermProductDelete,
+	PermInventoryRead,
+	PermInventoryWrite,
+	PermInventoryReserve,
+	PermUploadImage,
+}
+
+// ErrAPIKeyInvalid API 密钥不存在或签名错误，不区分具体原因
+var ErrAPIKeyInvalid = errors.New("API 密钥无效或签名错误")
+
+// APIKeyRequest 创建 API 密钥请求
+type APIKeyRequest struct {
+	Name     string     `json:"name" binding:"required,max=64"`
+	Scopes   []string   `json:"scopes" binding:"required,min=1"`
+	ExpireAt *time.Time `json:"expire_at"` // 为空表示不过期
+}
+
+// APIKeyCreateResponse 创建 API 密钥响应，secret 只在创建时返回一次
+type APIKeyCreateResponse struct {
+	*model.MerMerchantAPIKey
+	Secret string `json:"secret"`
+}
+
+// APIKeySignedRequest 需要校验签名的请求内容
+type APIKeySignedRequest struct {
+	AccessKey string
+	Timestamp string
+	Nonce     string
+	Signature string
+	Method    string
+	URI       string // 路径及查询参数，例如 /mer_admin/product?page=1
+	Body      []byte
+	IP        string
+}
+
+type APIKeyService struct {
+	ctx context.Context
+}
+
+func NewAPIKeyService(ctx context.Context) *APIKeyService {
+	dao.SetDefault(database.GetDB())
+	return &APIKeyService{ctx: ctx}
+}
+
+// Create 创建 API 密钥，授权范围不能超出创建人自己的权限
+func (s *APIKeyService) Create(callerID int32, merID int32, req *APIKeyRequest) (*APIKeyCreateResponse, error) {
+	perms, err := NewPermissionService(s.ctx).GetAdminPermissions(callerID)
+	if err != nil {
+		return nil, err
+	}
+	scopes, err := validateAPIKeyScopes(req.Scopes, perms)
+	if err != nil {
+		return nil, err
+	}
+	if req.ExpireAt != nil && !req.ExpireAt.After(time.Now()) {
+		return nil, errors.New("过期时间必须晚于当前时间")
+	}
+
+	token, err := utils.RandomToken(12)
+	if err != nil {
+		return nil, fmt.Errorf("生成 API 密钥失败: %w", err)
+	}
+	secret, err := utils.RandomToken(32)
+	if err != nil {
+		return nil, fmt.Errorf("生成 API 密钥失败: %w", err)
+	}
+
+	key := &model.MerMerchantAPIKey{
+		MerID:     merID,
+		Name:      req.Name,
+		AccessKey: apiKeyAccessKeyPrefix + token,
+		Secret:    secret,
+		Scopes:    scopes,
+		ExpireAt:  req.ExpireAt,
+		CreatedBy: callerID,
+		CreateAt:  time.Now(),
+	}
+	if err := dao.MerMerchantAPIKey.WithContext(s.ctx).Create(key); err != nil {
+		return nil, fmt.Errorf("创建 API 密钥失败: %w", err)
+	}
+
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		Action:     AuditActionAPIKeyCreate,
+		EntityType: AuditEntityAPIKey,
+		EntityID:   int64(key.APIKeyID),
+		After:      key,
+	})
+
+	return &APIKeyCreateResponse{MerMerchantAPIKey: key, Secret: secret}, nil
+}
+
+// List 获取商户的 API 密钥列表（不包含密钥）
+func (s *APIKeyService) List(merID int32) ([]*model.MerMerchantAPIKey, error) {
+	k := dao.MerMerchantAPIKey
+	list, err := k.WithContext(s.ctx).
+		Where(k.MerID.Eq(merID)).
+		Order(k.APIKeyID.Desc()).
+		Find()
+	if err != nil {
+		return nil, fmt.Errorf("查询 API 密钥失败: %w", err)
+	}
+	return list, nil
+}
+
+// Revoke 吊销 API 密钥，吊销后立即失效且不能恢复
+func (s *APIKeyService) Revoke(keyID int32, merID int32) error {
+	k := dao.MerMerchantAPIKey
+	info, err := k.WithContext(s.ctx).
+		Where(k.APIKeyID.Eq(keyID), k.MerID.Eq(merID), k.RevokeAt.IsNull()).
+		Update(k.RevokeAt, time.Now())
+	if err != nil {
+		return fmt.Errorf("吊销 API 密钥失败: %w", err)
+	}
+	if info.RowsAffected == 0 {
+		return errors.New("API 密钥不存在或已吊销")
+	}
+
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		Action:     AuditActionAPIKeyRevoke,
+		EntityType: AuditEntityAPIKey,
+		EntityID:   int64(keyID),
+	})
+	return nil
+}
+
+// Authenticate 校验请求签名，成功时返回对应的 API 密钥
+func (s *APIKeyService) Authenticate(req *APIKeySignedRequest) (*model.MerMerchantAPIKey, error) {
+	ts, err := strconv.ParseInt(req.Timestamp, 10, 64)
+	if err != nil {
+		return nil, errors.New("X-Timestamp 格式错误")
+	}
+	if diff := time.Since(time.Unix(ts, 0)); diff > apiKeySignatureSkew || diff < -apiKeySignatureSkew {
+		return nil, errors.New("请求已过期，请检查客户端时间")
+	}
+	if req.Nonce == "" || len(req.Nonce) > 64 {
+		return nil, errors.New("X-Nonce 格式错误")
+	}
+
+	k := dao.MerMerchantAPIKey
+	key, err := k.WithContext(s.ctx).Where(k.AccessKey.Eq(req.AccessKey)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrAPIKeyInvalid
+		}
+		return nil, fmt.Errorf("查询 API 密钥失败: %w", err)
+	}
+
+	expected := APIKeySignature(key.Secret, req.Method, req.URI, req.Timestamp, req.Nonce, req.Body)
+	if !hmac.Equal([]byte(expected), []byte(strings.ToLower(req.Signature))) {
+		return nil, ErrAPIKeyInvalid
+	}
+	if key.RevokeAt != nil {
+		return nil, errors.New("API 密钥已吊销")
+	}
+	if key.ExpireAt != nil && !key.ExpireAt.After(time.Now()) {
+		return nil, errors.New("API 密钥已过期")
+	}
+	if err := NewMerchantService(s.ctx).CheckActive(key.MerID); err != nil {
+		return nil, err
+	}
+
+	// 同一随机数在时间戳有效期内只能使用一次
+	ok, err := redis.GetRedis().SetNX(s.ctx, fmt.Sprintf(apiKeyNonceKeyFmt, key.AccessKey, req.Nonce), 1, 2*apiKeySignatureSkew).Result()
+	if err != nil {
+		return nil, fmt.Errorf("校验请求失败: %w", err)
+	}
+	if !ok {
+		return nil, errors.New("请求重复提交")
+	}
+
+	// 更新最后使用信息，失败不影响请求
+	if err := s.touch(key, req.IP); err != nil {
+		fmt.Printf("更新 API 密钥使用信息失败: %v\n", err)
+	}
+
+	return key, nil
+}
+
+// touch 更新最后使用时间和 IP，每分钟最多更新一次
+func (s *APIKeyService) touch(key *model.MerMerchantAPIKey, ip string) error {
+	ok, err := redis.GetRedis().SetNX(s.ctx, fmt.Sprintf(apiKeyTouchKeyFmt, key.APIKeyID), 1, apiKeyTouchInterval).Result()
+	if err != nil || !ok {
+		return err
+	}
+
+	k := dao.MerMerchantAPIKey
+	_, err = k.WithContext(s.ctx).
+		Where(k.APIKeyID.Eq(key.APIKeyID)).
+		Updates(map[string]interface{}{
+			"last_used_at": time.Now(),
+			"last_used_ip": ip,
+		})
+	return err
+}
+
+// APIKeySignature 计算请求签名：
+// hex(HMAC-SHA256(secret, METHOD + "\n" + URI + "\n" + timestamp + "\n" + nonce + "\n" + hex(SHA256(body))))
+func APIKeySignature(secret, method, uri, timestamp, nonce string, body []byte) string {
+	bodyHash := sha256.Sum256(body)
+	payload := strings.Join([]string{
+		strings.ToUpper(method),
+		uri,
+		timestamp,
+		nonce,
+		hex.EncodeToString(bodyHash[:]),
+	}, "\n")
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// APIKeyScopeList 解析 API 密钥的授权范围
+func APIKeyScopeList(key *model.MerMerchantAPIKey) []string {
+	scopes := make([]string, 0)
+	for _, code := range strings.Split(key.Scopes, ",") {
+		if code = strings.TrimSpace(code); code != "" {
+			scopes = append(scopes, code)
+		}
+	}
+	return scopes
+}
+
+// validateAPIKeyScopes 校验授权范围并转换为存储格式
+func validateAPIKeyScopes(scopes []string, callerPerms []string) (string, error) {
+	seen := make(map[string]bool)
+	codes := make([]string, 0, len(scopes))
+	for _, code := range scopes {
+		code = strings.TrimSpace(code)
+		if !isAPIKeyScope(code) {
+			return "", fmt.Errorf("无效的授权范围: %s", code)
+		}
+		if !HasPermission(callerPerms, code) {
+			return "", fmt.Errorf("不能授予自己没有的权限: %s", code)
+		}
+		if !seen[code] {
+			seen[code] = true
+			codes = append(codes, code)
+		}
+	}
+	return strings.Join(codes, ","), nil
+}
+
+// isAPIKeyScope 判断权限码是否可以授权给 API 密钥
+func isAPIKeyScope(code string) bool {
+	for _, scope := range APIKeyScopes {
+		if scope == code {
+			return true
+		}
+	}
+	return false
+}
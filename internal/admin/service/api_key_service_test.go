@@ -0,0 +1,40 @@
+package service
+
+import "testing"
+
+func TestAPIKeySignature(t *testing.T) {
+	const (
+		secret = "secret"
+		ts     = "1700000000"
+		nonce  = "abc"
+	)
+	get := APIKeySignature(secret, "GET", "/mer_admin/product?page=1", ts, nonce, nil)
+	post := APIKeySignature(secret, "POST", "/mer_admin/product", ts, nonce, []byte(`{"store_name":"test"}`))
+
+	tests := []struct {
+		name   string
+		got    string
+		want   string
+		differ bool // want 为另一个签名，两者必须不同
+	}{
+		{"GET 无请求体", get, "2bc8335d05a5f418f6902665bd733450769ca48e85bf68ab9459d99c928ba4e0", false},
+		{"POST 带请求体", post, "cf16b42abf93af2a1e0572e253ff6eabd00b09139e6df28e0552b7e433ea56b2", false},
+		{"方法不区分大小写", APIKeySignature(secret, "get", "/mer_admin/product?page=1", ts, nonce, nil), get, false},
+		{"空请求体与 nil 相同", APIKeySignature(secret, "GET", "/mer_admin/product?page=1", ts, nonce, []byte{}), get, false},
+		{"密钥不同", APIKeySignature("other", "GET", "/mer_admin/product?page=1", ts, nonce, nil), get, true},
+		{"查询参数不同", APIKeySignature(secret, "GET", "/mer_admin/product?page=2", ts, nonce, nil), get, true},
+		{"时间戳不同", APIKeySignature(secret, "GET", "/mer_admin/product?page=1", "1700000001", nonce, nil), get, true},
+		{"随机数不同", APIKeySignature(secret, "GET", "/mer_admin/product?page=1", ts, "abd", nil), get, true},
+		{"请求体不同", APIKeySignature(secret, "POST", "/mer_admin/product", ts, nonce, []byte(`{"store_name":"Test"}`)), post, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.differ && tt.got == tt.want {
+				t.Errorf("signature = %s, want different", tt.got)
+			}
+			if !tt.differ && tt.got != tt.want {
+				t.Errorf("signature = %s, want %s", tt.got, tt.want)
+			}
+		})
+	}
+}
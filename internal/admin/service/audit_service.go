@@ -0,0 +1,378 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"merchant_api/internal/dao"
+	"merchant_api/internal/model"
+	"merchant_api/pkg/config"
+	"merchant_api/pkg/database"
+	"reflect"
+	"time"
+)
+
+// 操作类型
+const (
+	AuditActionLoginLocked    = "auth.login_locked"    // 账号登录失败次数过多被锁定
+	AuditActionIPLocked       = "auth.ip_locked"       // IP 登录失败次数过多被锁定
+	AuditActionLogin          = "auth.login"           // 登录成功
+	AuditActionLogout         = "auth.logout"          // 登出
+	AuditActionPasswordChange = "auth.password_change" // 修改密码
+	AuditActionPasswordReset  = "auth.password_reset"  // 使用重置码重置密码
+	AuditActionResetCodeIssue = "auth.reset_code"      // 生成密码重置码
+	AuditActionTwoFactorOn    = "auth.2fa_enable"      // 启用两步验证
+	AuditActionTwoFactorOff   = "auth.2fa_disable"     // 关闭两步验证
+	AuditActionTwoFactorReset = "auth.2fa_reset"       // 重置子账号两步验证
+	AuditActionStepUp         = "auth.step_up"         // 敏感操作前二次验证
+
+	AuditActionCategoryCreate = "category.create"
+	AuditActionCategoryUpdate = "category.update"
+	AuditActionCategoryDelete = "category.delete"
+
+	AuditActionProductCreate  = "product.create"
+	AuditActionProductUpdate  = "product.update"
+	AuditActionProductDelete  = "product.delete"
+	AuditActionProductListing = "product.listing"  // 上下架
+	AuditActionProductSoldOut = "product.sold_out" // 售完状态
+
+	AuditActionInventoryAdjust   = "inventory.adjust"    // 盘点调整库存
+	AuditActionInventoryLowStock = "inventory.low_stock" // 修改低库存预警值
+
+	AuditActionMerchantProfile = "merchant.profile"        // 修改店铺资料
+	AuditActionBusinessHours   = "merchant.business_hours" // 修改每周营业时间
+	AuditActionHolidaySet      = "merchant.holiday_set"    // 设置节假日
+	AuditActionHolidayDelete   = "merchant.holiday_delete" // 删除节假日
+
+	// 平台运营操作，记录在被操作的商户下（商户分类、运营账号等平台数据记录在商户ID 0 下）
+	AuditActionMerchantCreate  = "merchant.create"       // 创建商户
+	AuditActionMerchantUpdate  = "merchant.update"       // 修改商户信息及分类
+	AuditActionMerchantApprove = "merchant.approve"      // 审核通过
+	AuditActionMerchantReject  = "merchant.reject"       // 审核驳回
+	AuditActionMerchantLock    = "merchant.lock"         // 锁定商户
+	AuditActionMerchantUnlock  = "merchant.unlock"       // 解锁商户
+	AuditActionMerchantOwner   = "merchant.owner_create" // 创建商户主账号
+
+	AuditActionMerchantCategoryCreate = "merchant_category.create"
+	AuditActionMerchantCategoryUpdate = "merchant_category.update"
+	AuditActionMerchantCategoryDelete = "merchant_category.delete"
+
+	// 入驻申请审核通过时记录在新创建的商户下
+	AuditActionApplicationReview  = "application.review"  // 开始审核入驻申请
+	AuditActionApplicationComment = "application.comment" // 添加审核意见
+	AuditActionApplicationApprove = "application.approve" // 审核通过，创建商户及主账号
+	AuditActionApplicationReject  = "application.reject"  // 驳回
+
+	AuditActionOperatorLogin    = "operator.login"
+	AuditActionOperatorLogout   = "operator.logout"
+	AuditActionOperatorCreate   = "operator.create"
+	AuditActionOperatorStatus   = "operator.status"   // 启用/禁用运营账号
+	AuditActionOperatorPassword = "operator.password" // 修改密码
+
+	AuditActionWithdrawalCreate  = "withdrawal.create"  // 申请提现
+	AuditActionWithdrawalApprove = "withdrawal.approve" // 平台审核通过
+	AuditActionWithdrawalReject  = "withdrawal.reject"  // 平台驳回
+	AuditActionWithdrawalSubmit  = "withdrawal.submit"  // 平台重新提交出款
+
+	AuditActionPayoutAccountCreate = "payout_account.create"
+	AuditActionPayoutAccountUpdate = "payout_account.update"
+	AuditActionPayoutAccountDelete = "payout_account.delete"
+
+	AuditActionAPIKeyCreate = "api_key.create"
+	AuditActionAPIKeyRevoke = "api_key.revoke"
+)
+
+// 操作对象类型
+const (
+	AuditEntityAdmin         = "admin"
+	AuditEntityCategory      = "category"
+	AuditEntityProduct       = "product"
+	AuditEntityAPIKey        = "api_key"
+	AuditEntityMerchant      = "merchant"
+	AuditEntityWithdrawal    = "withdrawal"
+	AuditEntityPayoutAccount = "payout_account"
+
+	AuditEntityMerchantCategory = "merchant_category"
+	AuditEntityOperator         = "operator"
+	AuditEntityApplication      = "application"
+	AuditEntitySku              = "sku"
+)
+
+// auditCleanupBatch 清理过期日志时每批删除的条数，避免长时间锁表
+const auditCleanupBatch = 1000
+
+// AuditActor 当前请求的操作人，由中间件写入请求上下文
+type AuditActor struct {
+	MerID   int32
+	AdminID int32
+	Account string
+	IP      string
+	Route   string // 请求方法 + 路由，例如 PUT /mer_admin/product/:id
+}
+
+type auditActorKey struct{}
+
+// WithAuditActor 将操作人写入上下文
+func WithAuditActor(ctx context.Context, actor AuditActor) context.Context {
+	return context.WithValue(ctx, auditActorKey{}, actor)
+}
+
+// AuditActorFrom 从上下文读取操作人，不存在时返回零值
+func AuditActorFrom(ctx context.Context) AuditActor {
+	actor, _ := ctx.Value(auditActorKey{}).(AuditActor)
+	return actor
+}
+
+type AuditService struct {
+	ctx context.Context
+}
+
+func NewAuditService(ctx context.Context) *AuditService {
+	dao.SetDefault(database.GetDB())
+	return &AuditService{ctx: ctx}
+}
+
+// AuditEntry 操作日志内容，Before/After 序列化为 JSON 存储
+type AuditEntry struct {
+	MerID      int32
+	AdminID    int32
+	Account    string
+	IP         string
+	Action     string
+	Route      string
+	EntityType string
+	EntityID   int64
+	Before     interface{}
+	After      interface{}
+}
+
+// Record 写入一条操作日志
+func (s *AuditService) Record(entry *AuditEntry) error {
+	before, err := marshalAuditValue(entry.Before)
+	if err != nil {
+		return err
+	}
+	after, err := marshalAuditValue(entry.After)
+	if err != nil {
+		return err
+	}
+
+	log := &model.MerAdminAuditLog{
+		MerID:      entry.MerID,
+		AdminID:    entry.AdminID,
+		Account:    entry.Account,
+		IP:         entry.IP,
+		Action:     entry.Action,
+		Route:      entry.Route,
+		EntityType: entry.EntityType,
+		EntityID:   entry.EntityID,
+		Before:     before,
+		After:      after,
+		CreateAt:   time.Now(),
+	}
+	if err := dao.MerAdminAuditLog.WithContext(s.ctx).Create(log); err != nil {
+		return fmt.Errorf("记录操作日志失败: %w", err)
+	}
+	return nil
+}
+
+// Log 记录操作日志，写入失败不影响业务：
+// 未设置的操作人信息从请求上下文中读取；修改前后都有值时只保留有变化的字段，没有变化时不记录
+func (s *AuditService) Log(entry *AuditEntry) {
+	actor := AuditActorFrom(s.ctx)
+	if entry.MerID == 0 {
+		entry.MerID = actor.MerID
+	}
+	if entry.AdminID == 0 {
+		entry.AdminID = actor.AdminID
+	}
+	if entry.Account == "" {
+		entry.Account = actor.Account
+	}
+	if entry.IP == "" {
+		entry.IP = actor.IP
+	}
+	if entry.Route == "" {
+		entry.Route = actor.Route
+	}
+
+	if entry.Before != nil && entry.After != nil {
+		before, after, changed, err := auditDiff(entry.Before, entry.After)
+		if err != nil {
+			fmt.Printf("记录操作日志失败: %v\n", err)
+			return
+		}
+		if !changed {
+			return
+		}
+		entry.Before, entry.After = before, after
+	}
+
+	if err := s.Record(entry); err != nil {
+		fmt.Printf("记录操作日志失败: %v\n", err)
+	}
+}
+
+// AuditLogListRequest 操作日志查询条件
+type AuditLogListRequest struct {
+	Page       int    `form:"page,default=1"`
+	PageSize   int    `form:"page_size,default=20" binding:"max=100"`
+	AdminID    *int32 `form:"admin_id"`
+	Action     string `form:"action"` // 支持前缀匹配，例如 product. 查询所有商品操作
+	EntityType string `form:"entity_type"`
+	EntityID   *int64 `form:"entity_id"`
+	StartTime  string `form:"start_time"` // 格式 2006-01-02 15:04:05
+	EndTime    string `form:"end_time"`
+}
+
+// List 查询商户的操作日志，按时间倒序
+func (s *AuditService) List(merID int32, req *AuditLogListRequest) ([]*model.MerAdminAuditLog, int64, error) {
+	a := dao.MerAdminAuditLog
+	query := a.WithContext(s.ctx).Where(a.MerID.Eq(merID))
+
+	if req.AdminID != nil {
+		query = query.Where(a.AdminID.Eq(*req.AdminID))
+	}
+	if req.Action != "" {
+		if req.Action[len(req.Action)-1] == '.' {
+			query = query.Where(a.Action.Like(req.Action + "%"))
+		} else {
+			query = query.Where(a.Action.Eq(req.Action))
+		}
+	}
+	if req.EntityType != "" {
+		query = query.Where(a.EntityType.Eq(req.EntityType))
+	}
+	if req.EntityID != nil {
+		query = query.Where(a.EntityID.Eq(*req.EntityID))
+	}
+	if req.StartTime != "" {
+		start, err := time.ParseInLocation(time.DateTime, req.StartTime, time.Local)
+		if err != nil {
+			return nil, 0, fmt.Errorf("开始时间格式错误: %w", err)
+		}
+		query = query.Where(a.CreateAt.Gte(start))
+	}
+	if req.EndTime != "" {
+		end, err := time.ParseInLocation(time.DateTime, req.EndTime, time.Local)
+		if err != nil {
+			return nil, 0, fmt.Errorf("结束时间格式错误: %w", err)
+		}
+		query = query.Where(a.CreateAt.Lte(end))
+	}
+
+	total, err := query.Count()
+	if err != nil {
+		return nil, 0, fmt.Errorf("查询操作日志总数失败: %w", err)
+	}
+
+	list, err := query.
+		Order(a.CreateAt.Desc(), a.ID.Desc()).
+		Limit(req.PageSize).
+		Offset((req.Page - 1) * req.PageSize).
+		Find()
+	if err != nil {
+		return nil, 0, fmt.Errorf("查询操作日志失败: %w", err)
+	}
+	return list, total, nil
+}
+
+// Cleanup 分批删除指定时间之前的操作日志，返回删除的条数
+func (s *AuditService) Cleanup(before time.Time) (int64, error) {
+	a := dao.MerAdminAuditLog
+	var total int64
+	for {
+		info, err := a.WithContext(s.ctx).Where(a.CreateAt.Lt(before)).Limit(auditCleanupBatch).Delete()
+		if err != nil {
+			return total, fmt.Errorf("清理操作日志失败: %w", err)
+		}
+		total += info.RowsAffected
+		if info.RowsAffected < auditCleanupBatch {
+			return total, nil
+		}
+	}
+}
+
+// RunAuditRetention 按 audit.retention_days 定期清理过期的操作日志，ctx 取消时退出
+func RunAuditRetention(ctx context.Context) {
+	cfg := config.GlobalConfig.Audit
+	if cfg.RetentionDays <= 0 {
+		return
+	}
+	interval := time.Duration(cfg.CleanupInterval) * time.Second
+	if interval <= 0 {
+		interval = time.Hour
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		before := time.Now().AddDate(0, 0, -cfg.RetentionDays)
+		count, err := NewAuditService(ctx).Cleanup(before)
+		if err != nil {
+			fmt.Printf("%v\n", err)
+		} else if count > 0 {
+			fmt.Printf("已清理 %d 条过期操作日志\n", count)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// auditDiff 比较修改前后的数据，只保留有变化的字段
+func auditDiff(before, after interface{}) (map[string]interface{}, map[string]interface{}, bool, error) {
+	b, err := auditFields(before)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	a, err := auditFields(after)
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	diffBefore := make(map[string]interface{})
+	diffAfter := make(map[string]interface{})
+	for key, value := range b {
+		if !reflect.DeepEqual(value, a[key]) {
+			diffBefore[key] = value
+			diffAfter[key] = a[key]
+		}
+	}
+	for key, value := range a {
+		if _, ok := b[key]; !ok {
+			diffBefore[key] = nil
+			diffAfter[key] = value
+		}
+	}
+	return diffBefore, diffAfter, len(diffAfter) > 0, nil
+}
+
+// auditFields 将数据转换为字段 map（按 JSON 字段名）
+func auditFields(v interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("序列化操作日志失败: %w", err)
+	}
+	fields := make(map[string]interface{})
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("序列化操作日志失败: %w", err)
+	}
+	return fields, nil
+}
+
+// marshalAuditValue 序列化修改前后的数据，nil 不存储
+func marshalAuditValue(v interface{}) (*string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("序列化操作日志失败: %w", err)
+	}
+	str := string(data)
+	return &str, nil
+}
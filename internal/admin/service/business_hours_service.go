@@ -0,0 +1,509 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"merchant_api/internal/dao"
+	"merchant_api/internal/model"
+	"merchant_api/pkg/database"
+	"merchant_api/pkg/redis"
+	"sort"
+	"strings"
+	"time"
+	_ "time/tzdata" // 运行环境没有时区数据库时也能加载商户时区
+
+	redisv8 "github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Redis 键
+const merchantScheduleKeyFmt = "admin:merchant:%d:schedule" // 商户营业时间缓存
+
+// merchantScheduleTTL 商户营业时间缓存时间
+const merchantScheduleTTL = 10 * time.Minute
+
+// defaultMerchantTimezone 商户未设置时区时使用的时区
+const defaultMerchantTimezone = "Asia/Shanghai"
+
+const (
+	minutesPerDay     = 24 * 60
+	minutesPerWeek    = 7 * minutesPerDay
+	maxWindowsPerDay  = 8 // 每天（每个节假日）最多的营业时段数
+	maxHolidayAdvance = 366 * 24 * time.Hour
+)
+
+// TimeWindow 营业时段，时间为商户时区的 HH:MM；
+// 结束时间早于或等于开始时间表示跨午夜延续到次日，24:00 表示营业到当天结束
+type TimeWindow struct {
+	Open  string `json:"open" binding:"required,len=5"`
+	Close string `json:"close" binding:"required,len=5"`
+}
+
+// BusinessHourWindow 每周营业时段
+type BusinessHourWindow struct {
+	Weekday int32 `json:"weekday" binding:"min=0,max=6"` // 0 周日，1-6 周一至周六
+	TimeWindow
+}
+
+// BusinessHoliday 节假日营业时间，覆盖当天的每周营业时段
+type BusinessHoliday struct {
+	Date  string       `json:"date" binding:"required,len=10"` // YYYY-MM-DD
+	Name  string       `json:"name" binding:"max=32"`
+	Hours []TimeWindow `json:"hours" binding:"dive"` // 当天营业时段，为空表示全天休息
+}
+
+// BusinessHoursRequest 每周营业时间设置请求，整体替换原有时段
+type BusinessHoursRequest struct {
+	Timezone string               `json:"timezone" binding:"required,max=64"` // IANA 时区，例如 Asia/Shanghai
+	Weekly   []BusinessHourWindow `json:"weekly" binding:"dive"`
+}
+
+// BusinessSchedule 商户营业时间
+type BusinessSchedule struct {
+	Timezone string               `json:"timezone"`
+	Weekly   []BusinessHourWindow `json:"weekly"`
+	Holidays []BusinessHoliday    `json:"holidays"`
+}
+
+type BusinessHoursService struct {
+	ctx context.Context
+}
+
+func NewBusinessHoursService(ctx context.Context) *BusinessHoursService {
+	dao.SetDefault(database.GetDB())
+	return &BusinessHoursService{ctx: ctx}
+}
+
+// Get 获取商户营业时间，节假日只返回今天及以后的
+func (s *BusinessHoursService) Get(merID int32) (*BusinessSchedule, error) {
+	schedule, err := s.schedule(merID)
+	if err != nil {
+		return nil, err
+	}
+
+	today := time.Now().In(schedule.location()).Format(time.DateOnly)
+	result := *schedule
+	result.Holidays = make([]BusinessHoliday, 0, len(schedule.Holidays))
+	for _, holiday := range schedule.Holidays {
+		if holiday.Date >= today {
+			result.Holidays = append(result.Holidays, holiday)
+		}
+	}
+	return &result, nil
+}
+
+// UpdateWeekly 设置商户时区和每周营业时段（整体替换）
+func (s *BusinessHoursService) UpdateWeekly(merID int32, req *BusinessHoursRequest) (*BusinessSchedule, error) {
+	if _, err := time.LoadLocation(req.Timezone); err != nil {
+		return nil, fmt.Errorf("无效的时区: %s", req.Timezone)
+	}
+	if err := validateWeekly(req.Weekly); err != nil {
+		return nil, err
+	}
+
+	before, err := s.Get(merID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]*model.MerMerchantBusinessHour, 0, len(req.Weekly))
+	for _, w := range req.Weekly {
+		rows = append(rows, &model.MerMerchantBusinessHour{
+			MerID:     merID,
+			Weekday:   w.Weekday,
+			OpenTime:  w.Open,
+			CloseTime: w.Close,
+		})
+	}
+
+	err = dao.Q.Transaction(func(tx *dao.Query) error {
+		m := tx.MerMerchant
+		if _, err := m.WithContext(s.ctx).
+			Where(m.MerID.Eq(merID)).
+			Updates(map[string]interface{}{
+				"timezone":  req.Timezone,
+				"update_at": time.Now(),
+			}); err != nil {
+			return fmt.Errorf("更新商户时区失败: %w", err)
+		}
+
+		h := tx.MerMerchantBusinessHour
+		if _, err := h.WithContext(s.ctx).Where(h.MerID.Eq(merID)).Delete(); err != nil {
+			return fmt.Errorf("更新营业时间失败: %w", err)
+		}
+		if len(rows) > 0 {
+			if err := h.WithContext(s.ctx).Create(rows...); err != nil {
+				return fmt.Errorf("更新营业时间失败: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.invalidate(merID)
+
+	after, err := s.Get(merID)
+	if err != nil {
+		return nil, err
+	}
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		Action:     AuditActionBusinessHours,
+		EntityType: AuditEntityMerchant,
+		EntityID:   int64(merID),
+		Before:     map[string]interface{}{"timezone": before.Timezone, "weekly": before.Weekly},
+		After:      map[string]interface{}{"timezone": after.Timezone, "weekly": after.Weekly},
+	})
+	return after, nil
+}
+
+// SetHoliday 设置节假日营业时间，同一天已设置时覆盖
+func (s *BusinessHoursService) SetHoliday(merID int32, req *BusinessHoliday) (*BusinessHoliday, error) {
+	schedule, err := s.schedule(merID)
+	if err != nil {
+		return nil, err
+	}
+	date, err := time.ParseInLocation(time.DateOnly, req.Date, schedule.location())
+	if err != nil {
+		return nil, errors.New("日期格式错误，应为 YYYY-MM-DD")
+	}
+	now := time.Now().In(schedule.location())
+	if req.Date < now.Format(time.DateOnly) {
+		return nil, errors.New("不能设置已经过去的日期")
+	}
+	if date.Sub(now) > maxHolidayAdvance {
+		return nil, errors.New("最多只能提前一年设置节假日")
+	}
+	if err := validateDayWindows(req.Hours); err != nil {
+		return nil, err
+	}
+
+	holiday := &model.MerMerchantHoliday{
+		MerID:       merID,
+		HolidayDate: req.Date,
+		Name:        strings.TrimSpace(req.Name),
+		Hours:       formatTimeWindows(req.Hours),
+		UpdateAt:    time.Now(),
+	}
+	err = dao.MerMerchantHoliday.WithContext(s.ctx).
+		Clauses(clause.OnConflict{DoUpdates: clause.AssignmentColumns([]string{"name", "hours", "update_at"})}).
+		Create(holiday)
+	if err != nil {
+		return nil, fmt.Errorf("设置节假日失败: %w", err)
+	}
+	s.invalidate(merID)
+
+	result := toBusinessHoliday(holiday)
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		Action:     AuditActionHolidaySet,
+		EntityType: AuditEntityMerchant,
+		EntityID:   int64(merID),
+		After:      result,
+	})
+	return &result, nil
+}
+
+// DeleteHoliday 删除节假日设置，当天恢复每周营业时段
+func (s *BusinessHoursService) DeleteHoliday(merID int32, date string) error {
+	h := dao.MerMerchantHoliday
+	info, err := h.WithContext(s.ctx).
+		Where(h.MerID.Eq(merID), h.HolidayDate.Eq(date)).
+		Delete()
+	if err != nil {
+		return fmt.Errorf("删除节假日失败: %w", err)
+	}
+	if info.RowsAffected == 0 {
+		return errors.New("节假日不存在")
+	}
+	s.invalidate(merID)
+
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		Action:     AuditActionHolidayDelete,
+		EntityType: AuditEntityMerchant,
+		EntityID:   int64(merID),
+		Before:     map[string]interface{}{"date": date},
+	})
+	return nil
+}
+
+// IsOpen 判断商户在指定时间是否营业（按商户时区计算，节假日优先于每周营业时段）
+func (s *BusinessHoursService) IsOpen(merID int32, at time.Time) (bool, error) {
+	schedule, err := s.schedule(merID)
+	if err != nil {
+		return false, err
+	}
+
+	// 缓存中只有昨天及以后的节假日，更早的时间需要查询当天和前一天的节假日
+	loc := schedule.location()
+	local := at.In(loc)
+	from := local.AddDate(0, 0, -1).Format(time.DateOnly)
+	if from < time.Now().In(loc).AddDate(0, 0, -1).Format(time.DateOnly) {
+		holidays, err := s.loadHolidays(merID, from, local.Format(time.DateOnly))
+		if err != nil {
+			return false, err
+		}
+		past := *schedule
+		past.Holidays = holidays
+		schedule = &past
+	}
+	return schedule.IsOpen(at), nil
+}
+
+// IsOpen 判断指定时间是否在营业时段内：
+// 当天的营业时段，或前一天跨午夜延续到当天的营业时段
+func (bs *BusinessSchedule) IsOpen(at time.Time) bool {
+	local := at.In(bs.location())
+	minute := local.Hour()*60 + local.Minute()
+
+	for _, w := range bs.windowsOn(local) {
+		start, end := parseClock(w.Open), parseClock(w.Close)
+		if end > start {
+			if minute >= start && minute < end {
+				return true
+			}
+		} else if minute >= start {
+			return true
+		}
+	}
+
+	for _, w := range bs.windowsOn(local.AddDate(0, 0, -1)) {
+		start, end := parseClock(w.Open), parseClock(w.Close)
+		if end <= start && minute < end {
+			return true
+		}
+	}
+	return false
+}
+
+// windowsOn 指定日期（商户时区）的营业时段：有节假日设置时使用节假日的时段
+func (bs *BusinessSchedule) windowsOn(day time.Time) []TimeWindow {
+	date := day.Format(time.DateOnly)
+	for _, holiday := range bs.Holidays {
+		if holiday.Date == date {
+			return holiday.Hours
+		}
+	}
+
+	windows := make([]TimeWindow, 0)
+	for _, w := range bs.Weekly {
+		if w.Weekday == int32(day.Weekday()) {
+			windows = append(windows, w.TimeWindow)
+		}
+	}
+	return windows
+}
+
+// location 商户时区，无效时使用默认时区
+func (bs *BusinessSchedule) location() *time.Location {
+	if loc, err := time.LoadLocation(bs.Timezone); err == nil {
+		return loc
+	}
+	loc, _ := time.LoadLocation(defaultMerchantTimezone)
+	return loc
+}
+
+// schedule 读取商户营业时间（包含昨天及以后的节假日，用于判断跨午夜时段），缓存在 Redis 中
+func (s *BusinessHoursService) schedule(merID int32) (*BusinessSchedule, error) {
+	rdb := redis.GetRedis()
+	cacheKey := fmt.Sprintf(merchantScheduleKeyFmt, merID)
+
+	cached, err := rdb.Get(s.ctx, cacheKey).Result()
+	if err == nil {
+		var schedule BusinessSchedule
+		if json.Unmarshal([]byte(cached), &schedule) == nil {
+			return &schedule, nil
+		}
+	} else if err != redisv8.Nil {
+		return nil, fmt.Errorf("读取营业时间缓存失败: %w", err)
+	}
+
+	schedule, err := s.loadSchedule(merID)
+	if err != nil {
+		return nil, err
+	}
+	if data, err := json.Marshal(schedule); err == nil {
+		rdb.Set(s.ctx, cacheKey, data, merchantScheduleTTL)
+	}
+	return schedule, nil
+}
+
+func (s *BusinessHoursService) loadSchedule(merID int32) (*BusinessSchedule, error) {
+	m := dao.MerMerchant
+	merchant, err := m.WithContext(s.ctx).
+		Select(m.MerID, m.Timezone).
+		Where(m.MerID.Eq(merID), m.IsDel.Eq(0)).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("商户不存在")
+		}
+		return nil, fmt.Errorf("查询商户失败: %w", err)
+	}
+
+	schedule := &BusinessSchedule{
+		Timezone: merchant.Timezone,
+		Weekly:   make([]BusinessHourWindow, 0),
+	}
+	if schedule.Timezone == "" {
+		schedule.Timezone = defaultMerchantTimezone
+	}
+
+	h := dao.MerMerchantBusinessHour
+	hours, err := h.WithContext(s.ctx).
+		Where(h.MerID.Eq(merID)).
+		Order(h.Weekday, h.OpenTime).
+		Find()
+	if err != nil {
+		return nil, fmt.Errorf("查询营业时间失败: %w", err)
+	}
+	for _, row := range hours {
+		schedule.Weekly = append(schedule.Weekly, BusinessHourWindow{
+			Weekday:    row.Weekday,
+			TimeWindow: TimeWindow{Open: row.OpenTime, Close: row.CloseTime},
+		})
+	}
+
+	yesterday := time.Now().In(schedule.location()).AddDate(0, 0, -1).Format(time.DateOnly)
+	schedule.Holidays, err = s.loadHolidays(merID, yesterday, "")
+	if err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+// loadHolidays 查询 from 到 to（YYYY-MM-DD，包含两端）的节假日，to 为空表示不限制
+func (s *BusinessHoursService) loadHolidays(merID int32, from, to string) ([]BusinessHoliday, error) {
+	d := dao.MerMerchantHoliday
+	query := d.WithContext(s.ctx).Where(d.MerID.Eq(merID), d.HolidayDate.Gte(from))
+	if to != "" {
+		query = query.Where(d.HolidayDate.Lte(to))
+	}
+	rows, err := query.Order(d.HolidayDate).Find()
+	if err != nil {
+		return nil, fmt.Errorf("查询节假日失败: %w", err)
+	}
+
+	holidays := make([]BusinessHoliday, 0, len(rows))
+	for _, holiday := range rows {
+		holidays = append(holidays, toBusinessHoliday(holiday))
+	}
+	return holidays, nil
+}
+
+// invalidate 清除营业时间缓存，失败时只记录日志（缓存最多延迟 merchantScheduleTTL 过期）
+func (s *BusinessHoursService) invalidate(merID int32) {
+	if err := redis.GetRedis().Del(s.ctx, fmt.Sprintf(merchantScheduleKeyFmt, merID)).Err(); err != nil {
+		fmt.Printf("清除营业时间缓存失败: %v\n", err)
+	}
+}
+
+// validateWeekly 校验每周营业时段：时间格式正确，同一时间不能被多个时段覆盖（包括跨午夜延续到次日的部分）
+func validateWeekly(windows []BusinessHourWindow) error {
+	perDay := make(map[int32]int)
+	var covered [minutesPerWeek]bool
+	for _, w := range windows {
+		if w.Weekday < 0 || w.Weekday > 6 {
+			return fmt.Errorf("星期格式错误: %d", w.Weekday)
+		}
+		if perDay[w.Weekday]++; perDay[w.Weekday] > maxWindowsPerDay {
+			return fmt.Errorf("每天最多设置 %d 个营业时段", maxWindowsPerDay)
+		}
+		start, end, err := parseTimeWindow(w.TimeWindow)
+		if err != nil {
+			return err
+		}
+		if end <= start {
+			end += minutesPerDay
+		}
+		base := int(w.Weekday) * minutesPerDay
+		for minute := base + start; minute < base+end; minute++ {
+			if covered[minute%minutesPerWeek] {
+				return fmt.Errorf("营业时段重叠: %s %s-%s", weekdayName(w.Weekday), w.Open, w.Close)
+			}
+			covered[minute%minutesPerWeek] = true
+		}
+	}
+	return nil
+}
+
+// validateDayWindows 校验某一天的营业时段（节假日），各时段不能重叠
+func validateDayWindows(windows []TimeWindow) error {
+	if len(windows) > maxWindowsPerDay {
+		return fmt.Errorf("每天最多设置 %d 个营业时段", maxWindowsPerDay)
+	}
+	var covered [2 * minutesPerDay]bool
+	for _, w := range windows {
+		start, end, err := parseTimeWindow(w)
+		if err != nil {
+			return err
+		}
+		if end <= start {
+			end += minutesPerDay
+		}
+		for minute := start; minute < end; minute++ {
+			if covered[minute] {
+				return fmt.Errorf("营业时段重叠: %s-%s", w.Open, w.Close)
+			}
+			covered[minute] = true
+		}
+	}
+	return nil
+}
+
+// parseTimeWindow 解析营业时段，返回开始、结束时间（当天的分钟数）
+func parseTimeWindow(w TimeWindow) (int, int, error) {
+	start, end := parseClock(w.Open), parseClock(w.Close)
+	if start < 0 || start >= minutesPerDay {
+		return 0, 0, fmt.Errorf("开始时间格式错误: %s", w.Open)
+	}
+	if end < 0 {
+		return 0, 0, fmt.Errorf("结束时间格式错误: %s", w.Close)
+	}
+	if start == end {
+		return 0, 0, fmt.Errorf("开始时间和结束时间不能相同: %s-%s，全天营业请使用 00:00-24:00", w.Open, w.Close)
+	}
+	return start, end, nil
+}
+
+// parseClock 解析 HH:MM 为当天的分钟数（00:00-24:00），格式错误返回 -1
+func parseClock(value string) int {
+	if value == "24:00" {
+		return minutesPerDay
+	}
+	t, err := time.Parse("15:04", value)
+	if err != nil || len(value) != 5 {
+		return -1
+	}
+	return t.Hour()*60 + t.Minute()
+}
+
+// formatTimeWindows 按开始时间排序后转换为存储格式，例如 10:00-14:00,17:00-21:00
+func formatTimeWindows(windows []TimeWindow) string {
+	sorted := append([]TimeWindow(nil), windows...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Open < sorted[j].Open })
+
+	parts := make([]string, 0, len(sorted))
+	for _, w := range sorted {
+		parts = append(parts, w.Open+"-"+w.Close)
+	}
+	return strings.Join(parts, ",")
+}
+
+// toBusinessHoliday 转换节假日记录
+func toBusinessHoliday(holiday *model.MerMerchantHoliday) BusinessHoliday {
+	result := BusinessHoliday{Date: holiday.HolidayDate, Name: holiday.Name, Hours: make([]TimeWindow, 0)}
+	for _, part := range strings.Split(holiday.Hours, ",") {
+		if start, end, ok := strings.Cut(strings.TrimSpace(part), "-"); ok {
+			result.Hours = append(result.Hours, TimeWindow{Open: start, Close: end})
+		}
+	}
+	return result
+}
+
+// weekdayName 星期名称
+func weekdayName(weekday int32) string {
+	return [...]string{"周日", "周一", "周二", "周三", "周四", "周五", "周六"}[weekday]
+}
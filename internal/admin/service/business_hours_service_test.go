@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"database/sql/driver"
+	"merchant_api/internal/dao"
+	"merchant_api/pkg/database"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBusinessScheduleIsOpen(t *testing.T) {
+	schedule := &BusinessSchedule{
+		Timezone: "Asia/Shanghai",
+		Weekly: []BusinessHourWindow{
+			{Weekday: 1, TimeWindow: TimeWindow{Open: "22:00", Close: "02:00"}}, // 周一跨午夜到周二 02:00
+			{Weekday: 2, TimeWindow: TimeWindow{Open: "09:00", Close: "17:00"}},
+			{Weekday: 3, TimeWindow: TimeWindow{Open: "00:00", Close: "24:00"}}, // 周三全天
+		},
+		Holidays: []BusinessHoliday{
+			{Date: "2026-10-26", Name: "休息", Hours: []TimeWindow{}},                                  // 周一全天休息
+			{Date: "2026-11-02", Name: "提前营业", Hours: []TimeWindow{{Open: "20:00", Close: "01:00"}}}, // 周一改为 20:00 到次日 01:00
+		},
+	}
+	shanghai, _ := time.LoadLocation("Asia/Shanghai")
+	local := func(value string) time.Time {
+		at, err := time.ParseInLocation("2006-01-02 15:04", value, shanghai)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return at
+	}
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want bool
+	}{
+		{"周一开始营业前", local("2026-10-19 21:59"), false},
+		{"周一开始营业", local("2026-10-19 22:00"), true},
+		{"跨午夜延续到周二", local("2026-10-20 01:59"), true},
+		{"跨午夜时段结束", local("2026-10-20 02:00"), false},
+		{"周二营业", local("2026-10-20 09:00"), true},
+		{"周二结束时间不营业", local("2026-10-20 17:00"), false},
+		{"全天营业的开始", local("2026-10-21 00:00"), true},
+		{"全天营业的最后一分钟", local("2026-10-21 23:59"), true},
+		{"24:00 不延续到次日", local("2026-10-22 00:00"), false},
+		{"节假日休息", local("2026-10-26 23:00"), false},
+		{"节假日休息覆盖前一天跨午夜的时段", local("2026-10-27 01:00"), false},
+		{"节假日的营业时段", local("2026-11-02 20:30"), true},
+		{"节假日跨午夜延续到次日", local("2026-11-03 00:30"), true},
+		{"节假日跨午夜时段结束", local("2026-11-03 01:30"), false},
+		{"UTC 时间按商户时区计算", time.Date(2026, 10, 19, 14, 30, 0, 0, time.UTC), true},
+		{"UTC 时间在商户时区营业前", time.Date(2026, 10, 19, 13, 30, 0, 0, time.UTC), false},
+		{"其他时区的时间", time.Date(2026, 10, 19, 20, 0, 0, 0, time.FixedZone("UTC+3", 3*3600)), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := schedule.IsOpen(tt.at); got != tt.want {
+				t.Errorf("IsOpen(%s) = %v, want %v", tt.at.In(shanghai).Format(time.DateTime), got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBusinessScheduleIsOpenMerchantTimezone(t *testing.T) {
+	schedule := &BusinessSchedule{
+		Timezone: "America/New_York",
+		Weekly:   []BusinessHourWindow{{Weekday: 1, TimeWindow: TimeWindow{Open: "09:00", Close: "17:00"}}},
+	}
+	// 纽约 2026-10-19 周一 09:30（夏令时 UTC-4）
+	if !schedule.IsOpen(time.Date(2026, 10, 19, 13, 30, 0, 0, time.UTC)) {
+		t.Error("IsOpen() = false at 09:30 New York time, want true")
+	}
+	// 上海时间同一时刻是周一 21:30，不能按上海时区计算
+	if schedule.IsOpen(time.Date(2026, 10, 19, 8, 30, 0, 0, time.UTC)) {
+		t.Error("IsOpen() = true at 04:30 New York time, want false")
+	}
+}
+
+func TestLoadHolidaysRange(t *testing.T) {
+	var args []driver.Value
+	var query string
+	newFakeDB(t, func(q string, a []driver.Value) ([]string, [][]driver.Value) {
+		query, args = q, a
+		return []string{"holiday_date", "name", "hours"}, [][]driver.Value{
+			{"2025-01-01", "元旦", "10:00-14:00,17:00-21:00"},
+		}
+	})
+	dao.SetDefault(database.GetDB())
+
+	holidays, err := (&BusinessHoursService{ctx: context.Background()}).loadHolidays(1, "2024-12-31", "2025-01-01")
+	if err != nil {
+		t.Fatalf("loadHolidays() error = %v", err)
+	}
+	if !strings.Contains(query, "`holiday_date` <= ?") {
+		t.Errorf("query = %s, want upper bound on holiday_date", query)
+	}
+	if want := []driver.Value{int64(1), "2024-12-31", "2025-01-01"}; !reflect.DeepEqual(args, want) {
+		t.Errorf("args = %v, want %v", args, want)
+	}
+	want := []BusinessHoliday{{Date: "2025-01-01", Name: "元旦", Hours: []TimeWindow{
+		{Open: "10:00", Close: "14:00"}, {Open: "17:00", Close: "21:00"},
+	}}}
+	if !reflect.DeepEqual(holidays, want) {
+		t.Errorf("holidays = %+v, want %+v", holidays, want)
+	}
+}
@@ -0,0 +1,207 @@
+package service
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/base64"
+	"io"
+	"merchant_api/internal/pkg/fieldcrypt"
+	"merchant_api/pkg/config"
+	"merchant_api/pkg/database"
+	"strings"
+	"sync"
+	"testing"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// fakeDB 记录执行的 SQL 并按 query 函数返回查询结果的 database/sql 驱动，用于不连接 MySQL 的服务测试
+type fakeDB struct {
+	mu       sync.Mutex
+	execs    []fakeExec
+	query    func(query string, args []driver.Value) ([]string, [][]driver.Value)
+	queryErr error // 不为 nil 时所有查询返回该错误，模拟数据库故障
+}
+
+type fakeExec struct {
+	Query string
+	Args  []driver.Value
+}
+
+var (
+	fakeDBs      sync.Map // DSN -> *fakeDB
+	registerFake sync.Once
+)
+
+// newFakeDB 创建测试数据库并设置为 database.DB，测试结束后恢复
+func newFakeDB(t *testing.T, query func(query string, args []driver.Value) ([]string, [][]driver.Value)) *fakeDB {
+	t.Helper()
+	registerFake.Do(func() { sql.Register("fakedb", fakeDriver{}) })
+
+	fake := &fakeDB{query: query}
+	fakeDBs.Store(t.Name(), fake)
+	db, err := gorm.Open(mysql.New(mysql.Config{
+		DriverName:                "fakedb",
+		DSN:                       t.Name(),
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{
+		DisableAutomaticPing: true,
+		Logger:               logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("open fake db: %v", err)
+	}
+
+	old := database.DB
+	database.DB = db
+	t.Cleanup(func() {
+		database.DB = old
+		fakeDBs.Delete(t.Name())
+	})
+	return fake
+}
+
+// inserts 返回写入指定表的 INSERT 语句
+func (f *fakeDB) inserts(table string) []fakeExec {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var result []fakeExec
+	for _, exec := range f.execs {
+		if strings.HasPrefix(exec.Query, "INSERT INTO `"+table+"`") {
+			result = append(result, exec)
+		}
+	}
+	return result
+}
+
+// insertColumn 从 INSERT 语句中取出某一列写入的值，ok 为 false 表示语句中没有该列
+func insertColumn(exec fakeExec, column string) (driver.Value, bool) {
+	start := strings.Index(exec.Query, "(")
+	end := strings.Index(exec.Query, ") VALUES")
+	if start < 0 || end < 0 {
+		return nil, false
+	}
+	for i, name := range strings.Split(exec.Query[start+1:end], ",") {
+		if strings.Trim(name, "` ") == column {
+			return exec.Args[i], true
+		}
+	}
+	return nil, false
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(dsn string) (driver.Conn, error) {
+	fake, _ := fakeDBs.Load(dsn)
+	return &fakeConn{db: fake.(*fakeDB)}, nil
+}
+
+type fakeConn struct {
+	db *fakeDB
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{conn: c, query: query}, nil
+}
+
+func (c *fakeConn) Close() error              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) { return fakeTx{}, nil }
+
+func (c *fakeConn) exec(query string, args []driver.Value) (driver.Result, error) {
+	c.db.mu.Lock()
+	c.db.execs = append(c.db.execs, fakeExec{Query: query, Args: args})
+	c.db.mu.Unlock()
+	return fakeResult{}, nil
+}
+
+// fakeResult 每次写入影响 1 行，自增ID为 1
+type fakeResult struct{}
+
+func (fakeResult) LastInsertId() (int64, error) { return 1, nil }
+func (fakeResult) RowsAffected() (int64, error) { return 1, nil }
+
+func (c *fakeConn) queryRows(query string, args []driver.Value) (driver.Rows, error) {
+	if c.db.queryErr != nil {
+		return nil, c.db.queryErr
+	}
+	var columns []string
+	var rows [][]driver.Value
+	if c.db.query != nil {
+		columns, rows = c.db.query(query, args)
+	}
+	return &fakeRows{columns: columns, rows: rows}, nil
+}
+
+type fakeTx struct{}
+
+func (fakeTx) Commit() error   { return nil }
+func (fakeTx) Rollback() error { return nil }
+
+type fakeStmt struct {
+	conn  *fakeConn
+	query string
+}
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return s.conn.exec(s.query, args)
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return s.conn.queryRows(s.query, args)
+}
+
+type fakeRows struct {
+	columns []string
+	rows    [][]driver.Value
+	pos     int
+}
+
+func (r *fakeRows) Columns() []string { return r.columns }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.pos >= len(r.rows) {
+		return io.EOF
+	}
+	copy(dest, r.rows[r.pos])
+	r.pos++
+	return nil
+}
+
+// countRows COUNT 查询的结果
+func countRows(n int64) ([]string, [][]driver.Value) {
+	return []string{"count(*)"}, [][]driver.Value{{n}}
+}
+
+// initTestCrypto 使用随机密钥初始化字段加密
+func initTestCrypto(t *testing.T) {
+	t.Helper()
+	master := make([]byte, 32)
+	rand.Read(master)
+	wrap := func() string {
+		key := make([]byte, 32)
+		rand.Read(key)
+		block, _ := aes.NewCipher(master)
+		aead, _ := cipher.NewGCM(block)
+		nonce := make([]byte, aead.NonceSize())
+		rand.Read(nonce)
+		return base64.StdEncoding.EncodeToString(aead.Seal(nonce, nonce, key, nil))
+	}
+	err := fieldcrypt.Init(config.CryptoConfig{
+		MasterKey:     base64.StdEncoding.EncodeToString(master),
+		ActiveVersion: 1,
+		DataKeys:      []config.CryptoKeyConfig{{Version: 1, Key: wrap()}},
+		BlindIndexKey: wrap(),
+	})
+	if err != nil {
+		t.Fatalf("init crypto: %v", err)
+	}
+}
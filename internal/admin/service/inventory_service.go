@@ -0,0 +1,601 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"merchant_api/internal/dao"
+	"merchant_api/internal/model"
+	"merchant_api/pkg/config"
+	"merchant_api/pkg/database"
+	"sort"
+	"time"
+
+	"gorm.io/gen/field"
+	"gorm.io/gorm/clause"
+)
+
+// 库存变动类型
+const (
+	StockMovementInitial = "initial" // 创建 SKU 时的初始库存
+	StockMovementAdjust  = "adjust"  // 盘点调整
+	StockMovementReserve = "reserve" // 订单预占
+	StockMovementCommit  = "commit"  // 订单扣减
+	StockMovementRelease = "release" // 订单取消或超时释放
+)
+
+// 库存预占状态
+const (
+	ReservationReserved  = "reserved"
+	ReservationCommitted = "committed"
+	ReservationReleased  = "released"
+)
+
+// defaultReservationTTL 未配置 inventory.reservation_ttl 时的预占有效期（秒）
+const defaultReservationTTL = 900
+
+// reservationReleaseBatch 每次释放过期预占时处理的订单数
+const reservationReleaseBatch = 100
+
+// ErrInsufficientStock 可售库存（库存 - 已预占）不足
+var ErrInsufficientStock = errors.New("可售库存不足")
+
+// ErrReservationNotFound 订单没有库存预占记录
+var ErrReservationNotFound = errors.New("订单预占记录不存在")
+
+type InventoryService struct {
+	ctx context.Context
+}
+
+func NewInventoryService(ctx context.Context) *InventoryService {
+	dao.SetDefault(database.GetDB())
+	return &InventoryService{ctx: ctx}
+}
+
+// InventoryListRequest 库存列表查询条件
+type InventoryListRequest struct {
+	Page      int    `form:"page,default=1"`
+	PageSize  int    `form:"page_size,default=20" binding:"max=100"`
+	ProductID *int32 `form:"product_id"`
+	LowStock  bool   `form:"low_stock"` // 只看可售库存不高于预警值的 SKU
+}
+
+// InventoryItem SKU 库存信息
+type InventoryItem struct {
+	*model.MerStoreProductSku
+	StoreName  string `json:"store_name"`
+	Available  *int32 `json:"available"` // 可售库存 = 库存 - 已预占，未录入库存时为 null
+	IsLowStock bool   `json:"is_low_stock"`
+}
+
+// StockMovementListRequest 库存变动记录查询条件
+type StockMovementListRequest struct {
+	Page         int    `form:"page,default=1"`
+	PageSize     int    `form:"page_size,default=20" binding:"max=100"`
+	ProductSkuID *int32 `form:"product_sku_id"`
+	Type         string `form:"type"`
+	OrderNo      string `form:"order_no"`
+}
+
+// StockAdjustRequest 盘点调整请求，quantity 为正表示入库，为负表示出库
+type StockAdjustRequest struct {
+	Quantity int32  `json:"quantity" binding:"required"`
+	Remark   string `json:"remark" binding:"max=255"`
+}
+
+// LowStockRequest 设置低库存预警值，0 表示不预警
+type LowStockRequest struct {
+	LowStock *int32 `json:"low_stock" binding:"required,min=0"`
+}
+
+// StockReserveItem 预占的 SKU 及数量
+type StockReserveItem struct {
+	ProductSkuID int32 `json:"product_sku_id" binding:"required"`
+	Quantity     int32 `json:"quantity" binding:"required,min=1"`
+}
+
+// StockReserveRequest 订单预占库存请求
+type StockReserveRequest struct {
+	OrderNo string             `json:"order_no" binding:"required,max=64"`
+	Items   []StockReserveItem `json:"items" binding:"required,min=1,dive"`
+	TTL     int                `json:"ttl" binding:"omitempty,min=60,max=86400"` // 有效期（秒），不传使用 inventory.reservation_ttl
+}
+
+// List 查询商户的 SKU 库存
+func (s *InventoryService) List(merID int32, req *InventoryListRequest) ([]*InventoryItem, int64, error) {
+	sk := dao.MerStoreProductSku
+	p := dao.MerStoreProduct
+
+	products := p.WithContext(s.ctx).Select(p.ProductID).Where(p.MerID.Eq(merID), p.DeleteAt.IsNull())
+	if req.ProductID != nil {
+		products = products.Where(p.ProductID.Eq(*req.ProductID))
+	}
+	query := sk.WithContext(s.ctx).Where(sk.WithContext(s.ctx).Columns(sk.ProductID).In(products))
+	if req.LowStock {
+		query = query.Where(sk.LowStock.Gt(0), field.NewUnsafeFieldRaw("stock - reserved <= low_stock"))
+	}
+
+	total, err := query.Count()
+	if err != nil {
+		return nil, 0, fmt.Errorf("查询库存总数失败: %w", err)
+	}
+
+	skus, err := query.
+		Order(sk.ProductID.Desc(), sk.ProductSkuID).
+		Limit(req.PageSize).
+		Offset((req.Page - 1) * req.PageSize).
+		Find()
+	if err != nil {
+		return nil, 0, fmt.Errorf("查询库存失败: %w", err)
+	}
+
+	names := make(map[int32]string)
+	if len(skus) > 0 {
+		productIDs := make([]int32, 0, len(skus))
+		for _, sku := range skus {
+			productIDs = append(productIDs, sku.ProductID)
+		}
+		rows, err := p.WithContext(s.ctx).Select(p.ProductID, p.StoreName).Where(p.ProductID.In(productIDs...)).Find()
+		if err != nil {
+			return nil, 0, fmt.Errorf("查询商品失败: %w", err)
+		}
+		for _, row := range rows {
+			names[row.ProductID] = row.StoreName
+		}
+	}
+
+	list := make([]*InventoryItem, 0, len(skus))
+	for _, sku := range skus {
+		item := &InventoryItem{MerStoreProductSku: sku, StoreName: names[sku.ProductID]}
+		if sku.Stock != nil {
+			available := *sku.Stock - sku.Reserved
+			item.Available = &available
+			item.IsLowStock = sku.LowStock > 0 && available <= sku.LowStock
+		}
+		list = append(list, item)
+	}
+	return list, total, nil
+}
+
+// Movements 查询库存变动记录，按时间倒序
+func (s *InventoryService) Movements(merID int32, req *StockMovementListRequest) ([]*model.MerStoreStockMovement, int64, error) {
+	m := dao.MerStoreStockMovement
+	query := m.WithContext(s.ctx).Where(m.MerID.Eq(merID))
+	if req.ProductSkuID != nil {
+		query = query.Where(m.ProductSkuID.Eq(*req.ProductSkuID))
+	}
+	if req.Type != "" {
+		query = query.Where(m.Type.Eq(req.Type))
+	}
+	if req.OrderNo != "" {
+		query = query.Where(m.OrderNo.Eq(req.OrderNo))
+	}
+
+	total, err := query.Count()
+	if err != nil {
+		return nil, 0, fmt.Errorf("查询库存变动总数失败: %w", err)
+	}
+
+	list, err := query.
+		Order(m.MovementID.Desc()).
+		Limit(req.PageSize).
+		Offset((req.Page - 1) * req.PageSize).
+		Find()
+	if err != nil {
+		return nil, 0, fmt.Errorf("查询库存变动失败: %w", err)
+	}
+	return list, total, nil
+}
+
+// Adjust 盘点调整库存，调整后库存不能少于已预占数量；未录入库存的 SKU 从 0 开始调整，之后按库存控制销售
+func (s *InventoryService) Adjust(merID, skuID int32, req *StockAdjustRequest) (*model.MerStoreProductSku, error) {
+	var before, after model.MerStoreProductSku
+	err := dao.Q.Transaction(func(tx *dao.Query) error {
+		skus, err := s.lockSkus(tx, []int32{skuID})
+		if err != nil {
+			return err
+		}
+		if err := s.checkOwner(tx, merID, skus); err != nil {
+			return err
+		}
+
+		sku := skus[skuID]
+		before = *sku
+		if err := s.move(tx, merID, sku, StockMovementAdjust, req.Quantity, 0, "", req.Remark); err != nil {
+			return err
+		}
+		after = *sku
+		return s.refreshSaleStatus(tx, []int32{sku.ProductID})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		MerID:      merID,
+		Action:     AuditActionInventoryAdjust,
+		EntityType: AuditEntitySku,
+		EntityID:   int64(skuID),
+		Before:     map[string]interface{}{"stock": before.Stock},
+		After:      map[string]interface{}{"stock": after.Stock, "remark": req.Remark},
+	})
+	return &after, nil
+}
+
+// SetLowStock 设置 SKU 的低库存预警值
+func (s *InventoryService) SetLowStock(merID, skuID int32, lowStock int32) error {
+	sk := dao.MerStoreProductSku
+	sku, err := sk.WithContext(s.ctx).Where(sk.ProductSkuID.Eq(skuID)).First()
+	if err != nil {
+		return errors.New("SKU不存在或无权访问")
+	}
+	if err := s.checkOwner(dao.Q, merID, map[int32]*model.MerStoreProductSku{skuID: sku}); err != nil {
+		return err
+	}
+
+	if _, err := sk.WithContext(s.ctx).Where(sk.ProductSkuID.Eq(skuID)).Update(sk.LowStock, lowStock); err != nil {
+		return fmt.Errorf("更新低库存预警值失败: %w", err)
+	}
+
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		MerID:      merID,
+		Action:     AuditActionInventoryLowStock,
+		EntityType: AuditEntitySku,
+		EntityID:   int64(skuID),
+		Before:     map[string]interface{}{"low_stock": sku.LowStock},
+		After:      map[string]interface{}{"low_stock": lowStock},
+	})
+	return nil
+}
+
+// Reservations 查询订单的库存预占记录
+func (s *InventoryService) Reservations(merID int32, orderNo string) ([]*model.MerStoreStockReservation, error) {
+	r := dao.MerStoreStockReservation
+	list, err := r.WithContext(s.ctx).Where(r.MerID.Eq(merID), r.OrderNo.Eq(orderNo)).Order(r.ProductSkuID).Find()
+	if err != nil {
+		return nil, fmt.Errorf("查询预占记录失败: %w", err)
+	}
+	if len(list) == 0 {
+		return nil, ErrReservationNotFound
+	}
+	return list, nil
+}
+
+// Reserve 为订单预占库存，所有 SKU 全部预占成功或全部失败。
+// 同一订单重复调用时直接返回已有的预占记录，便于下单方超时重试
+func (s *InventoryService) Reserve(merID int32, req *StockReserveRequest) ([]*model.MerStoreStockReservation, error) {
+	ttl := req.TTL
+	if ttl <= 0 {
+		ttl = config.GlobalConfig.Inventory.ReservationTTL
+	}
+	if ttl <= 0 {
+		ttl = defaultReservationTTL
+	}
+
+	quantities := make(map[int32]int32)
+	for _, item := range req.Items {
+		quantities[item.ProductSkuID] += item.Quantity
+	}
+	skuIDs := make([]int32, 0, len(quantities))
+	for id := range quantities {
+		skuIDs = append(skuIDs, id)
+	}
+
+	var result []*model.MerStoreStockReservation
+	err := dao.Q.Transaction(func(tx *dao.Query) error {
+		r := tx.MerStoreStockReservation
+		existing, err := r.WithContext(s.ctx).Where(r.MerID.Eq(merID), r.OrderNo.Eq(req.OrderNo)).Order(r.ProductSkuID).Find()
+		if err != nil {
+			return fmt.Errorf("查询预占记录失败: %w", err)
+		}
+		if len(existing) > 0 {
+			result = existing
+			return nil
+		}
+
+		skus, err := s.lockSkus(tx, skuIDs)
+		if err != nil {
+			return err
+		}
+		if err := s.checkOwner(tx, merID, skus); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		expireAt := now.Add(time.Duration(ttl) * time.Second)
+		sort.Slice(skuIDs, func(i, j int) bool { return skuIDs[i] < skuIDs[j] })
+		for _, id := range skuIDs {
+			sku := skus[id]
+			if err := s.move(tx, merID, sku, StockMovementReserve, 0, quantities[id], req.OrderNo, ""); err != nil {
+				return err
+			}
+			result = append(result, &model.MerStoreStockReservation{
+				MerID:        merID,
+				OrderNo:      req.OrderNo,
+				ProductID:    sku.ProductID,
+				ProductSkuID: id,
+				Quantity:     quantities[id],
+				Status:       ReservationReserved,
+				ExpireAt:     expireAt,
+				CreateAt:     now,
+				UpdateAt:     now,
+			})
+		}
+		if err := r.WithContext(s.ctx).Create(result...); err != nil {
+			return fmt.Errorf("保存预占记录失败: %w", err)
+		}
+		return s.refreshSaleStatus(tx, skuProductIDs(skus))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Commit 订单支付后扣减预占的库存
+func (s *InventoryService) Commit(merID int32, orderNo string) ([]*model.MerStoreStockReservation, error) {
+	return s.settle(merID, orderNo, ReservationCommitted, "")
+}
+
+// Release 订单取消时释放预占的库存
+func (s *InventoryService) Release(merID int32, orderNo string, remark string) ([]*model.MerStoreStockReservation, error) {
+	return s.settle(merID, orderNo, ReservationReleased, remark)
+}
+
+// ReleaseExpired 释放已过期且未扣减的预占，返回释放的订单数
+func (s *InventoryService) ReleaseExpired() (int, error) {
+	r := dao.MerStoreStockReservation
+	var orders []struct {
+		MerID   int32
+		OrderNo string
+	}
+	err := r.WithContext(s.ctx).
+		Distinct(r.MerID, r.OrderNo).
+		Where(r.Status.Eq(ReservationReserved), r.ExpireAt.Lt(time.Now())).
+		Limit(reservationReleaseBatch).
+		Scan(&orders)
+	if err != nil {
+		return 0, fmt.Errorf("查询过期预占失败: %w", err)
+	}
+
+	released := 0
+	for _, order := range orders {
+		// 扫描之后订单可能已经扣减，settle 会拒绝并保持原状
+		if _, err := s.settle(order.MerID, order.OrderNo, ReservationReleased, "预占超时自动释放"); err != nil {
+			fmt.Printf("释放订单 %s 的库存预占失败: %v\n", order.OrderNo, err)
+			continue
+		}
+		released++
+	}
+	return released, nil
+}
+
+// RunReservationRelease 按 inventory.release_interval 定期释放过期的库存预占，ctx 取消时退出
+func RunReservationRelease(ctx context.Context) {
+	interval := time.Duration(config.GlobalConfig.Inventory.ReleaseInterval) * time.Second
+	if interval <= 0 {
+		interval = time.Minute
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		count, err := NewInventoryService(ctx).ReleaseExpired()
+		if err != nil {
+			fmt.Printf("%v\n", err)
+		} else if count > 0 {
+			fmt.Printf("已释放 %d 个订单的过期库存预占\n", count)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// settle 将订单的预占全部扣减或释放，重复调用同一操作时直接返回当前记录
+func (s *InventoryService) settle(merID int32, orderNo, to, remark string) ([]*model.MerStoreStockReservation, error) {
+	var result []*model.MerStoreStockReservation
+	err := dao.Q.Transaction(func(tx *dao.Query) error {
+		r := tx.MerStoreStockReservation
+		list, err := r.WithContext(s.ctx).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where(r.MerID.Eq(merID), r.OrderNo.Eq(orderNo)).
+			Order(r.ProductSkuID).
+			Find()
+		if err != nil {
+			return fmt.Errorf("查询预占记录失败: %w", err)
+		}
+		if len(list) == 0 {
+			return ErrReservationNotFound
+		}
+		result = list
+
+		pending := make([]*model.MerStoreStockReservation, 0, len(list))
+		for _, item := range list {
+			switch item.Status {
+			case ReservationReserved:
+				pending = append(pending, item)
+			case to:
+			case ReservationCommitted:
+				return errors.New("订单库存已扣减，不能释放")
+			default:
+				return errors.New("订单预占已释放，不能扣减")
+			}
+		}
+		if len(pending) == 0 {
+			return nil
+		}
+
+		skuIDs := make([]int32, 0, len(pending))
+		reservationIDs := make([]int64, 0, len(pending))
+		for _, item := range pending {
+			skuIDs = append(skuIDs, item.ProductSkuID)
+			reservationIDs = append(reservationIDs, item.ReservationID)
+		}
+		// 预占期间商品可能已被删除，这里不再校验商品状态，只按预占记录处理
+		skus, err := s.lockSkus(tx, skuIDs)
+		if err != nil {
+			return err
+		}
+		for _, item := range pending {
+			sku := skus[item.ProductSkuID]
+			if to == ReservationCommitted {
+				err = s.move(tx, merID, sku, StockMovementCommit, -item.Quantity, -item.Quantity, orderNo, remark)
+			} else {
+				err = s.move(tx, merID, sku, StockMovementRelease, 0, -item.Quantity, orderNo, remark)
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		now := time.Now()
+		if _, err := r.WithContext(s.ctx).
+			Where(r.ReservationID.In(reservationIDs...)).
+			Updates(map[string]interface{}{"status": to, "update_at": now}); err != nil {
+			return fmt.Errorf("更新预占记录失败: %w", err)
+		}
+		for _, item := range pending {
+			item.Status = to
+			item.UpdateAt = now
+		}
+		return s.refreshSaleStatus(tx, skuProductIDs(skus))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// lockSkus 按 ID 升序加行锁读取 SKU，固定加锁顺序避免并发下单时死锁
+func (s *InventoryService) lockSkus(tx *dao.Query, ids []int32) (map[int32]*model.MerStoreProductSku, error) {
+	sk := tx.MerStoreProductSku
+	list, err := sk.WithContext(s.ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where(sk.ProductSkuID.In(ids...)).
+		Order(sk.ProductSkuID).
+		Find()
+	if err != nil {
+		return nil, fmt.Errorf("锁定SKU失败: %w", err)
+	}
+
+	skus := make(map[int32]*model.MerStoreProductSku, len(list))
+	for _, sku := range list {
+		skus[sku.ProductSkuID] = sku
+	}
+	for _, id := range ids {
+		if skus[id] == nil {
+			return nil, fmt.Errorf("SKU %d 不存在或无权访问", id)
+		}
+	}
+	return skus, nil
+}
+
+// checkOwner 校验 SKU 所属商品属于该商户且未删除
+func (s *InventoryService) checkOwner(q *dao.Query, merID int32, skus map[int32]*model.MerStoreProductSku) error {
+	productIDs := skuProductIDs(skus)
+	p := q.MerStoreProduct
+	count, err := p.WithContext(s.ctx).
+		Where(p.ProductID.In(productIDs...), p.MerID.Eq(merID), p.DeleteAt.IsNull()).
+		Count()
+	if err != nil {
+		return fmt.Errorf("查询商品失败: %w", err)
+	}
+	if int(count) != len(productIDs) {
+		return errors.New("SKU不存在或无权访问")
+	}
+	return nil
+}
+
+// move 修改已加锁 SKU 的库存及预占数量，并记录变动。
+// 未录入库存的 SKU 不限制预占，扣减时库存仍为 NULL；盘点调整后开始记录库存
+func (s *InventoryService) move(tx *dao.Query, merID int32, sku *model.MerStoreProductSku, typ string, stockChange, reservedChange int32, orderNo, remark string) error {
+	var stock *int32
+	if sku.Stock != nil || typ == StockMovementAdjust {
+		after := stockChange
+		if sku.Stock != nil {
+			after += *sku.Stock
+		}
+		stock = &after
+	}
+	reserved := sku.Reserved + reservedChange
+	if reserved < 0 || (stock != nil && (*stock < 0 || reserved > *stock)) {
+		if typ == StockMovementAdjust {
+			return fmt.Errorf("SKU %d 调整后库存不能少于已预占数量 %d", sku.ProductSkuID, sku.Reserved)
+		}
+		return fmt.Errorf("SKU %d %w", sku.ProductSkuID, ErrInsufficientStock)
+	}
+
+	sk := tx.MerStoreProductSku
+	if _, err := sk.WithContext(s.ctx).
+		Where(sk.ProductSkuID.Eq(sku.ProductSkuID)).
+		Updates(map[string]interface{}{"stock": stock, "reserved": reserved}); err != nil {
+		return fmt.Errorf("更新SKU库存失败: %w", err)
+	}
+	sku.Stock = stock
+	sku.Reserved = reserved
+
+	movement := &model.MerStoreStockMovement{
+		MerID:          merID,
+		ProductID:      sku.ProductID,
+		ProductSkuID:   sku.ProductSkuID,
+		Type:           typ,
+		StockChange:    stockChange,
+		ReservedChange: reservedChange,
+		StockAfter:     stock,
+		ReservedAfter:  reserved,
+		OrderNo:        orderNo,
+		Remark:         remark,
+		CreatedBy:      AuditActorFrom(s.ctx).AdminID,
+		CreateAt:       time.Now(),
+	}
+	if err := tx.MerStoreStockMovement.WithContext(s.ctx).Create(movement); err != nil {
+		return fmt.Errorf("记录库存变动失败: %w", err)
+	}
+	return nil
+}
+
+// refreshSaleStatus 根据 SKU 可售库存更新商品销售状态，规则与 skuOnSale 相同：
+// 有 SKU 未录入库存或有可售库存时为销售中，否则标记售完
+func (s *InventoryService) refreshSaleStatus(tx *dao.Query, productIDs []int32) error {
+	sk := tx.MerStoreProductSku
+	p := tx.MerStoreProduct
+	for _, productID := range productIDs {
+		available, err := sk.WithContext(s.ctx).
+			Where(sk.ProductID.Eq(productID)).
+			Where(sk.Where(sk.Stock.IsNull()).Or(sk.Stock.GtCol(sk.Reserved))).
+			Count()
+		if err != nil {
+			return fmt.Errorf("查询SKU库存失败: %w", err)
+		}
+		onSale := available > 0
+		if _, err := p.WithContext(s.ctx).
+			Where(p.ProductID.Eq(productID), p.SaleStatus.Is(!onSale)).
+			Update(p.SaleStatus, onSale); err != nil {
+			return fmt.Errorf("更新商品销售状态失败: %w", err)
+		}
+	}
+	return nil
+}
+
+// skuOnSale SKU 是否可以销售：未录入库存（stock 为 nil）时不限制，否则需要有可售库存
+func skuOnSale(stock *int32, reserved int32) bool {
+	return stock == nil || *stock > reserved
+}
+
+// skuProductIDs 去重后的商品ID
+func skuProductIDs(skus map[int32]*model.MerStoreProductSku) []int32 {
+	seen := make(map[int32]bool)
+	ids := make([]int32, 0, len(skus))
+	for _, sku := range skus {
+		if !seen[sku.ProductID] {
+			seen[sku.ProductID] = true
+			ids = append(ids, sku.ProductID)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
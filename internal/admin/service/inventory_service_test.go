@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"merchant_api/internal/dao"
+	"merchant_api/internal/model"
+	"merchant_api/pkg/database"
+	"strings"
+	"testing"
+)
+
+func TestInventoryMove(t *testing.T) {
+	stock := func(n int32) *int32 { return &n }
+	tests := []struct {
+		name           string
+		stock          *int32
+		reserved       int32
+		typ            string
+		stockChange    int32
+		reservedChange int32
+		wantStock      *int32
+		wantReserved   int32
+		wantErr        error
+	}{
+		{"未录入库存的预占不受限制", nil, 0, StockMovementReserve, 0, 5, nil, 5, nil},
+		{"未录入库存的扣减", nil, 5, StockMovementCommit, -5, -5, nil, 0, nil},
+		{"未录入库存的盘点开始记录库存", nil, 2, StockMovementAdjust, 10, 0, stock(10), 2, nil},
+		{"未录入库存的盘点不能少于已预占", nil, 2, StockMovementAdjust, 1, 0, nil, 2, errors.New("")},
+		{"有库存时预占", stock(3), 1, StockMovementReserve, 0, 2, stock(3), 3, nil},
+		{"可售库存不足", stock(3), 1, StockMovementReserve, 0, 3, stock(3), 1, ErrInsufficientStock},
+		{"扣减", stock(3), 2, StockMovementCommit, -2, -2, stock(1), 0, nil},
+		{"释放", stock(3), 2, StockMovementRelease, 0, -2, stock(3), 0, nil},
+		{"盘点后少于已预占", stock(3), 2, StockMovementAdjust, -2, 0, stock(3), 2, errors.New("")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := newFakeDB(t, nil)
+			sku := &model.MerStoreProductSku{ProductSkuID: 7, ProductID: 3, Stock: tt.stock, Reserved: tt.reserved}
+			err := (&InventoryService{ctx: context.Background()}).
+				move(dao.Use(database.GetDB()), 1, sku, tt.typ, tt.stockChange, tt.reservedChange, "SO1", "")
+			if (err != nil) != (tt.wantErr != nil) {
+				t.Fatalf("move() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if errors.Is(tt.wantErr, ErrInsufficientStock) && !errors.Is(err, ErrInsufficientStock) {
+				t.Errorf("move() error = %v, want %v", err, ErrInsufficientStock)
+			}
+			if (sku.Stock == nil) != (tt.wantStock == nil) || (sku.Stock != nil && *sku.Stock != *tt.wantStock) {
+				t.Errorf("stock = %v, want %v", ptrValue(sku.Stock), ptrValue(tt.wantStock))
+			}
+			if sku.Reserved != tt.wantReserved {
+				t.Errorf("reserved = %d, want %d", sku.Reserved, tt.wantReserved)
+			}
+
+			movements := fake.inserts("mer_store_stock_movement")
+			if err != nil {
+				if len(movements) != 0 {
+					t.Errorf("got %d movements on error, want 0", len(movements))
+				}
+				return
+			}
+			if len(movements) != 1 {
+				t.Fatalf("got %d movements, want 1", len(movements))
+			}
+			if after, _ := insertColumn(movements[0], "stock_after"); (after == nil) != (tt.wantStock == nil) {
+				t.Errorf("stock_after = %v, want %v", after, ptrValue(tt.wantStock))
+			}
+		})
+	}
+}
+
+func TestRefreshSaleStatusCountsUntrackedSkus(t *testing.T) {
+	var queries []string
+	newFakeDB(t, func(query string, args []driver.Value) ([]string, [][]driver.Value) {
+		queries = append(queries, query)
+		return countRows(1)
+	})
+
+	if err := (&InventoryService{ctx: context.Background()}).refreshSaleStatus(dao.Use(database.GetDB()), []int32{3}); err != nil {
+		t.Fatalf("refreshSaleStatus() error = %v", err)
+	}
+	// 未录入库存的 SKU 与有可售库存的 SKU 一样算作可以销售
+	want := "(`mer_store_product_sku`.`stock` IS NULL OR `mer_store_product_sku`.`stock` > `mer_store_product_sku`.`reserved`)"
+	if len(queries) != 1 || !strings.Contains(queries[0], want) {
+		t.Errorf("queries = %q\nwant condition %s", queries, want)
+	}
+}
+
+func ptrValue(p *int32) interface{} {
+	if p == nil {
+		return nil
+	}
+	return *p
+}
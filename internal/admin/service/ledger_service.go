@@ -0,0 +1,434 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"merchant_api/internal/dao"
+	"merchant_api/internal/model"
+	"merchant_api/pkg/database"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 资金账户：available、frozen 为商户账户，贷方增加、借方减少，余额不能为负；
+// platform 为平台往来账户，作为入账、出款、调账的对方科目
+const (
+	LedgerAccountAvailable = "available" // 可用余额（与 mer_merchant.mer_money 一致）
+	LedgerAccountFrozen    = "frozen"    // 冻结金额
+	LedgerAccountPlatform  = "platform"  // 平台往来
+)
+
+// 记账方向
+const (
+	LedgerCredit = "credit" // 贷
+	LedgerDebit  = "debit"  // 借
+)
+
+// 记账原因
+const (
+	LedgerReasonOpening     = "opening"      // 期初余额
+	LedgerReasonOrderIncome = "order_income" // 订单收入
+	LedgerReasonRefund      = "refund"       // 订单退款
+	LedgerReasonFee         = "fee"          // 平台服务费
+	LedgerReasonAdjust      = "adjust"       // 平台调账
+
+	LedgerReasonWithdraw         = "withdraw"          // 提现申请（可用余额转入冻结）
+	LedgerReasonWithdrawReturn   = "withdraw_return"   // 提现驳回或出款失败（冻结金额退回可用余额）
+	LedgerReasonWithdrawPayout   = "withdraw_payout"   // 提现到账（冻结金额转出）
+	LedgerReasonWithdrawReversal = "withdraw_reversal" // 到账后被退回（退回可用余额）
+)
+
+// ledgerCheckLimit 对账时最多返回的借贷不平交易数
+const ledgerCheckLimit = 100
+
+var (
+	// ErrLedgerMismatch 商户表余额与流水余额不一致（余额被绕过流水直接修改），需要先对账处理
+	ErrLedgerMismatch = errors.New("商户余额与资金流水不一致，请先对账")
+	// ErrInsufficientBalance 商户账户余额不足
+	ErrInsufficientBalance = errors.New("余额不足")
+)
+
+// LedgerPosting 分录
+type LedgerPosting struct {
+	Account   string
+	Direction string
+	Amount    float64
+}
+
+// LedgerTransaction 记账交易，分录的借方合计必须等于贷方合计
+type LedgerTransaction struct {
+	MerID     int32
+	Reason    string
+	RefType   string // 关联单据，同一商户同一原因的同一单据只记账一次
+	RefID     string
+	Remark    string
+	CreatedBy int32 // 操作人管理员ID，0 为系统
+	Postings  []LedgerPosting
+}
+
+// NewLedgerTransfer 两个账户之间转账的交易：from 记借方，to 记贷方
+func NewLedgerTransfer(merID int32, reason, from, to string, amount float64) *LedgerTransaction {
+	return &LedgerTransaction{
+		MerID:  merID,
+		Reason: reason,
+		Postings: []LedgerPosting{
+			{Account: from, Direction: LedgerDebit, Amount: amount},
+			{Account: to, Direction: LedgerCredit, Amount: amount},
+		},
+	}
+}
+
+// LedgerListRequest 资金流水查询条件
+type LedgerListRequest struct {
+	Page      int    `form:"page,default=1"`
+	PageSize  int    `form:"page_size,default=20" binding:"max=100"`
+	Account   string `form:"account" binding:"omitempty,oneof=available frozen platform"`
+	Direction string `form:"direction" binding:"omitempty,oneof=credit debit"`
+	Reason    string `form:"reason"`
+	RefType   string `form:"ref_type"`
+	RefID     string `form:"ref_id"`
+	TxnNo     string `form:"txn_no"`
+	StartTime string `form:"start_time"` // 格式 2006-01-02 15:04:05
+	EndTime   string `form:"end_time"`
+}
+
+// LedgerCheckResult 对账结果
+type LedgerCheckResult struct {
+	MerMoney         float64  `json:"mer_money"`         // 商户表中的余额
+	LedgerAvailable  float64  `json:"ledger_available"`  // 按流水汇总的可用余额
+	RunningAvailable float64  `json:"running_available"` // 最后一条可用余额流水记录的余额
+	LedgerFrozen     float64  `json:"ledger_frozen"`     // 按流水汇总的冻结金额
+	RunningFrozen    float64  `json:"running_frozen"`    // 最后一条冻结金额流水记录的余额
+	UnbalancedTxns   []string `json:"unbalanced_txns"`   // 借贷不平的交易号
+	Consistent       bool     `json:"consistent"`
+}
+
+type LedgerService struct {
+	ctx context.Context
+}
+
+func NewLedgerService(ctx context.Context) *LedgerService {
+	dao.SetDefault(database.GetDB())
+	return &LedgerService{ctx: ctx}
+}
+
+// Post 记账，返回交易号；同一单据已记账时直接返回原交易号
+func (s *LedgerService) Post(txn *LedgerTransaction) (string, error) {
+	var txnNo string
+	err := dao.Q.Transaction(func(tx *dao.Query) error {
+		var err error
+		txnNo, err = s.PostTx(tx, txn)
+		return err
+	})
+	return txnNo, err
+}
+
+// PostTx 在调用方的事务中记账，用于和业务数据的修改一起提交：
+// 锁定商户行后按各账户最后一条流水计算余额，写入分录并同步 mer_money
+func (s *LedgerService) PostTx(tx *dao.Query, txn *LedgerTransaction) (string, error) {
+	deltas, err := ledgerDeltas(txn)
+	if err != nil {
+		return "", err
+	}
+
+	m := tx.MerMerchant
+	merchant, err := m.WithContext(s.ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select(m.MerID, m.MerMoney).
+		Where(m.MerID.Eq(txn.MerID)).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", errors.New("商户不存在")
+		}
+		return "", fmt.Errorf("锁定商户失败: %w", err)
+	}
+
+	l := tx.MerMerchantLedger
+	if txn.RefID != "" {
+		existing, err := l.WithContext(s.ctx).
+			Where(l.MerID.Eq(txn.MerID), l.RefType.Eq(txn.RefType), l.RefID.Eq(txn.RefID), l.Reason.Eq(txn.Reason)).
+			First()
+		if err == nil {
+			return existing.TxnNo, nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", fmt.Errorf("查询资金流水失败: %w", err)
+		}
+	}
+
+	// 各账户当前余额（分），商户表余额必须与可用余额流水一致
+	balances := make(map[string]int64, len(deltas)+1)
+	for _, account := range append([]string{LedgerAccountAvailable}, ledgerAccounts(deltas)...) {
+		if _, ok := balances[account]; ok {
+			continue
+		}
+		balance, err := s.runningBalance(tx, txn.MerID, account)
+		if err != nil {
+			return "", err
+		}
+		balances[account] = balance
+	}
+	if balances[LedgerAccountAvailable] != toCents(merchant.MerMoney) {
+		return "", ErrLedgerMismatch
+	}
+
+	for account, delta := range deltas {
+		if delta < 0 && account != LedgerAccountPlatform && balances[account]+delta < 0 {
+			if account == LedgerAccountFrozen {
+				return "", fmt.Errorf("冻结金额%w", ErrInsufficientBalance)
+			}
+			return "", fmt.Errorf("可用余额%w", ErrInsufficientBalance)
+		}
+	}
+
+	txnNo := strings.ReplaceAll(uuid.New().String(), "-", "")
+	now := time.Now()
+	entries := make([]*model.MerMerchantLedger, 0, len(txn.Postings))
+	for _, p := range txn.Postings {
+		amount := toCents(p.Amount)
+		if p.Direction == LedgerCredit {
+			balances[p.Account] += amount
+		} else {
+			balances[p.Account] -= amount
+		}
+		entries = append(entries, &model.MerMerchantLedger{
+			TxnNo:     txnNo,
+			MerID:     txn.MerID,
+			Account:   p.Account,
+			Direction: p.Direction,
+			Amount:    fromCents(amount),
+			Balance:   fromCents(balances[p.Account]),
+			Reason:    txn.Reason,
+			RefType:   txn.RefType,
+			RefID:     txn.RefID,
+			Remark:    txn.Remark,
+			CreatedBy: txn.CreatedBy,
+			CreateAt:  now,
+		})
+	}
+	if err := l.WithContext(s.ctx).Create(entries...); err != nil {
+		return "", fmt.Errorf("写入资金流水失败: %w", err)
+	}
+
+	if _, ok := deltas[LedgerAccountAvailable]; ok {
+		if _, err := m.WithContext(s.ctx).
+			Where(m.MerID.Eq(txn.MerID)).
+			Updates(map[string]interface{}{
+				"mer_money": fromCents(balances[LedgerAccountAvailable]),
+				"update_at": now,
+			}); err != nil {
+			return "", fmt.Errorf("更新商户余额失败: %w", err)
+		}
+	}
+	return txnNo, nil
+}
+
+// List 查询商户的资金流水，按记账顺序倒序
+func (s *LedgerService) List(merID int32, req *LedgerListRequest) ([]*model.MerMerchantLedger, int64, error) {
+	l := dao.MerMerchantLedger
+	query := l.WithContext(s.ctx).Where(l.MerID.Eq(merID))
+
+	if req.Account != "" {
+		query = query.Where(l.Account.Eq(req.Account))
+	}
+	if req.Direction != "" {
+		query = query.Where(l.Direction.Eq(req.Direction))
+	}
+	if req.Reason != "" {
+		query = query.Where(l.Reason.Eq(req.Reason))
+	}
+	if req.RefType != "" {
+		query = query.Where(l.RefType.Eq(req.RefType))
+	}
+	if req.RefID != "" {
+		query = query.Where(l.RefID.Eq(req.RefID))
+	}
+	if req.TxnNo != "" {
+		query = query.Where(l.TxnNo.Eq(req.TxnNo))
+	}
+	if req.StartTime != "" {
+		start, err := time.ParseInLocation(time.DateTime, req.StartTime, time.Local)
+		if err != nil {
+			return nil, 0, fmt.Errorf("开始时间格式错误: %w", err)
+		}
+		query = query.Where(l.CreateAt.Gte(start))
+	}
+	if req.EndTime != "" {
+		end, err := time.ParseInLocation(time.DateTime, req.EndTime, time.Local)
+		if err != nil {
+			return nil, 0, fmt.Errorf("结束时间格式错误: %w", err)
+		}
+		query = query.Where(l.CreateAt.Lte(end))
+	}
+
+	total, err := query.Count()
+	if err != nil {
+		return nil, 0, fmt.Errorf("查询资金流水总数失败: %w", err)
+	}
+
+	list, err := query.
+		Order(l.LedgerID.Desc()).
+		Limit(req.PageSize).
+		Offset((req.Page - 1) * req.PageSize).
+		Find()
+	if err != nil {
+		return nil, 0, fmt.Errorf("查询资金流水失败: %w", err)
+	}
+	return list, total, nil
+}
+
+// Check 对账：按流水重新汇总商户账户余额，与 mer_money 及流水记录的余额比较，并检查借贷是否平衡
+func (s *LedgerService) Check(merID int32) (*LedgerCheckResult, error) {
+	m := dao.MerMerchant
+	merchant, err := m.WithContext(s.ctx).
+		Select(m.MerID, m.MerMoney).
+		Where(m.MerID.Eq(merID)).
+		First()
+	if err != nil {
+		return nil, fmt.Errorf("查询商户失败: %w", err)
+	}
+
+	db := database.GetDB().WithContext(s.ctx)
+	var sums []struct {
+		Account string
+		Total   float64
+	}
+	err = db.Model(&model.MerMerchantLedger{}).
+		Select("account, SUM(IF(direction = ?, amount, -amount)) AS total", LedgerCredit).
+		Where("mer_id = ?", merID).
+		Group("account").
+		Scan(&sums).Error
+	if err != nil {
+		return nil, fmt.Errorf("汇总资金流水失败: %w", err)
+	}
+	totals := make(map[string]int64, len(sums))
+	for _, sum := range sums {
+		totals[sum.Account] = toCents(sum.Total)
+	}
+
+	unbalanced := make([]string, 0)
+	err = db.Model(&model.MerMerchantLedger{}).
+		Select("txn_no").
+		Where("mer_id = ?", merID).
+		Group("txn_no").
+		Having("SUM(IF(direction = ?, amount, -amount)) <> 0", LedgerCredit).
+		Limit(ledgerCheckLimit).
+		Pluck("txn_no", &unbalanced).Error
+	if err != nil {
+		return nil, fmt.Errorf("检查交易借贷平衡失败: %w", err)
+	}
+
+	runningAvailable, err := s.runningBalance(dao.Q, merID, LedgerAccountAvailable)
+	if err != nil {
+		return nil, err
+	}
+	runningFrozen, err := s.runningBalance(dao.Q, merID, LedgerAccountFrozen)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LedgerCheckResult{
+		MerMoney:         merchant.MerMoney,
+		LedgerAvailable:  fromCents(totals[LedgerAccountAvailable]),
+		RunningAvailable: fromCents(runningAvailable),
+		LedgerFrozen:     fromCents(totals[LedgerAccountFrozen]),
+		RunningFrozen:    fromCents(runningFrozen),
+		UnbalancedTxns:   unbalanced,
+		Consistent: toCents(merchant.MerMoney) == totals[LedgerAccountAvailable] &&
+			runningAvailable == totals[LedgerAccountAvailable] &&
+			runningFrozen == totals[LedgerAccountFrozen] &&
+			len(unbalanced) == 0,
+	}, nil
+}
+
+// Balance 商户账户当前余额（最后一条流水记录的余额）
+func (s *LedgerService) Balance(merID int32, account string) (float64, error) {
+	balance, err := s.runningBalance(dao.Q, merID, account)
+	if err != nil {
+		return 0, err
+	}
+	return fromCents(balance), nil
+}
+
+// runningBalance 账户最后一条流水记录的余额（分），没有流水时为 0
+func (s *LedgerService) runningBalance(q *dao.Query, merID int32, account string) (int64, error) {
+	l := q.MerMerchantLedger
+	last, err := l.WithContext(s.ctx).
+		Select(l.LedgerID, l.Balance).
+		Where(l.MerID.Eq(merID), l.Account.Eq(account)).
+		Order(l.LedgerID.Desc()).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("查询账户余额失败: %w", err)
+	}
+	return toCents(last.Balance), nil
+}
+
+// ledgerDeltas 校验交易分录并返回各账户的变动金额（分）
+func ledgerDeltas(txn *LedgerTransaction) (map[string]int64, error) {
+	if txn.Reason == "" {
+		return nil, errors.New("记账原因不能为空")
+	}
+	if len(txn.Postings) < 2 {
+		return nil, errors.New("每笔交易至少需要两条分录")
+	}
+
+	deltas := make(map[string]int64)
+	var debit, credit int64
+	for _, p := range txn.Postings {
+		switch p.Account {
+		case LedgerAccountAvailable, LedgerAccountFrozen, LedgerAccountPlatform:
+		default:
+			return nil, fmt.Errorf("无效的资金账户: %s", p.Account)
+		}
+		amount := toCents(p.Amount)
+		if amount <= 0 {
+			return nil, errors.New("记账金额必须大于 0")
+		}
+		switch p.Direction {
+		case LedgerCredit:
+			credit += amount
+			deltas[p.Account] += amount
+		case LedgerDebit:
+			debit += amount
+			deltas[p.Account] -= amount
+		default:
+			return nil, fmt.Errorf("无效的记账方向: %s", p.Direction)
+		}
+	}
+	if debit != credit {
+		return nil, errors.New("借贷金额不平衡")
+	}
+	return deltas, nil
+}
+
+// ledgerAccounts 交易涉及的账户，按名称排序（固定查询顺序）
+func ledgerAccounts(deltas map[string]int64) []string {
+	accounts := make([]string, 0, len(deltas))
+	for account := range deltas {
+		accounts = append(accounts, account)
+	}
+	sort.Strings(accounts)
+	return accounts
+}
+
+// toCents 金额转换为分，避免浮点误差累积
+func toCents(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
+
+// fromCents 分转换为金额
+func fromCents(cents int64) float64 {
+	return float64(cents) / 100
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestToCents(t *testing.T) {
+	tests := []struct {
+		amount float64
+		want   int64
+	}{
+		{0, 0},
+		{0.01, 1},
+		{19.99, 1999},
+		{0.1 + 0.2, 30},
+		{1234567.89, 123456789},
+		{0.005, 1},
+		{0.004, 0},
+		{-12.34, -1234},
+	}
+	for _, tt := range tests {
+		if got := toCents(tt.amount); got != tt.want {
+			t.Errorf("toCents(%v) = %d, want %d", tt.amount, got, tt.want)
+		}
+	}
+}
+
+func TestLedgerDeltas(t *testing.T) {
+	posting := func(account, direction string, amount float64) LedgerPosting {
+		return LedgerPosting{Account: account, Direction: direction, Amount: amount}
+	}
+	tests := []struct {
+		name    string
+		txn     *LedgerTransaction
+		want    map[string]int64
+		wantErr bool
+	}{
+		{
+			name: "订单收入",
+			txn: &LedgerTransaction{Reason: LedgerReasonOrderIncome, Postings: []LedgerPosting{
+				posting(LedgerAccountPlatform, LedgerDebit, 99.9),
+				posting(LedgerAccountAvailable, LedgerCredit, 99.9),
+			}},
+			want: map[string]int64{LedgerAccountPlatform: -9990, LedgerAccountAvailable: 9990},
+		},
+		{
+			name: "提现冻结",
+			txn:  NewLedgerTransfer(1, LedgerReasonWithdraw, LedgerAccountAvailable, LedgerAccountFrozen, 50),
+			want: map[string]int64{LedgerAccountAvailable: -5000, LedgerAccountFrozen: 5000},
+		},
+		{
+			name: "多条分录合并到同一账户",
+			txn: &LedgerTransaction{Reason: LedgerReasonFee, Postings: []LedgerPosting{
+				posting(LedgerAccountPlatform, LedgerDebit, 100),
+				posting(LedgerAccountAvailable, LedgerCredit, 100),
+				posting(LedgerAccountAvailable, LedgerDebit, 3.5),
+				posting(LedgerAccountPlatform, LedgerCredit, 3.5),
+			}},
+			want: map[string]int64{LedgerAccountPlatform: -9650, LedgerAccountAvailable: 9650},
+		},
+		{
+			name: "浮点金额按分比较借贷",
+			txn: &LedgerTransaction{Reason: LedgerReasonAdjust, Postings: []LedgerPosting{
+				posting(LedgerAccountPlatform, LedgerDebit, 0.3),
+				posting(LedgerAccountAvailable, LedgerCredit, 0.1),
+				posting(LedgerAccountAvailable, LedgerCredit, 0.2),
+			}},
+			want: map[string]int64{LedgerAccountPlatform: -30, LedgerAccountAvailable: 30},
+		},
+		{
+			name: "借贷不平",
+			txn: &LedgerTransaction{Reason: LedgerReasonAdjust, Postings: []LedgerPosting{
+				posting(LedgerAccountPlatform, LedgerDebit, 10),
+				posting(LedgerAccountAvailable, LedgerCredit, 10.01),
+			}},
+			wantErr: true,
+		},
+		{
+			name:    "没有记账原因",
+			txn:     NewLedgerTransfer(1, "", LedgerAccountPlatform, LedgerAccountAvailable, 10),
+			wantErr: true,
+		},
+		{
+			name: "只有一条分录",
+			txn: &LedgerTransaction{Reason: LedgerReasonAdjust, Postings: []LedgerPosting{
+				posting(LedgerAccountAvailable, LedgerCredit, 10),
+			}},
+			wantErr: true,
+		},
+		{
+			name:    "金额不足一分",
+			txn:     NewLedgerTransfer(1, LedgerReasonAdjust, LedgerAccountPlatform, LedgerAccountAvailable, 0.004),
+			wantErr: true,
+		},
+		{
+			name:    "负数金额",
+			txn:     NewLedgerTransfer(1, LedgerReasonAdjust, LedgerAccountPlatform, LedgerAccountAvailable, -10),
+			wantErr: true,
+		},
+		{
+			name:    "无效账户",
+			txn:     NewLedgerTransfer(1, LedgerReasonAdjust, LedgerAccountPlatform, "bank", 10),
+			wantErr: true,
+		},
+		{
+			name: "无效方向",
+			txn: &LedgerTransaction{Reason: LedgerReasonAdjust, Postings: []LedgerPosting{
+				posting(LedgerAccountPlatform, LedgerDebit, 10),
+				posting(LedgerAccountAvailable, "in", 10),
+			}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ledgerDeltas(tt.txn)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ledgerDeltas() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ledgerDeltas() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLedgerAccountsSorted(t *testing.T) {
+	got := ledgerAccounts(map[string]int64{LedgerAccountPlatform: -1, LedgerAccountAvailable: 1, LedgerAccountFrozen: 0})
+	want := []string{LedgerAccountAvailable, LedgerAccountFrozen, LedgerAccountPlatform}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ledgerAccounts() = %v, want %v", got, want)
+	}
+}
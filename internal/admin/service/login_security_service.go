@@ -0,0 +1,298 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"merchant_api/internal/model"
+	"merchant_api/internal/pkg/captcha"
+	"merchant_api/internal/pkg/utils"
+	"merchant_api/pkg/config"
+	"merchant_api/pkg/redis"
+	"strconv"
+	"strings"
+	"time"
+
+	redisv8 "github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+)
+
+// Redis 键
+const (
+	loginFailKeyFmt     = "admin:login_fail:%s"    // 登录失败记录（ZSET，score 为失败时间）
+	loginLockKeyFmt     = "admin:login_lock:%s"    // 登录锁定
+	loginCaptchaKeyFmt  = "admin:login_captcha:%s" // 锁定解除后仍需验证码
+	adminCaptchaKeyFmt  = "admin:captcha:%s"       // 验证码 -> 答案
+	loginCaptchaLength  = 4
+	loginSubjectAccount = "account:"
+	loginSubjectIP      = "ip:"
+)
+
+var (
+	ErrCaptchaRequired = errors.New("请输入验证码")
+	ErrCaptchaInvalid  = errors.New("验证码错误或已过期")
+)
+
+// LoginLockedError 登录失败次数过多，账号或 IP 被临时锁定
+type LoginLockedError struct {
+	IP         bool          // true 表示 IP 被锁定，否则为账号被锁定
+	RetryAfter time.Duration // 剩余锁定时间
+}
+
+func (e *LoginLockedError) Error() string {
+	if e.IP {
+		return fmt.Sprintf("登录失败次数过多，IP 已被锁定，请%d分钟后再试", e.Minutes())
+	}
+	return fmt.Sprintf("登录失败次数过多，账号已被锁定，请%d分钟后再试", e.Minutes())
+}
+
+// Minutes 剩余锁定分钟数（向上取整）
+func (e *LoginLockedError) Minutes() int {
+	return int((e.RetryAfter + time.Minute - 1) / time.Minute)
+}
+
+// CaptchaInput 登录时提交的验证码
+type CaptchaInput struct {
+	ID   string
+	Code string
+}
+
+// CaptchaResponse 验证码响应
+type CaptchaResponse struct {
+	CaptchaID string `json:"captcha_id"`
+	Image     string `json:"image"` // data:image/png;base64,...
+	ExpiresIn int    `json:"expires_in"`
+}
+
+// LoginSecurityService 登录防暴力破解：按账号和 IP 统计滑动窗口内的失败次数，
+// 超过阈值后要求验证码，继续失败则临时锁定
+type LoginSecurityService struct {
+	ctx context.Context
+}
+
+func NewLoginSecurityService(ctx context.Context) *LoginSecurityService {
+	return &LoginSecurityService{ctx: ctx}
+}
+
+// accountSubject 账号维度的统计对象：已存在的管理员按 ID 统计（账号和手机号共用），
+// 不存在的账号按登录名统计，两者锁定行为一致，避免暴露账号是否存在
+func accountSubject(account string, admin *model.MerMerchantAdmin) string {
+	if admin != nil {
+		return loginSubjectAccount + strconv.Itoa(int(admin.MerchantAdminID))
+	}
+	return loginSubjectAccount + strings.ToLower(strings.TrimSpace(account))
+}
+
+// Check 登录前检查锁定状态和验证码
+func (s *LoginSecurityService) Check(account string, admin *model.MerMerchantAdmin, ip string, input *CaptchaInput) error {
+	if err := s.CheckLocked(account, admin, ip); err != nil {
+		return err
+	}
+
+	subjects := []string{loginSubjectIP + ip, accountSubject(account, admin)}
+	required, err := s.captchaRequired(subjects)
+	if err != nil {
+		return err
+	}
+	if !required {
+		return nil
+	}
+	if input == nil || input.ID == "" || input.Code == "" {
+		return ErrCaptchaRequired
+	}
+	return s.VerifyCaptcha(input.ID, input.Code)
+}
+
+// CheckLocked 检查账号或 IP 是否处于锁定状态
+func (s *LoginSecurityService) CheckLocked(account string, admin *model.MerMerchantAdmin, ip string) error {
+	rdb := redis.GetRedis()
+	for _, subject := range []string{loginSubjectIP + ip, accountSubject(account, admin)} {
+		ttl, err := rdb.PTTL(s.ctx, fmt.Sprintf(loginLockKeyFmt, subject)).Result()
+		if err != nil {
+			return fmt.Errorf("查询登录锁定状态失败: %w", err)
+		}
+		if ttl > 0 {
+			return &LoginLockedError{IP: strings.HasPrefix(subject, loginSubjectIP), RetryAfter: ttl}
+		}
+	}
+	return nil
+}
+
+// captchaRequired 任一统计对象失败次数达到阈值，或处于锁定后的验证码状态时需要验证码
+func (s *LoginSecurityService) captchaRequired(subjects []string) (bool, error) {
+	cfg := config.GlobalConfig.LoginSecurity
+	rdb := redis.GetRedis()
+	for _, subject := range subjects {
+		exists, err := rdb.Exists(s.ctx, fmt.Sprintf(loginCaptchaKeyFmt, subject)).Result()
+		if err != nil {
+			return false, fmt.Errorf("查询登录状态失败: %w", err)
+		}
+		if exists > 0 {
+			return true, nil
+		}
+
+		count, err := s.countFailures(subject)
+		if err != nil {
+			return false, err
+		}
+		if cfg.CaptchaThreshold > 0 && count >= int64(cfg.CaptchaThreshold) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// countFailures 统计窗口内的失败次数
+func (s *LoginSecurityService) countFailures(subject string) (int64, error) {
+	window := time.Duration(config.GlobalConfig.LoginSecurity.Window) * time.Second
+	min := strconv.FormatInt(time.Now().Add(-window).UnixMilli(), 10)
+	count, err := redis.GetRedis().ZCount(s.ctx, fmt.Sprintf(loginFailKeyFmt, subject), min, "+inf").Result()
+	if err != nil {
+		return 0, fmt.Errorf("查询登录失败次数失败: %w", err)
+	}
+	return count, nil
+}
+
+// RecordFailure 记录一次登录失败，达到阈值时锁定并写入操作日志。
+// 本次失败触发锁定时返回 LoginLockedError
+func (s *LoginSecurityService) RecordFailure(account string, admin *model.MerMerchantAdmin, ip string) error {
+	cfg := config.GlobalConfig.LoginSecurity
+
+	accountCount, err := s.addFailure(accountSubject(account, admin))
+	if err != nil {
+		return err
+	}
+	ipCount, err := s.addFailure(loginSubjectIP + ip)
+	if err != nil {
+		return err
+	}
+
+	lockTTL := time.Duration(cfg.LockDuration) * time.Second
+	if cfg.IPMaxFailures > 0 && ipCount >= int64(cfg.IPMaxFailures) {
+		if err := s.lock(loginSubjectIP + ip); err != nil {
+			return err
+		}
+		s.audit(&AuditEntry{
+			IP:         ip,
+			Action:     AuditActionIPLocked,
+			Account:    account,
+			EntityType: AuditEntityAdmin,
+		}, admin)
+		return &LoginLockedError{IP: true, RetryAfter: lockTTL}
+	}
+	if cfg.AccountMaxFailures > 0 && accountCount >= int64(cfg.AccountMaxFailures) {
+		if err := s.lock(accountSubject(account, admin)); err != nil {
+			return err
+		}
+		s.audit(&AuditEntry{
+			IP:         ip,
+			Action:     AuditActionLoginLocked,
+			Account:    account,
+			EntityType: AuditEntityAdmin,
+		}, admin)
+		return &LoginLockedError{RetryAfter: lockTTL}
+	}
+	return nil
+}
+
+// RecordSuccess 登录成功后清除账号的失败记录（IP 维度的记录保留）
+func (s *LoginSecurityService) RecordSuccess(account string, admin *model.MerMerchantAdmin) error {
+	subject := accountSubject(account, admin)
+	err := redis.GetRedis().Del(s.ctx,
+		fmt.Sprintf(loginFailKeyFmt, subject),
+		fmt.Sprintf(loginCaptchaKeyFmt, subject),
+	).Err()
+	if err != nil {
+		return fmt.Errorf("清除登录失败记录失败: %w", err)
+	}
+	return nil
+}
+
+// addFailure 写入失败记录并清理窗口外的记录，返回窗口内的失败次数
+func (s *LoginSecurityService) addFailure(subject string) (int64, error) {
+	window := time.Duration(config.GlobalConfig.LoginSecurity.Window) * time.Second
+	now := time.Now()
+	key := fmt.Sprintf(loginFailKeyFmt, subject)
+
+	var count *redisv8.IntCmd
+	_, err := redis.GetRedis().TxPipelined(s.ctx, func(pipe redisv8.Pipeliner) error {
+		pipe.ZRemRangeByScore(s.ctx, key, "-inf", strconv.FormatInt(now.Add(-window).UnixMilli(), 10))
+		pipe.ZAdd(s.ctx, key, &redisv8.Z{Score: float64(now.UnixMilli()), Member: uuid.New().String()})
+		count = pipe.ZCard(s.ctx, key)
+		pipe.Expire(s.ctx, key, window)
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("记录登录失败失败: %w", err)
+	}
+	return count.Val(), nil
+}
+
+// lock 锁定统计对象，锁定解除后在一个统计窗口内仍需验证码
+func (s *LoginSecurityService) lock(subject string) error {
+	cfg := config.GlobalConfig.LoginSecurity
+	lockTTL := time.Duration(cfg.LockDuration) * time.Second
+	captchaTTL := lockTTL + time.Duration(cfg.Window)*time.Second
+
+	_, err := redis.GetRedis().TxPipelined(s.ctx, func(pipe redisv8.Pipeliner) error {
+		pipe.Set(s.ctx, fmt.Sprintf(loginLockKeyFmt, subject), 1, lockTTL)
+		pipe.Set(s.ctx, fmt.Sprintf(loginCaptchaKeyFmt, subject), 1, captchaTTL)
+		pipe.Del(s.ctx, fmt.Sprintf(loginFailKeyFmt, subject))
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("锁定登录失败: %w", err)
+	}
+	return nil
+}
+
+// audit 记录锁定事件，账号存在时记录到其所属商户下，商户管理员可在操作日志中查看
+func (s *LoginSecurityService) audit(entry *AuditEntry, admin *model.MerMerchantAdmin) {
+	if admin != nil {
+		entry.MerID = admin.MerID
+		entry.AdminID = admin.MerchantAdminID
+		entry.Account = admin.Account
+		entry.EntityID = int64(admin.MerchantAdminID)
+	}
+	NewAuditService(s.ctx).Log(entry)
+}
+
+// GenerateCaptcha 生成登录验证码
+func (s *LoginSecurityService) GenerateCaptcha() (*CaptchaResponse, error) {
+	code, img, err := captcha.Generate(loginCaptchaLength)
+	if err != nil {
+		return nil, fmt.Errorf("生成验证码失败: %w", err)
+	}
+	id, err := utils.RandomToken(16)
+	if err != nil {
+		return nil, fmt.Errorf("生成验证码失败: %w", err)
+	}
+
+	expire := config.GlobalConfig.LoginSecurity.CaptchaExpire
+	err = redis.GetRedis().Set(s.ctx, fmt.Sprintf(adminCaptchaKeyFmt, id), code, time.Duration(expire)*time.Second).Err()
+	if err != nil {
+		return nil, fmt.Errorf("存储验证码失败: %w", err)
+	}
+
+	return &CaptchaResponse{
+		CaptchaID: id,
+		Image:     "data:image/png;base64," + base64.StdEncoding.EncodeToString(img),
+		ExpiresIn: expire,
+	}, nil
+}
+
+// VerifyCaptcha 校验验证码，验证码无论对错只能使用一次
+func (s *LoginSecurityService) VerifyCaptcha(id, code string) error {
+	answer, err := redis.GetRedis().GetDel(s.ctx, fmt.Sprintf(adminCaptchaKeyFmt, id)).Result()
+	if err != nil {
+		if err == redisv8.Nil {
+			return ErrCaptchaInvalid
+		}
+		return fmt.Errorf("验证验证码失败: %w", err)
+	}
+	if answer != strings.TrimSpace(code) {
+		return ErrCaptchaInvalid
+	}
+	return nil
+}
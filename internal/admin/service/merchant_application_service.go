@@ -0,0 +1,714 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"merchant_api/internal/dao"
+	"merchant_api/internal/model"
+	"merchant_api/internal/pkg/fieldcrypt"
+	"merchant_api/internal/pkg/utils"
+	"merchant_api/pkg/database"
+	"mime/multipart"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// 入驻申请状态
+const (
+	ApplicationDraft     = "draft"     // 草稿，申请人可以修改
+	ApplicationSubmitted = "submitted" // 已提交，等待审核
+	ApplicationReviewing = "reviewing" // 审核中
+	ApplicationRejected  = "rejected"  // 已驳回，申请人可以修改后重新提交
+	ApplicationApproved  = "approved"  // 已通过，已创建商户及主账号
+)
+
+// applicationTransitions 允许的状态变更
+var applicationTransitions = map[string][]string{
+	ApplicationDraft:     {ApplicationSubmitted},
+	ApplicationSubmitted: {ApplicationReviewing},
+	ApplicationReviewing: {ApplicationApproved, ApplicationRejected},
+	ApplicationRejected:  {ApplicationSubmitted},
+}
+
+// 申请记录的操作人类型
+const (
+	ApplicationByApplicant = "applicant"
+	ApplicationByOperator  = "operator"
+)
+
+// 资质文件类型
+const (
+	DocumentBusinessLicense = "business_license" // 营业执照，提交时必须上传
+	DocumentIDCardFront     = "id_card_front"    // 身份证人像面
+	DocumentIDCardBack      = "id_card_back"     // 身份证国徽面
+	DocumentFoodLicense     = "food_license"     // 食品经营许可证
+	DocumentOther           = "other"
+)
+
+var (
+	// ErrApplicationNotFound 申请不存在或访问令牌错误，不区分具体原因
+	ErrApplicationNotFound = errors.New("申请不存在或访问令牌无效")
+	// ErrApplicationStateChanged 申请状态已被其他请求修改
+	ErrApplicationStateChanged = errors.New("申请状态已变更，请刷新后重试")
+)
+
+// ApplicationDocument 资质文件
+type ApplicationDocument struct {
+	Type string `json:"type" binding:"required,oneof=business_license id_card_front id_card_back food_license other"`
+	Path string `json:"path" binding:"required"` // 上传接口返回的路径
+}
+
+// ApplicationRequest 保存申请，草稿阶段字段可以为空，提交时校验是否完整
+type ApplicationRequest struct {
+	MerName      string                `json:"mer_name" binding:"max=32"`
+	RealName     string                `json:"real_name" binding:"max=16"` // 联系人，同时作为主账号姓名
+	MerPhone     string                `json:"mer_phone"`
+	CategoryIDs  []int32               `json:"category_ids"`
+	MerAddress   string                `json:"mer_address" binding:"max=64"`
+	MerInfo      string                `json:"mer_info" binding:"max=256"`
+	ServicePhone string                `json:"service_phone" binding:"max=13"`
+	Timezone     string                `json:"timezone" binding:"max=64"` // IANA 时区，默认 Asia/Shanghai
+	Documents    []ApplicationDocument `json:"documents" binding:"max=10,dive"`
+	Account      string                `json:"account" binding:"omitempty,min=4,max=32"` // 主账号登录名
+	Password     string                `json:"password"`                                 // 主账号密码，为空时不修改
+}
+
+// CreateApplicationRequest 创建申请请求，需要图形验证码（GET /mer_admin/auth/captcha）
+type CreateApplicationRequest struct {
+	ApplicationRequest
+	CaptchaID   string `json:"captcha_id" binding:"required"`
+	CaptchaCode string `json:"captcha_code" binding:"required"`
+}
+
+// ApplicationListRequest 申请列表查询条件
+type ApplicationListRequest struct {
+	Page     int    `form:"page,default=1"`
+	PageSize int    `form:"page_size,default=20" binding:"max=100"`
+	Status   string `form:"status" binding:"omitempty,oneof=draft submitted reviewing rejected approved"`
+	Keyword  string `form:"keyword"` // 商户名称
+	Phone    string `form:"phone"`   // 联系人手机号（精确匹配）
+}
+
+// ApplicationReviewRequest 审核请求，驳回及添加审核意见时必须填写
+type ApplicationReviewRequest struct {
+	Remark string `json:"remark" binding:"max=255"`
+}
+
+// ApplicationDetail 申请详情
+type ApplicationDetail struct {
+	*model.MerMerchantApplication
+	CategoryIDs []int32                            `json:"category_ids"`
+	Documents   []ApplicationDocument              `json:"documents"`
+	HasPassword bool                               `json:"has_password"` // 是否已设置主账号密码
+	Logs        []*model.MerMerchantApplicationLog `json:"logs,omitempty"`
+}
+
+// ApplicationCreateResponse 创建申请响应，token 只在创建时返回一次
+type ApplicationCreateResponse struct {
+	*ApplicationDetail
+	Token string `json:"token"`
+}
+
+// MerchantApplicationService 商户入驻申请：申请人填写并提交，平台运营审核
+type MerchantApplicationService struct {
+	ctx context.Context
+}
+
+func NewMerchantApplicationService(ctx context.Context) *MerchantApplicationService {
+	dao.SetDefault(database.GetDB())
+	return &MerchantApplicationService{ctx: ctx}
+}
+
+// Create 创建申请草稿，返回申请人后续访问申请使用的令牌
+func (s *MerchantApplicationService) Create(req *CreateApplicationRequest) (*ApplicationCreateResponse, error) {
+	if err := NewLoginSecurityService(s.ctx).VerifyCaptcha(req.CaptchaID, req.CaptchaCode); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	app := &model.MerMerchantApplication{
+		ApplicationNo: strings.ReplaceAll(uuid.New().String(), "-", ""),
+		Status:        ApplicationDraft,
+		CreateAt:      now,
+		UpdateAt:      now,
+	}
+	if err := s.apply(app, &req.ApplicationRequest); err != nil {
+		return nil, err
+	}
+	token, err := utils.RandomToken(32)
+	if err != nil {
+		return nil, fmt.Errorf("生成访问令牌失败: %w", err)
+	}
+	app.TokenHash = hashApplicationToken(token)
+
+	err = dao.Q.Transaction(func(tx *dao.Query) error {
+		if err := tx.MerMerchantApplication.WithContext(s.ctx).Create(app); err != nil {
+			return fmt.Errorf("创建申请失败: %w", err)
+		}
+		return s.addLog(tx, app.ApplicationID, "", ApplicationDraft, ApplicationByApplicant, 0, "")
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	detail, err := s.detail(app, ApplicationByApplicant)
+	if err != nil {
+		return nil, err
+	}
+	return &ApplicationCreateResponse{ApplicationDetail: detail, Token: token}, nil
+}
+
+// GetByToken 申请人使用令牌查看申请，不包含平台内部的审核意见
+func (s *MerchantApplicationService) GetByToken(token string) (*ApplicationDetail, error) {
+	app, err := s.loadByToken(token)
+	if err != nil {
+		return nil, err
+	}
+	return s.detail(app, ApplicationByApplicant)
+}
+
+// Update 申请人修改申请，只有草稿和已驳回的申请可以修改
+func (s *MerchantApplicationService) Update(token string, req *ApplicationRequest) (*ApplicationDetail, error) {
+	app, err := s.loadByToken(token)
+	if err != nil {
+		return nil, err
+	}
+	if app.Status != ApplicationDraft && app.Status != ApplicationRejected {
+		return nil, errors.New("申请已提交，不能修改")
+	}
+	status := app.Status
+	if err := s.apply(app, req); err != nil {
+		return nil, err
+	}
+	app.UpdateAt = time.Now()
+
+	a := dao.MerMerchantApplication
+	info, err := a.WithContext(s.ctx).
+		Select(a.MerName, a.RealName, a.MerPhone, a.MerPhoneHash, a.CategoryIds, a.MerAddress, a.MerInfo,
+			a.ServicePhone, a.Timezone, a.Documents, a.Account, a.Pwd, a.UpdateAt).
+		Where(a.ApplicationID.Eq(app.ApplicationID), a.Status.Eq(status)).
+		Updates(app)
+	if err != nil {
+		return nil, fmt.Errorf("保存申请失败: %w", err)
+	}
+	if info.RowsAffected == 0 {
+		// 内容没有变化时 MySQL 返回的影响行数也为 0，需要确认状态是否被修改
+		current, err := s.load(app.ApplicationID)
+		if err != nil {
+			return nil, err
+		}
+		if current.Status != status {
+			return nil, ErrApplicationStateChanged
+		}
+	}
+	return s.detail(app, ApplicationByApplicant)
+}
+
+// UploadDocument 申请人上传资质文件，返回的路径在保存申请时填入 documents
+func (s *MerchantApplicationService) UploadDocument(token string, file *multipart.FileHeader) (string, error) {
+	app, err := s.loadByToken(token)
+	if err != nil {
+		return "", err
+	}
+	if app.Status != ApplicationDraft && app.Status != ApplicationRejected {
+		return "", errors.New("申请已提交，不能修改")
+	}
+	return NewUploadService(s.ctx).UploadDocument(file)
+}
+
+// Submit 申请人提交申请，草稿和已驳回的申请可以提交
+func (s *MerchantApplicationService) Submit(token string) (*ApplicationDetail, error) {
+	app, err := s.loadByToken(token)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkComplete(app); err != nil {
+		return nil, err
+	}
+
+	err = s.transition(app, ApplicationSubmitted, ApplicationByApplicant, 0, "", map[string]interface{}{
+		"submitted_at": time.Now(),
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetByToken(token)
+}
+
+// List 平台运营查询申请列表
+func (s *MerchantApplicationService) List(req *ApplicationListRequest) ([]*ApplicationDetail, int64, error) {
+	a := dao.MerMerchantApplication
+	query := a.WithContext(s.ctx)
+	if req.Status != "" {
+		query = query.Where(a.Status.Eq(req.Status))
+	}
+	if req.Keyword != "" {
+		query = query.Where(a.MerName.Like("%" + req.Keyword + "%"))
+	}
+	if req.Phone != "" {
+		hash, err := fieldcrypt.BlindIndex(strings.TrimSpace(req.Phone))
+		if err != nil {
+			return nil, 0, fmt.Errorf("计算手机号索引失败: %w", err)
+		}
+		query = query.Where(a.MerPhoneHash.Eq(hash))
+	}
+
+	total, err := query.Count()
+	if err != nil {
+		return nil, 0, fmt.Errorf("查询申请总数失败: %w", err)
+	}
+	apps, err := query.
+		Order(a.ApplicationID.Desc()).
+		Limit(req.PageSize).
+		Offset((req.Page - 1) * req.PageSize).
+		Find()
+	if err != nil {
+		return nil, 0, fmt.Errorf("查询申请列表失败: %w", err)
+	}
+
+	list := make([]*ApplicationDetail, 0, len(apps))
+	for _, app := range apps {
+		detail, err := s.detail(app, "")
+		if err != nil {
+			return nil, 0, err
+		}
+		list = append(list, detail)
+	}
+	return list, total, nil
+}
+
+// Get 平台运营查看申请详情，包含全部状态变更及审核意见
+func (s *MerchantApplicationService) Get(applicationID int32) (*ApplicationDetail, error) {
+	app, err := s.load(applicationID)
+	if err != nil {
+		return nil, err
+	}
+	return s.detail(app, ApplicationByOperator)
+}
+
+// DocumentFile 平台运营下载资质文件，返回文件在磁盘上的路径
+func (s *MerchantApplicationService) DocumentFile(applicationID int32, index int) (string, error) {
+	app, err := s.load(applicationID)
+	if err != nil {
+		return "", err
+	}
+	documents, err := parseApplicationDocuments(app.Documents)
+	if err != nil {
+		return "", err
+	}
+	if index < 0 || index >= len(documents) {
+		return "", errors.New("资质文件不存在")
+	}
+	return NewUploadService(s.ctx).DocumentFile(documents[index].Path)
+}
+
+// StartReview 开始审核已提交的申请
+func (s *MerchantApplicationService) StartReview(operatorID, applicationID int32) (*ApplicationDetail, error) {
+	app, err := s.load(applicationID)
+	if err != nil {
+		return nil, err
+	}
+	err = s.transition(app, ApplicationReviewing, ApplicationByOperator, operatorID, "", map[string]interface{}{
+		"reviewed_by": operatorID,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	s.audit(AuditActionApplicationReview, 0, app, "")
+	return s.Get(applicationID)
+}
+
+// Comment 添加审核意见，不改变申请状态，只有平台运营可以看到
+func (s *MerchantApplicationService) Comment(operatorID, applicationID int32, remark string) (*ApplicationDetail, error) {
+	remark = strings.TrimSpace(remark)
+	if remark == "" {
+		return nil, errors.New("请填写审核意见")
+	}
+	app, err := s.load(applicationID)
+	if err != nil {
+		return nil, err
+	}
+	if app.Status == ApplicationDraft {
+		return nil, errors.New("申请尚未提交")
+	}
+
+	err = s.addLog(dao.Q, app.ApplicationID, app.Status, app.Status, ApplicationByOperator, operatorID, remark)
+	if err != nil {
+		return nil, err
+	}
+	s.audit(AuditActionApplicationComment, 0, app, remark)
+	return s.Get(applicationID)
+}
+
+// Reject 驳回审核中的申请，必须填写原因，申请人修改后可以重新提交
+func (s *MerchantApplicationService) Reject(operatorID, applicationID int32, remark string) (*ApplicationDetail, error) {
+	remark = strings.TrimSpace(remark)
+	if remark == "" {
+		return nil, errors.New("请填写驳回原因")
+	}
+	app, err := s.load(applicationID)
+	if err != nil {
+		return nil, err
+	}
+	err = s.transition(app, ApplicationRejected, ApplicationByOperator, operatorID, remark, map[string]interface{}{
+		"review_remark": remark,
+		"reviewed_by":   operatorID,
+		"reviewed_at":   time.Now(),
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	s.audit(AuditActionApplicationReject, 0, app, remark)
+	return s.Get(applicationID)
+}
+
+// Approve 审核通过，在同一事务中创建商户（审核状态为已通过）及商户主账号
+func (s *MerchantApplicationService) Approve(operatorID, applicationID int32, remark string) (*ApplicationDetail, error) {
+	remark = strings.TrimSpace(remark)
+	app, err := s.load(applicationID)
+	if err != nil {
+		return nil, err
+	}
+	// 提交后手机号、账号可能已被其他商户使用，创建前重新校验
+	if err := s.checkComplete(app); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var merID int32
+	err = s.transition(app, ApplicationApproved, ApplicationByOperator, operatorID, remark, map[string]interface{}{
+		"review_remark": remark,
+		"reviewed_by":   operatorID,
+		"reviewed_at":   now,
+	}, func(tx *dao.Query, updates map[string]interface{}) error {
+		merchant := &model.MerMerchant{
+			CategoryIds:  app.CategoryIds,
+			MerName:      app.MerName,
+			RealName:     app.RealName,
+			MerPhone:     app.MerPhone,
+			MerPhoneHash: app.MerPhoneHash,
+			MerAddress:   app.MerAddress,
+			MerInfo:      app.MerInfo,
+			ServicePhone: app.ServicePhone,
+			Timezone:     app.Timezone,
+			Status:       true,
+			AuditStatus:  MerchantAuditApproved,
+			AuditRemark:  remark,
+			AuditBy:      operatorID,
+			AuditAt:      &now,
+			CreateAt:     now,
+			UpdateAt:     now,
+		}
+		if err := tx.MerMerchant.WithContext(s.ctx).Create(merchant); err != nil {
+			return fmt.Errorf("创建商户失败: %w", err)
+		}
+
+		phone := app.MerPhone
+		phoneHash, err := adminPhoneHash(&phone)
+		if err != nil {
+			return err
+		}
+		admin := &model.MerMerchantAdmin{
+			MerID:     merchant.MerID,
+			Account:   app.Account,
+			Pwd:       app.Pwd,
+			RealName:  app.RealName,
+			Phone:     &phone,
+			PhoneHash: phoneHash,
+			Level:     AdminLevelPlatform,
+			Roles:     stringPtr(""),
+			Status:    1,
+			CreateAt:  now,
+		}
+		if err := tx.MerMerchantAdmin.WithContext(s.ctx).Create(admin); err != nil {
+			return fmt.Errorf("创建商户主账号失败: %w", err)
+		}
+		if err := NewPasswordService(s.ctx).saveHistory(tx, admin.MerchantAdminID, app.Pwd); err != nil {
+			return err
+		}
+
+		merID = merchant.MerID
+		updates["mer_id"] = merID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.audit(AuditActionApplicationApprove, merID, app, remark)
+	return s.Get(applicationID)
+}
+
+// apply 校验并写入申请人填写的内容，草稿阶段允许为空
+func (s *MerchantApplicationService) apply(app *model.MerMerchantApplication, req *ApplicationRequest) error {
+	app.MerName = strings.TrimSpace(req.MerName)
+	app.RealName = strings.TrimSpace(req.RealName)
+	app.MerAddress = strings.TrimSpace(req.MerAddress)
+	app.MerInfo = strings.TrimSpace(req.MerInfo)
+
+	phone := strings.TrimSpace(req.MerPhone)
+	app.MerPhone, app.MerPhoneHash = phone, ""
+	if phone != "" {
+		if !utils.IsValidPhone(phone) {
+			return errors.New("手机号格式错误")
+		}
+		hash, err := fieldcrypt.BlindIndex(phone)
+		if err != nil {
+			return fmt.Errorf("计算手机号索引失败: %w", err)
+		}
+		app.MerPhoneHash = hash
+	}
+
+	categoryIDs := make([]int32, 0)
+	if len(req.CategoryIDs) > 0 {
+		ids, err := NewMerchantCategoryService(s.ctx).checkCategoryIDs(req.CategoryIDs)
+		if err != nil {
+			return err
+		}
+		categoryIDs = ids
+	}
+	app.CategoryIds = formatCategoryIDs(categoryIDs)
+
+	app.ServicePhone = strings.TrimSpace(req.ServicePhone)
+	if app.ServicePhone != "" && !utils.IsValidPhone(app.ServicePhone) {
+		return errors.New("客服电话格式不正确")
+	}
+	app.Timezone = req.Timezone
+	if app.Timezone == "" {
+		app.Timezone = defaultMerchantTimezone
+	}
+	if _, err := time.LoadLocation(app.Timezone); err != nil {
+		return fmt.Errorf("无效的时区: %s", app.Timezone)
+	}
+
+	upload := NewUploadService(s.ctx)
+	documents := make([]ApplicationDocument, 0, len(req.Documents))
+	for _, document := range req.Documents {
+		if _, err := upload.DocumentFile(document.Path); err != nil {
+			return err
+		}
+		documents = append(documents, document)
+	}
+	data, err := json.Marshal(documents)
+	if err != nil {
+		return fmt.Errorf("保存资质文件失败: %w", err)
+	}
+	app.Documents = string(data)
+
+	app.Account = strings.TrimSpace(req.Account)
+	if req.Password != "" {
+		if err := NewPasswordService(s.ctx).ValidatePolicy(req.Password); err != nil {
+			return err
+		}
+		pwd, err := utils.HashPassword(req.Password)
+		if err != nil {
+			return fmt.Errorf("密码加密失败: %w", err)
+		}
+		app.Pwd = pwd
+	}
+	return nil
+}
+
+// checkComplete 校验申请内容完整，且手机号、主账号未被占用
+func (s *MerchantApplicationService) checkComplete(app *model.MerMerchantApplication) error {
+	switch {
+	case app.MerName == "":
+		return errors.New("请填写商户名称")
+	case app.RealName == "":
+		return errors.New("请填写联系人姓名")
+	case app.MerPhone == "":
+		return errors.New("请填写联系人手机号")
+	case app.CategoryIds == "" || app.CategoryIds == "[]":
+		return errors.New("请选择商户分类")
+	case app.Account == "" || app.Pwd == "":
+		return errors.New("请设置主账号及密码")
+	}
+
+	documents, err := parseApplicationDocuments(app.Documents)
+	if err != nil {
+		return err
+	}
+	hasLicense := false
+	for _, document := range documents {
+		if document.Type == DocumentBusinessLicense {
+			hasLicense = true
+		}
+	}
+	if !hasLicense {
+		return errors.New("请上传营业执照")
+	}
+
+	if _, err := NewPlatformMerchantService(s.ctx).checkPhoneAvailable(app.MerPhone, 0); err != nil {
+		return err
+	}
+	phone := app.MerPhone
+	return NewAdminService(s.ctx).checkAccountAvailable(app.Account, &phone, 0)
+}
+
+// transition 在事务中变更申请状态并写入申请记录，只有状态仍为读取时的状态才会更新；
+// fn 在更新状态前执行，可以在同一事务中写入其他数据并补充需要更新的字段
+func (s *MerchantApplicationService) transition(app *model.MerMerchantApplication, to, operatorType string, operatorID int32, remark string,
+	updates map[string]interface{}, fn func(tx *dao.Query, updates map[string]interface{}) error) error {
+	if !canTransitApplication(app.Status, to) {
+		return fmt.Errorf("申请状态为 %s，不能变更为 %s", app.Status, to)
+	}
+	updates["status"] = to
+	updates["update_at"] = time.Now()
+
+	return dao.Q.Transaction(func(tx *dao.Query) error {
+		if fn != nil {
+			if err := fn(tx, updates); err != nil {
+				return err
+			}
+		}
+
+		a := tx.MerMerchantApplication
+		info, err := a.WithContext(s.ctx).
+			Where(a.ApplicationID.Eq(app.ApplicationID), a.Status.Eq(app.Status)).
+			Updates(updates)
+		if err != nil {
+			return fmt.Errorf("更新申请状态失败: %w", err)
+		}
+		if info.RowsAffected == 0 {
+			return ErrApplicationStateChanged
+		}
+		return s.addLog(tx, app.ApplicationID, app.Status, to, operatorType, operatorID, remark)
+	})
+}
+
+// addLog 写入申请记录
+func (s *MerchantApplicationService) addLog(tx *dao.Query, applicationID int32, from, to, operatorType string, operatorID int32, remark string) error {
+	log := &model.MerMerchantApplicationLog{
+		ApplicationID: applicationID,
+		FromStatus:    from,
+		ToStatus:      to,
+		OperatorType:  operatorType,
+		OperatorID:    operatorID,
+		Remark:        remark,
+		CreateAt:      time.Now(),
+	}
+	if err := tx.MerMerchantApplicationLog.WithContext(s.ctx).Create(log); err != nil {
+		return fmt.Errorf("保存申请记录失败: %w", err)
+	}
+	return nil
+}
+
+// audit 记录平台运营的审核操作
+func (s *MerchantApplicationService) audit(action string, merID int32, app *model.MerMerchantApplication, remark string) {
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		MerID:      merID,
+		Action:     action,
+		EntityType: AuditEntityApplication,
+		EntityID:   int64(app.ApplicationID),
+		After: map[string]interface{}{
+			"application_no": app.ApplicationNo,
+			"mer_name":       app.MerName,
+			"remark":         remark,
+		},
+	})
+}
+
+// detail 组装申请详情；viewer 为申请人时不包含平台内部的审核意见，为空时不包含申请记录
+func (s *MerchantApplicationService) detail(app *model.MerMerchantApplication, viewer string) (*ApplicationDetail, error) {
+	var categoryIDs []int32
+	if err := json.Unmarshal([]byte(app.CategoryIds), &categoryIDs); err != nil {
+		return nil, fmt.Errorf("解析商户分类失败: %w", err)
+	}
+	documents, err := parseApplicationDocuments(app.Documents)
+	if err != nil {
+		return nil, err
+	}
+	detail := &ApplicationDetail{
+		MerMerchantApplication: app,
+		CategoryIDs:            categoryIDs,
+		Documents:              documents,
+		HasPassword:            app.Pwd != "",
+	}
+	if viewer == "" {
+		return detail, nil
+	}
+
+	l := dao.MerMerchantApplicationLog
+	logs, err := l.WithContext(s.ctx).
+		Where(l.ApplicationID.Eq(app.ApplicationID)).
+		Order(l.LogID).
+		Find()
+	if err != nil {
+		return nil, fmt.Errorf("查询申请记录失败: %w", err)
+	}
+	detail.Logs = make([]*model.MerMerchantApplicationLog, 0, len(logs))
+	for _, log := range logs {
+		if viewer == ApplicationByApplicant {
+			// 申请人只能看到状态变更，不能看到审核人
+			if log.FromStatus == log.ToStatus {
+				continue
+			}
+			log.OperatorID = 0
+		}
+		detail.Logs = append(detail.Logs, log)
+	}
+	return detail, nil
+}
+
+// load 按ID读取申请
+func (s *MerchantApplicationService) load(applicationID int32) (*model.MerMerchantApplication, error) {
+	a := dao.MerMerchantApplication
+	app, err := a.WithContext(s.ctx).Where(a.ApplicationID.Eq(applicationID)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("申请不存在")
+		}
+		return nil, fmt.Errorf("查询申请失败: %w", err)
+	}
+	return app, nil
+}
+
+// loadByToken 按申请人令牌读取申请
+func (s *MerchantApplicationService) loadByToken(token string) (*model.MerMerchantApplication, error) {
+	if token == "" {
+		return nil, ErrApplicationNotFound
+	}
+	a := dao.MerMerchantApplication
+	app, err := a.WithContext(s.ctx).Where(a.TokenHash.Eq(hashApplicationToken(token))).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrApplicationNotFound
+		}
+		return nil, fmt.Errorf("查询申请失败: %w", err)
+	}
+	return app, nil
+}
+
+// parseApplicationDocuments 解析资质文件列表
+func parseApplicationDocuments(raw string) ([]ApplicationDocument, error) {
+	documents := make([]ApplicationDocument, 0)
+	if raw == "" {
+		return documents, nil
+	}
+	if err := json.Unmarshal([]byte(raw), &documents); err != nil {
+		return nil, fmt.Errorf("解析资质文件失败: %w", err)
+	}
+	return documents, nil
+}
+
+// hashApplicationToken 计算申请人令牌的 SHA-256，数据库只保存该值
+func hashApplicationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func canTransitApplication(from, to string) bool {
+	for _, next := range applicationTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
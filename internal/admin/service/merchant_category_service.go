@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"merchant_api/internal/dao"
+	"merchant_api/internal/model"
+	"merchant_api/pkg/database"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// MerchantCategoryRequest 新增/修改商户分类请求
+type MerchantCategoryRequest struct {
+	CategoryName  string `json:"category_name" binding:"required,max=32"`
+	CategoryScope string `json:"category_scope" binding:"max=255"` // 经营范围
+}
+
+// MerchantCategoryService 商户分类（平台维护），商户的 category_ids 引用分类ID
+type MerchantCategoryService struct {
+	ctx context.Context
+}
+
+func NewMerchantCategoryService(ctx context.Context) *MerchantCategoryService {
+	dao.SetDefault(database.GetDB())
+	return &MerchantCategoryService{ctx: ctx}
+}
+
+// List 获取所有商户分类
+func (s *MerchantCategoryService) List() ([]*model.MerMerchantCategory, error) {
+	c := dao.MerMerchantCategory
+	list, err := c.WithContext(s.ctx).Order(c.MerchantCategoryID).Find()
+	if err != nil {
+		return nil, fmt.Errorf("查询商户分类失败: %w", err)
+	}
+	return list, nil
+}
+
+// Get 获取商户分类
+func (s *MerchantCategoryService) Get(categoryID int32) (*model.MerMerchantCategory, error) {
+	c := dao.MerMerchantCategory
+	category, err := c.WithContext(s.ctx).Where(c.MerchantCategoryID.Eq(categoryID)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("商户分类不存在")
+		}
+		return nil, fmt.Errorf("查询商户分类失败: %w", err)
+	}
+	return category, nil
+}
+
+// Create 新增商户分类，名称不能重复
+func (s *MerchantCategoryService) Create(req *MerchantCategoryRequest) (*model.MerMerchantCategory, error) {
+	name := strings.TrimSpace(req.CategoryName)
+	if err := s.checkNameAvailable(name, 0); err != nil {
+		return nil, err
+	}
+
+	category := &model.MerMerchantCategory{
+		CategoryName:  name,
+		CategoryScope: strings.TrimSpace(req.CategoryScope),
+		CreateAt:      time.Now(),
+	}
+	if err := dao.MerMerchantCategory.WithContext(s.ctx).Create(category); err != nil {
+		return nil, fmt.Errorf("新增商户分类失败: %w", err)
+	}
+
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		Action:     AuditActionMerchantCategoryCreate,
+		EntityType: AuditEntityMerchantCategory,
+		EntityID:   int64(category.MerchantCategoryID),
+		After:      category,
+	})
+	return category, nil
+}
+
+// Update 修改商户分类
+func (s *MerchantCategoryService) Update(categoryID int32, req *MerchantCategoryRequest) (*model.MerMerchantCategory, error) {
+	before, err := s.Get(categoryID)
+	if err != nil {
+		return nil, err
+	}
+	name := strings.TrimSpace(req.CategoryName)
+	if err := s.checkNameAvailable(name, categoryID); err != nil {
+		return nil, err
+	}
+
+	c := dao.MerMerchantCategory
+	_, err = c.WithContext(s.ctx).
+		Where(c.MerchantCategoryID.Eq(categoryID)).
+		Updates(map[string]interface{}{
+			"category_name":  name,
+			"category_scope": strings.TrimSpace(req.CategoryScope),
+		})
+	if err != nil {
+		return nil, fmt.Errorf("修改商户分类失败: %w", err)
+	}
+
+	after, err := s.Get(categoryID)
+	if err != nil {
+		return nil, err
+	}
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		Action:     AuditActionMerchantCategoryUpdate,
+		EntityType: AuditEntityMerchantCategory,
+		EntityID:   int64(categoryID),
+		Before:     before,
+		After:      after,
+	})
+	return after, nil
+}
+
+// Delete 删除商户分类，仍有商户使用时不能删除
+func (s *MerchantCategoryService) Delete(categoryID int32) error {
+	before, err := s.Get(categoryID)
+	if err != nil {
+		return err
+	}
+
+	var count int64
+	err = database.GetDB().WithContext(s.ctx).
+		Model(&model.MerMerchant{}).
+		Where("is_del = 0 AND JSON_CONTAINS(category_ids, ?)", strconv.Itoa(int(categoryID))).
+		Count(&count).Error
+	if err != nil {
+		return fmt.Errorf("查询分类下的商户失败: %w", err)
+	}
+	if count > 0 {
+		return fmt.Errorf("该分类下还有 %d 个商户，不能删除", count)
+	}
+
+	c := dao.MerMerchantCategory
+	if _, err := c.WithContext(s.ctx).Where(c.MerchantCategoryID.Eq(categoryID)).Delete(); err != nil {
+		return fmt.Errorf("删除商户分类失败: %w", err)
+	}
+
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		Action:     AuditActionMerchantCategoryDelete,
+		EntityType: AuditEntityMerchantCategory,
+		EntityID:   int64(categoryID),
+		Before:     before,
+	})
+	return nil
+}
+
+// checkNameAvailable 分类名称不能为空且不能重复
+func (s *MerchantCategoryService) checkNameAvailable(name string, excludeID int32) error {
+	if name == "" {
+		return errors.New("分类名称不能为空")
+	}
+	c := dao.MerMerchantCategory
+	count, err := c.WithContext(s.ctx).
+		Where(c.CategoryName.Eq(name), c.MerchantCategoryID.Neq(excludeID)).
+		Count()
+	if err != nil {
+		return fmt.Errorf("查询商户分类失败: %w", err)
+	}
+	if count > 0 {
+		return errors.New("分类名称已存在")
+	}
+	return nil
+}
+
+// checkCategoryIDs 校验商户分类是否存在，返回去重后的ID
+func (s *MerchantCategoryService) checkCategoryIDs(ids []int32) ([]int32, error) {
+	ids = uniqueInt32(ids)
+	if len(ids) == 0 {
+		return nil, errors.New("请选择商户分类")
+	}
+	c := dao.MerMerchantCategory
+	count, err := c.WithContext(s.ctx).Where(c.MerchantCategoryID.In(ids...)).Count()
+	if err != nil {
+		return nil, fmt.Errorf("查询商户分类失败: %w", err)
+	}
+	if int(count) != len(ids) {
+		return nil, errors.New("商户分类不存在")
+	}
+	return ids, nil
+}
+
+// formatCategoryIDs 将分类ID格式化为 MerMerchant.CategoryIds 的存储格式（JSON 数组）
+func formatCategoryIDs(ids []int32) string {
+	data, _ := json.Marshal(ids)
+	return string(data)
+}
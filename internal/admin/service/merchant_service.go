@@ -0,0 +1,249 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"merchant_api/internal/dao"
+	"merchant_api/internal/model"
+	"merchant_api/internal/pkg/utils"
+	"merchant_api/pkg/database"
+	"merchant_api/pkg/redis"
+	"strings"
+	"time"
+
+	redisv8 "github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+)
+
+// Redis 键
+const (
+	merchantStatusKeyFmt = "admin:merchant:%d:status" // 商户状态缓存：1 正常，0 锁定或已删除
+)
+
+// merchantStatusTTL 商户状态缓存时间，直接修改数据库时最多延迟该时间生效
+const merchantStatusTTL = time.Minute
+
+// 商户审核状态
+const (
+	MerchantAuditPending  = 0 // 待审核
+	MerchantAuditApproved = 1 // 已通过
+	MerchantAuditRejected = 2 // 已驳回
+)
+
+// ErrMerchantSuspended 商户已锁定、已删除或未通过审核
+var ErrMerchantSuspended = errors.New("商户已被停用")
+
+// MerchantProfileRequest 商户资料更新请求，不传的字段保持不变；
+// 余额、状态、商户分类等字段由平台维护，商户不能修改
+type MerchantProfileRequest struct {
+	MerName      *string `json:"mer_name" binding:"omitempty,min=1,max=32"`
+	MerInfo      *string `json:"mer_info" binding:"omitempty,max=256"`
+	MerLogo      *string `json:"mer_logo" binding:"omitempty,max=128"`   // 传空字符串清除
+	MerBanner    *string `json:"mer_banner" binding:"omitempty,max=128"` // 传空字符串清除
+	ServicePhone *string `json:"service_phone" binding:"omitempty,max=13"`
+	MerAddress   *string `json:"mer_address" binding:"omitempty,max=64"`
+	MerKeyword   *string `json:"mer_keyword" binding:"omitempty,max=64"`
+	DeliveryWay  *string `json:"delivery_way" binding:"omitempty,max=50"`
+}
+
+type MerchantService struct {
+	ctx context.Context
+}
+
+func NewMerchantService(ctx context.Context) *MerchantService {
+	dao.SetDefault(database.GetDB())
+	return &MerchantService{ctx: ctx}
+}
+
+// GetProfile 获取商户资料
+func (s *MerchantService) GetProfile(merID int32) (*model.MerMerchant, error) {
+	m := dao.MerMerchant
+	merchant, err := m.WithContext(s.ctx).
+		Where(m.MerID.Eq(merID), m.IsDel.Eq(0)).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("商户不存在")
+		}
+		return nil, fmt.Errorf("查询商户失败: %w", err)
+	}
+	return merchant, nil
+}
+
+// UpdateProfile 更新商户资料，图片必须是通过上传接口上传的图片
+func (s *MerchantService) UpdateProfile(merID int32, req *MerchantProfileRequest) (*model.MerMerchant, error) {
+	before, err := s.GetProfile(merID)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make(map[string]interface{})
+	if req.MerName != nil {
+		name := strings.TrimSpace(*req.MerName)
+		if name == "" {
+			return nil, errors.New("商户名称不能为空")
+		}
+		updates["mer_name"] = name
+	}
+	if req.MerInfo != nil {
+		updates["mer_info"] = strings.TrimSpace(*req.MerInfo)
+	}
+	if req.MerAddress != nil {
+		updates["mer_address"] = strings.TrimSpace(*req.MerAddress)
+	}
+	if req.MerKeyword != nil {
+		updates["mer_keyword"] = strings.TrimSpace(*req.MerKeyword)
+	}
+	if req.DeliveryWay != nil {
+		updates["delivery_way"] = strings.TrimSpace(*req.DeliveryWay)
+	}
+	if req.ServicePhone != nil {
+		phone := strings.TrimSpace(*req.ServicePhone)
+		if phone != "" && !utils.IsValidPhone(phone) {
+			return nil, errors.New("客服电话格式不正确")
+		}
+		updates["service_phone"] = phone
+	}
+
+	uploadService := NewUploadService(s.ctx)
+	images := []struct {
+		column string
+		value  *string
+		label  string
+	}{
+		{"mer_logo", req.MerLogo, "商户头像"},
+		{"mer_banner", req.MerBanner, "商户 banner"},
+	}
+	for _, image := range images {
+		if image.value == nil {
+			continue
+		}
+		if *image.value == "" {
+			updates[image.column] = nil
+			continue
+		}
+		if !uploadService.IsUploadedImage(*image.value) {
+			return nil, fmt.Errorf("%s必须使用上传接口上传的图片", image.label)
+		}
+		updates[image.column] = *image.value
+	}
+
+	if len(updates) == 0 {
+		return before, nil
+	}
+	updates["update_at"] = time.Now()
+
+	m := dao.MerMerchant
+	if _, err := m.WithContext(s.ctx).Where(m.MerID.Eq(merID)).Updates(updates); err != nil {
+		return nil, fmt.Errorf("更新商户资料失败: %w", err)
+	}
+
+	after, err := s.GetProfile(merID)
+	if err != nil {
+		return nil, err
+	}
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		Action:     AuditActionMerchantProfile,
+		EntityType: AuditEntityMerchant,
+		EntityID:   int64(merID),
+		Before:     before,
+		After:      after,
+	})
+	return after, nil
+}
+
+// CheckActive 检查商户是否正常（审核通过、未锁定且未删除），状态缓存在 Redis 中
+func (s *MerchantService) CheckActive(merID int32) error {
+	rdb := redis.GetRedis()
+	cacheKey := fmt.Sprintf(merchantStatusKeyFmt, merID)
+
+	cached, err := rdb.Get(s.ctx, cacheKey).Result()
+	if err == nil {
+		if cached != "1" {
+			return ErrMerchantSuspended
+		}
+		return nil
+	}
+	if err != redisv8.Nil {
+		return fmt.Errorf("读取商户状态失败: %w", err)
+	}
+
+	active, err := s.loadActive(merID)
+	if err != nil {
+		return err
+	}
+	value := "0"
+	if active {
+		value = "1"
+	}
+	rdb.Set(s.ctx, cacheKey, value, merchantStatusTTL)
+
+	if !active {
+		return ErrMerchantSuspended
+	}
+	return nil
+}
+
+// SetStatus 修改商户状态；锁定时立即吊销该商户所有管理员的会话
+func (s *MerchantService) SetStatus(merID int32, active bool) error {
+	m := dao.MerMerchant
+	_, err := m.WithContext(s.ctx).
+		Where(m.MerID.Eq(merID)).
+		Updates(map[string]interface{}{
+			"status":    active,
+			"update_at": time.Now(),
+		})
+	if err != nil {
+		return fmt.Errorf("更新商户状态失败: %w", err)
+	}
+
+	if err := s.InvalidateStatus(merID); err != nil {
+		return err
+	}
+	if !active {
+		return s.revokeSessions(merID)
+	}
+	return nil
+}
+
+// InvalidateStatus 清除商户状态缓存（商户被锁定、删除或恢复后调用）
+func (s *MerchantService) InvalidateStatus(merID int32) error {
+	if err := redis.GetRedis().Del(s.ctx, fmt.Sprintf(merchantStatusKeyFmt, merID)).Err(); err != nil {
+		return fmt.Errorf("清除商户状态缓存失败: %w", err)
+	}
+	return nil
+}
+
+// revokeSessions 吊销商户所有管理员的会话
+func (s *MerchantService) revokeSessions(merID int32) error {
+	a := dao.MerMerchantAdmin
+	var adminIDs []int32
+	if err := a.WithContext(s.ctx).Where(a.MerID.Eq(merID)).Pluck(a.MerchantAdminID, &adminIDs); err != nil {
+		return fmt.Errorf("查询商户管理员失败: %w", err)
+	}
+
+	sessionService := NewAdminSessionService(s.ctx)
+	for _, adminID := range adminIDs {
+		if _, err := sessionService.RevokeAll(adminID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadActive 从数据库读取商户状态，商户不存在或未审核通过视为停用
+func (s *MerchantService) loadActive(merID int32) (bool, error) {
+	m := dao.MerMerchant
+	merchant, err := m.WithContext(s.ctx).
+		Select(m.MerID, m.Status, m.AuditStatus, m.IsDel).
+		Where(m.MerID.Eq(merID)).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("查询商户失败: %w", err)
+	}
+	return merchant.Status && merchant.AuditStatus == MerchantAuditApproved && merchant.IsDel == 0, nil
+}
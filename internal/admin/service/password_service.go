@@ -0,0 +1,322 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"merchant_api/internal/dao"
+	"merchant_api/internal/model"
+	"merchant_api/internal/pkg/utils"
+	"merchant_api/pkg/config"
+	"merchant_api/pkg/database"
+	"merchant_api/pkg/redis"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	redisv8 "github.com/go-redis/redis/v8"
+)
+
+// Redis 键
+const (
+	adminPwdResetKeyFmt      = "admin:pwd_reset:%s" // 重置码 -> admin_id
+	adminPwdResetAdminKeyFmt = "admin:%d:pwd_reset" // 管理员当前有效的重置码
+)
+
+// 密码最大长度（bcrypt 只使用前 72 字节）
+const passwordMaxLength = 72
+
+type PasswordService struct {
+	ctx context.Context
+}
+
+func NewPasswordService(ctx context.Context) *PasswordService {
+	dao.SetDefault(database.GetDB())
+	return &PasswordService{ctx: ctx}
+}
+
+// ResetCodeResponse 重置码响应
+type ResetCodeResponse struct {
+	Code      string `json:"code"`
+	ExpiresIn int    `json:"expires_in"`
+}
+
+// ValidatePolicy 按配置的密码策略校验密码强度
+func (s *PasswordService) ValidatePolicy(password string) error {
+	policy := config.GlobalConfig.Password
+
+	if len(password) > passwordMaxLength {
+		return fmt.Errorf("密码长度不能超过%d位", passwordMaxLength)
+	}
+	if len([]rune(password)) < policy.MinLength {
+		return fmt.Errorf("密码长度不能少于%d位", policy.MinLength)
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			hasSymbol = true
+		}
+	}
+
+	var missing []string
+	if policy.RequireUpper && !hasUpper {
+		missing = append(missing, "大写字母")
+	}
+	if policy.RequireLower && !hasLower {
+		missing = append(missing, "小写字母")
+	}
+	if policy.RequireDigit && !hasDigit {
+		missing = append(missing, "数字")
+	}
+	if policy.RequireSymbol && !hasSymbol {
+		missing = append(missing, "特殊字符")
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("密码必须包含%s", strings.Join(missing, "、"))
+	}
+	return nil
+}
+
+// ChangePassword 修改自己的密码，需验证原密码
+func (s *PasswordService) ChangePassword(adminID int32, merID int32, oldPassword, newPassword string) error {
+	admin, err := NewAdminService(s.ctx).load(adminID, merID)
+	if err != nil {
+		return err
+	}
+
+	if !utils.CheckPassword(oldPassword, admin.Pwd) {
+		return errors.New("原密码错误")
+	}
+
+	if err := s.setPassword(admin, newPassword); err != nil {
+		return err
+	}
+
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		Action:     AuditActionPasswordChange,
+		EntityType: AuditEntityAdmin,
+		EntityID:   int64(adminID),
+	})
+	return nil
+}
+
+// IssueResetCode 为子账号生成一次性重置码，同一管理员只保留最新的重置码
+func (s *PasswordService) IssueResetCode(callerID, adminID int32, merID int32) (*ResetCodeResponse, error) {
+	if callerID == adminID {
+		return nil, errors.New("请使用修改密码功能修改自己的密码")
+	}
+	if _, err := NewAdminService(s.ctx).loadEditable(callerID, adminID, merID); err != nil {
+		return nil, err
+	}
+
+	code, err := utils.RandomToken(16)
+	if err != nil {
+		return nil, fmt.Errorf("生成重置码失败: %w", err)
+	}
+
+	expire := config.GlobalConfig.Password.ResetCodeExpire
+	ttl := time.Duration(expire) * time.Second
+	adminKey := fmt.Sprintf(adminPwdResetAdminKeyFmt, adminID)
+
+	rdb := redis.GetRedis()
+	previous, err := rdb.Get(s.ctx, adminKey).Result()
+	if err != nil && err != redisv8.Nil {
+		return nil, fmt.Errorf("生成重置码失败: %w", err)
+	}
+
+	_, err = rdb.TxPipelined(s.ctx, func(pipe redisv8.Pipeliner) error {
+		if previous != "" {
+			pipe.Del(s.ctx, fmt.Sprintf(adminPwdResetKeyFmt, previous))
+		}
+		pipe.Set(s.ctx, fmt.Sprintf(adminPwdResetKeyFmt, code), adminID, ttl)
+		pipe.Set(s.ctx, adminKey, code, ttl)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("存储重置码失败: %w", err)
+	}
+
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		Action:     AuditActionResetCodeIssue,
+		EntityType: AuditEntityAdmin,
+		EntityID:   int64(adminID),
+	})
+
+	return &ResetCodeResponse{Code: code, ExpiresIn: expire}, nil
+}
+
+// ResetPassword 使用重置码设置新密码，重置码只能使用一次，账号不匹配时重置码同样失效
+func (s *PasswordService) ResetPassword(account, code, newPassword string) error {
+	rdb := redis.GetRedis()
+	codeKey := fmt.Sprintf(adminPwdResetKeyFmt, code)
+
+	raw, err := rdb.Get(s.ctx, codeKey).Result()
+	if err != nil {
+		if err == redisv8.Nil {
+			return errors.New("重置码无效或已过期")
+		}
+		return fmt.Errorf("验证重置码失败: %w", err)
+	}
+	adminID, err := strconv.Atoi(raw)
+	if err != nil {
+		return errors.New("重置码数据格式错误")
+	}
+
+	a := dao.MerMerchantAdmin
+	admin, err := a.WithContext(s.ctx).
+		Where(a.MerchantAdminID.Eq(int32(adminID)), a.IsDel.Eq(0)).
+		First()
+	if err != nil || admin.Account != account {
+		s.consumeResetCode(code, int32(adminID))
+		return errors.New("重置码无效或已过期")
+	}
+
+	// 密码不符合要求时保留重置码，允许重新提交
+	if err := s.ValidatePolicy(newPassword); err != nil {
+		return err
+	}
+	if err := s.checkReuse(admin, newPassword); err != nil {
+		return err
+	}
+
+	// 并发提交时只有一个请求能取到重置码
+	if _, err := rdb.GetDel(s.ctx, codeKey).Result(); err != nil {
+		if err == redisv8.Nil {
+			return errors.New("重置码无效或已过期")
+		}
+		return fmt.Errorf("验证重置码失败: %w", err)
+	}
+	rdb.Del(s.ctx, fmt.Sprintf(adminPwdResetAdminKeyFmt, adminID))
+
+	if err := s.applyPassword(admin, newPassword); err != nil {
+		return err
+	}
+
+	// 重置密码时未登录，操作人记为该账号本身
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		MerID:      admin.MerID,
+		AdminID:    admin.MerchantAdminID,
+		Account:    admin.Account,
+		Action:     AuditActionPasswordReset,
+		EntityType: AuditEntityAdmin,
+		EntityID:   int64(admin.MerchantAdminID),
+	})
+	return nil
+}
+
+// consumeResetCode 删除重置码
+func (s *PasswordService) consumeResetCode(code string, adminID int32) {
+	rdb := redis.GetRedis()
+	rdb.Del(s.ctx, fmt.Sprintf(adminPwdResetKeyFmt, code), fmt.Sprintf(adminPwdResetAdminKeyFmt, adminID))
+}
+
+// setPassword 校验策略和历史密码后更新密码
+func (s *PasswordService) setPassword(admin *model.MerMerchantAdmin, password string) error {
+	if err := s.ValidatePolicy(password); err != nil {
+		return err
+	}
+	if err := s.checkReuse(admin, password); err != nil {
+		return err
+	}
+	return s.applyPassword(admin, password)
+}
+
+// applyPassword 更新密码并记录历史，然后吊销该管理员的全部会话
+func (s *PasswordService) applyPassword(admin *model.MerMerchantAdmin, password string) error {
+	pwd, err := utils.HashPassword(password)
+	if err != nil {
+		return fmt.Errorf("密码加密失败: %w", err)
+	}
+
+	err = dao.Q.Transaction(func(tx *dao.Query) error {
+		a := tx.MerMerchantAdmin
+		if _, err := a.WithContext(s.ctx).
+			Where(a.MerchantAdminID.Eq(admin.MerchantAdminID)).
+			Update(a.Pwd, pwd); err != nil {
+			return fmt.Errorf("更新密码失败: %w", err)
+		}
+		return s.saveHistory(tx, admin.MerchantAdminID, pwd)
+	})
+	if err != nil {
+		return err
+	}
+
+	// 密码变更后，已登录的会话全部失效
+	if _, err := NewAdminSessionService(s.ctx).RevokeAll(admin.MerchantAdminID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// checkReuse 新密码不能与当前密码及最近 N 次使用过的密码相同
+func (s *PasswordService) checkReuse(admin *model.MerMerchantAdmin, password string) error {
+	if utils.CheckPassword(password, admin.Pwd) {
+		return errors.New("新密码不能与当前密码相同")
+	}
+
+	count := config.GlobalConfig.Password.HistoryCount
+	if count <= 0 {
+		return nil
+	}
+
+	h := dao.MerMerchantAdminPwdHistory
+	history, err := h.WithContext(s.ctx).
+		Where(h.MerchantAdminID.Eq(admin.MerchantAdminID)).
+		Order(h.ID.Desc()).
+		Limit(count).
+		Find()
+	if err != nil {
+		return fmt.Errorf("查询历史密码失败: %w", err)
+	}
+	for _, item := range history {
+		if utils.CheckPassword(password, item.Pwd) {
+			return fmt.Errorf("新密码不能与最近%d次使用过的密码相同", count)
+		}
+	}
+	return nil
+}
+
+// saveHistory 记录密码哈希，只保留最近 N 条
+func (s *PasswordService) saveHistory(tx *dao.Query, adminID int32, pwd string) error {
+	count := config.GlobalConfig.Password.HistoryCount
+	if count <= 0 {
+		return nil
+	}
+
+	h := tx.MerMerchantAdminPwdHistory
+	if err := h.WithContext(s.ctx).Create(&model.MerMerchantAdminPwdHistory{
+		MerchantAdminID: adminID,
+		Pwd:             pwd,
+		CreateAt:        time.Now(),
+	}); err != nil {
+		return fmt.Errorf("记录历史密码失败: %w", err)
+	}
+
+	keep, err := h.WithContext(s.ctx).
+		Where(h.MerchantAdminID.Eq(adminID)).
+		Order(h.ID.Desc()).
+		Limit(count).
+		Select(h.ID).
+		Find()
+	if err != nil {
+		return fmt.Errorf("查询历史密码失败: %w", err)
+	}
+	if len(keep) < count {
+		return nil
+	}
+	if _, err := h.WithContext(s.ctx).
+		Where(h.MerchantAdminID.Eq(adminID), h.ID.Lt(keep[len(keep)-1].ID)).
+		Delete(); err != nil {
+		return fmt.Errorf("清理历史密码失败: %w", err)
+	}
+	return nil
+}
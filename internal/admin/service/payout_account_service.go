@@ -0,0 +1,264 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"merchant_api/internal/dao"
+	"merchant_api/internal/model"
+	"merchant_api/internal/pkg/fieldcrypt"
+	"merchant_api/internal/pkg/mask"
+	"merchant_api/internal/pkg/payout"
+	"merchant_api/internal/pkg/utils"
+	"merchant_api/pkg/database"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// payoutAccountLimit 每个商户最多的收款账户数
+const payoutAccountLimit = 10
+
+// PayoutAccountRequest 新增/修改收款账户请求；
+// bank 需要开户名、银行名称和账号（IBAN 或银行卡号），wallet 需要链和地址
+type PayoutAccountRequest struct {
+	AccountType   string `json:"account_type" binding:"required,oneof=bank wallet"`
+	HolderName    string `json:"holder_name" binding:"max=64"`
+	BankName      string `json:"bank_name" binding:"max=64"`
+	BankCode      string `json:"bank_code" binding:"max=16"` // SWIFT/BIC，可选
+	AccountNo     string `json:"account_no" binding:"max=64"`
+	Chain         string `json:"chain" binding:"omitempty,oneof=ethereum bsc polygon tron"`
+	WalletAddress string `json:"wallet_address" binding:"max=64"`
+}
+
+// PayoutAccountService 商户收款账户，账号和地址在响应中自动脱敏（mask.String）
+type PayoutAccountService struct {
+	ctx context.Context
+}
+
+func NewPayoutAccountService(ctx context.Context) *PayoutAccountService {
+	dao.SetDefault(database.GetDB())
+	return &PayoutAccountService{ctx: ctx}
+}
+
+// List 获取商户的收款账户
+func (s *PayoutAccountService) List(merID int32) ([]*model.MerMerchantPayoutAccount, error) {
+	a := dao.MerMerchantPayoutAccount
+	list, err := a.WithContext(s.ctx).
+		Where(a.MerID.Eq(merID)).
+		Order(a.AccountID).
+		Find()
+	if err != nil {
+		return nil, fmt.Errorf("查询收款账户失败: %w", err)
+	}
+	return list, nil
+}
+
+// Create 新增收款账户
+func (s *PayoutAccountService) Create(callerID int32, merID int32, req *PayoutAccountRequest) (*model.MerMerchantPayoutAccount, error) {
+	account, err := normalizePayoutAccount(req)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.List(merID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= payoutAccountLimit {
+		return nil, fmt.Errorf("最多只能添加 %d 个收款账户", payoutAccountLimit)
+	}
+	if err := checkDuplicatePayoutAccount(existing, 0, account); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	account.MerID = merID
+	account.CreatedBy = callerID
+	account.CreateAt = now
+	account.UpdateAt = now
+	if err := dao.MerMerchantPayoutAccount.WithContext(s.ctx).Create(account); err != nil {
+		return nil, fmt.Errorf("新增收款账户失败: %w", err)
+	}
+
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		Action:     AuditActionPayoutAccountCreate,
+		EntityType: AuditEntityPayoutAccount,
+		EntityID:   int64(account.AccountID),
+		After:      account,
+	})
+	return account, nil
+}
+
+// Update 修改收款账户，已申请的提现使用申请时的快照，不受影响
+func (s *PayoutAccountService) Update(merID int32, accountID int32, req *PayoutAccountRequest) (*model.MerMerchantPayoutAccount, error) {
+	before, err := s.load(merID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	account, err := normalizePayoutAccount(req)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := s.List(merID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkDuplicatePayoutAccount(existing, accountID, account); err != nil {
+		return nil, err
+	}
+
+	// map 更新不经过序列化器，需要手动加密
+	updates := map[string]interface{}{
+		"account_type": account.AccountType,
+		"bank_name":    account.BankName,
+		"bank_code":    account.BankCode,
+		"chain":        account.Chain,
+		"update_at":    time.Now(),
+	}
+	for column, value := range map[string]string{
+		"holder_name":    account.HolderName,
+		"account_no":     string(account.AccountNo),
+		"wallet_address": string(account.WalletAddress),
+	} {
+		if updates[column], err = fieldcrypt.Encrypt(value); err != nil {
+			return nil, fmt.Errorf("加密收款账户失败: %w", err)
+		}
+	}
+
+	a := dao.MerMerchantPayoutAccount
+	_, err = a.WithContext(s.ctx).
+		Where(a.AccountID.Eq(accountID), a.MerID.Eq(merID)).
+		Updates(updates)
+	if err != nil {
+		return nil, fmt.Errorf("修改收款账户失败: %w", err)
+	}
+
+	after, err := s.load(merID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		Action:     AuditActionPayoutAccountUpdate,
+		EntityType: AuditEntityPayoutAccount,
+		EntityID:   int64(accountID),
+		Before:     before,
+		After:      after,
+	})
+	return after, nil
+}
+
+// Delete 删除收款账户
+func (s *PayoutAccountService) Delete(merID int32, accountID int32) error {
+	before, err := s.load(merID, accountID)
+	if err != nil {
+		return err
+	}
+
+	a := dao.MerMerchantPayoutAccount
+	if _, err := a.WithContext(s.ctx).Where(a.AccountID.Eq(accountID), a.MerID.Eq(merID)).Delete(); err != nil {
+		return fmt.Errorf("删除收款账户失败: %w", err)
+	}
+
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		Action:     AuditActionPayoutAccountDelete,
+		EntityType: AuditEntityPayoutAccount,
+		EntityID:   int64(accountID),
+		Before:     before,
+	})
+	return nil
+}
+
+// load 查询商户的收款账户
+func (s *PayoutAccountService) load(merID int32, accountID int32) (*model.MerMerchantPayoutAccount, error) {
+	a := dao.MerMerchantPayoutAccount
+	account, err := a.WithContext(s.ctx).
+		Where(a.AccountID.Eq(accountID), a.MerID.Eq(merID)).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("收款账户不存在")
+		}
+		return nil, fmt.Errorf("查询收款账户失败: %w", err)
+	}
+	return account, nil
+}
+
+// checkDuplicatePayoutAccount 同一商户不能重复添加相同的账号或地址（字段加密后无法在数据库中比较，在内存中比较）
+func checkDuplicatePayoutAccount(existing []*model.MerMerchantPayoutAccount, excludeID int32, account *model.MerMerchantPayoutAccount) error {
+	for _, item := range existing {
+		if item.AccountID == excludeID || item.AccountType != account.AccountType {
+			continue
+		}
+		if account.AccountType == payout.MethodBank && item.AccountNo == account.AccountNo {
+			return errors.New("该收款账户已存在")
+		}
+		if account.AccountType == payout.MethodWallet && item.Chain == account.Chain && item.WalletAddress == account.WalletAddress {
+			return errors.New("该收款账户已存在")
+		}
+	}
+	return nil
+}
+
+// normalizePayoutAccount 校验收款账户并转换为存储格式：
+// 银行账号去除空格，IBAN 校验 mod-97，其他账号按银行卡号校验 Luhn；
+// EVM 地址转换为 EIP-55 校验格式，TRON 地址校验 Base58Check
+func normalizePayoutAccount(req *PayoutAccountRequest) (*model.MerMerchantPayoutAccount, error) {
+	account := &model.MerMerchantPayoutAccount{AccountType: req.AccountType}
+
+	switch req.AccountType {
+	case payout.MethodBank:
+		account.HolderName = strings.TrimSpace(req.HolderName)
+		account.BankName = strings.TrimSpace(req.BankName)
+		if account.HolderName == "" {
+			return nil, errors.New("请填写开户名")
+		}
+		if account.BankName == "" {
+			return nil, errors.New("请填写银行名称")
+		}
+
+		accountNo := utils.NormalizeAccountNo(req.AccountNo)
+		if utils.IsIBAN(accountNo) {
+			if !utils.IsValidIBAN(accountNo) {
+				return nil, errors.New("IBAN 格式或校验位错误")
+			}
+		} else if !utils.IsValidCardNumber(accountNo) {
+			return nil, errors.New("银行账号格式或校验位错误")
+		}
+		account.AccountNo = mask.String(accountNo)
+
+		if bankCode := strings.ToUpper(strings.TrimSpace(req.BankCode)); bankCode != "" {
+			if !utils.IsValidSWIFT(bankCode) {
+				return nil, errors.New("SWIFT/BIC 格式错误")
+			}
+			account.BankCode = bankCode
+		}
+
+	case payout.MethodWallet:
+		address := strings.TrimSpace(req.WalletAddress)
+		switch req.Chain {
+		case payout.ChainEthereum, payout.ChainBSC, payout.ChainPolygon:
+			checksummed, ok := utils.EVMChecksumAddress(address)
+			if !ok {
+				return nil, errors.New("钱包地址格式或校验位错误")
+			}
+			address = checksummed
+		case payout.ChainTron:
+			if !utils.IsValidTronAddress(address) {
+				return nil, errors.New("TRON 地址格式或校验位错误")
+			}
+		case "":
+			return nil, errors.New("请选择钱包所在的链")
+		default:
+			return nil, fmt.Errorf("不支持的链: %s", req.Chain)
+		}
+		account.Chain = req.Chain
+		account.WalletAddress = mask.String(address)
+		account.HolderName = strings.TrimSpace(req.HolderName)
+
+	default:
+		return nil, fmt.Errorf("无效的账户类型: %s", req.AccountType)
+	}
+	return account, nil
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"merchant_api/internal/pkg/payout"
+	"testing"
+)
+
+func TestNormalizePayoutAccount(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         PayoutAccountRequest
+		wantAccount string
+		wantWallet  string
+		wantCode    string
+		wantErr     bool
+	}{
+		{
+			name:        "银行卡去除空格",
+			req:         PayoutAccountRequest{AccountType: payout.MethodBank, HolderName: " 张三 ", BankName: "招商银行", AccountNo: "4111 1111 1111 1111"},
+			wantAccount: "4111111111111111",
+		},
+		{
+			name:        "IBAN 及 SWIFT 转为大写",
+			req:         PayoutAccountRequest{AccountType: payout.MethodBank, HolderName: "Max", BankName: "Deutsche Bank", BankCode: "deutdeff", AccountNo: "de89 3704 0044 0532 0130 00"},
+			wantAccount: "DE89370400440532013000",
+			wantCode:    "DEUTDEFF",
+		},
+		{
+			name:    "IBAN 校验位错误",
+			req:     PayoutAccountRequest{AccountType: payout.MethodBank, HolderName: "Max", BankName: "Deutsche Bank", AccountNo: "DE89370400440532013001"},
+			wantErr: true,
+		},
+		{
+			name:    "银行卡校验位错误",
+			req:     PayoutAccountRequest{AccountType: payout.MethodBank, HolderName: "张三", BankName: "招商银行", AccountNo: "4111111111111112"},
+			wantErr: true,
+		},
+		{
+			name:    "SWIFT 格式错误",
+			req:     PayoutAccountRequest{AccountType: payout.MethodBank, HolderName: "张三", BankName: "招商银行", BankCode: "ABC", AccountNo: "4111111111111111"},
+			wantErr: true,
+		},
+		{
+			name:    "缺少开户名",
+			req:     PayoutAccountRequest{AccountType: payout.MethodBank, BankName: "招商银行", AccountNo: "4111111111111111"},
+			wantErr: true,
+		},
+		{
+			name:       "EVM 地址转换为校验格式",
+			req:        PayoutAccountRequest{AccountType: payout.MethodWallet, Chain: payout.ChainBSC, WalletAddress: " 0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed "},
+			wantWallet: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		},
+		{
+			name:       "TRON 地址",
+			req:        PayoutAccountRequest{AccountType: payout.MethodWallet, Chain: payout.ChainTron, WalletAddress: "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t"},
+			wantWallet: "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t",
+		},
+		{
+			name:    "EVM 地址填到 TRON",
+			req:     PayoutAccountRequest{AccountType: payout.MethodWallet, Chain: payout.ChainTron, WalletAddress: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"},
+			wantErr: true,
+		},
+		{
+			name:    "没有选择链",
+			req:     PayoutAccountRequest{AccountType: payout.MethodWallet, WalletAddress: "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t"},
+			wantErr: true,
+		},
+		{
+			name:    "无效的账户类型",
+			req:     PayoutAccountRequest{AccountType: "paypal"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizePayoutAccount(&tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("normalizePayoutAccount() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if string(got.AccountNo) != tt.wantAccount || string(got.WalletAddress) != tt.wantWallet || got.BankCode != tt.wantCode {
+				t.Errorf("normalizePayoutAccount() = account_no %q, wallet_address %q, bank_code %q, want %q, %q, %q",
+					got.AccountNo, got.WalletAddress, got.BankCode, tt.wantAccount, tt.wantWallet, tt.wantCode)
+			}
+		})
+	}
+}
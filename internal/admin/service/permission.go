@@ -0,0 +1,67 @@
+package service
+
+// 权限码，路由通过 middleware.RequirePermission 校验
+const (
+	PermCategoryRead     = "category:read"     // 查看商品分类
+	PermCategoryWrite    = "category:write"    // 新增/编辑/删除商品分类
+	PermProductRead      = "product:read"      // 查看商品
+	PermProductWrite     = "product:write"     // 新增/编辑商品、上下架、售完状态
+	PermProductPrice     = "product:price"     // 修改商品及 SKU 价格
+	PermProductDelete    = "product:delete"    // 删除商品
+	PermInventoryRead    = "inventory:read"    // 查看库存及库存变动
+	PermInventoryWrite   = "inventory:write"   // 盘点调整库存、设置低库存预警
+	PermInventoryReserve = "inventory:reserve" // 订单预占、扣减、释放库存
+	PermUploadImage      = "upload:image"      // 上传图片
+	PermMerchantWrite    = "merchant:write"    // 编辑店铺资料
+	PermFinanceRead      = "finance:read"      // 查看资金流水及对账
+	PermFinanceWithdraw  = "finance:withdraw"  // 申请提现
+	PermFinanceAccount   = "finance:account"   // 管理收款账户
+	PermRoleManage       = "role:manage"       // 管理角色及角色分配
+	PermAdminRead        = "admin:read"        // 查看子账号
+	PermAdminWrite       = "admin:write"       // 新增/编辑/禁用/删除子账号
+	PermAuditRead        = "audit:read"        // 查看操作日志
+	PermAPIKeyManage     = "api_key:manage"    // 管理 API 密钥
+)
+
+// PermissionAll 超级权限标记（商户主账号）
+const PermissionAll = "*"
+
+// PermissionItem 权限项
+type PermissionItem struct {
+	Code  string `json:"code"`
+	Name  string `json:"name"`
+	Group string `json:"group"`
+}
+
+// PermissionCatalog 全部可分配的权限
+var PermissionCatalog = []PermissionItem{
+	{Code: PermCategoryRead, Name: "查看分类", Group: "商品分类"},
+	{Code: PermCategoryWrite, Name: "管理分类", Group: "商品分类"},
+	{Code: PermProductRead, Name: "查看商品", Group: "商品"},
+	{Code: PermProductWrite, Name: "编辑商品", Group: "商品"},
+	{Code: PermProductPrice, Name: "修改价格", Group: "商品"},
+	{Code: PermProductDelete, Name: "删除商品", Group: "商品"},
+	{Code: PermInventoryRead, Name: "查看库存", Group: "库存"},
+	{Code: PermInventoryWrite, Name: "调整库存", Group: "库存"},
+	{Code: PermInventoryReserve, Name: "订单库存预占", Group: "库存"},
+	{Code: PermUploadImage, Name: "上传图片", Group: "素材"},
+	{Code: PermMerchantWrite, Name: "编辑店铺资料", Group: "店铺"},
+	{Code: PermFinanceRead, Name: "查看资金流水", Group: "财务"},
+	{Code: PermFinanceWithdraw, Name: "申请提现", Group: "财务"},
+	{Code: PermFinanceAccount, Name: "管理收款账户", Group: "财务"},
+	{Code: PermRoleManage, Name: "角色管理", Group: "系统"},
+	{Code: PermAdminRead, Name: "查看子账号", Group: "系统"},
+	{Code: PermAdminWrite, Name: "管理子账号", Group: "系统"},
+	{Code: PermAuditRead, Name: "查看操作日志", Group: "系统"},
+	{Code: PermAPIKeyManage, Name: "管理API密钥", Group: "系统"},
+}
+
+// isValidPermission 判断权限码是否在权限目录中
+func isValidPermission(code string) bool {
+	for _, item := range PermissionCatalog {
+		if item.Code == code {
+			return true
+		}
+	}
+	return false
+}
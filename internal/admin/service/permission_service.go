@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"merchant_api/internal/dao"
+	"merchant_api/pkg/database"
+	"merchant_api/pkg/redis"
+	"strconv"
+	"strings"
+	"time"
+
+	redisv8 "github.com/go-redis/redis/v8"
+)
+
+// Redis 键
+const adminPermsKeyFmt = "admin:perms:%d" // 管理员权限缓存
+
+// adminPermsTTL 管理员权限缓存时间
+const adminPermsTTL = 10 * time.Minute
+
+type PermissionService struct {
+	ctx context.Context
+}
+
+func NewPermissionService(ctx context.Context) *PermissionService {
+	dao.SetDefault(database.GetDB())
+	return &PermissionService{ctx: ctx}
+}
+
+// GetAdminPermissions 获取管理员拥有的权限码（商户主账号返回 PermissionAll）
+func (s *PermissionService) GetAdminPermissions(adminID int32) ([]string, error) {
+	rdb := redis.GetRedis()
+	cacheKey := fmt.Sprintf(adminPermsKeyFmt, adminID)
+
+	cached, err := rdb.Get(s.ctx, cacheKey).Result()
+	if err == nil {
+		var perms []string
+		if json.Unmarshal([]byte(cached), &perms) == nil {
+			return perms, nil
+		}
+	} else if err != redisv8.Nil {
+		return nil, fmt.Errorf("读取权限缓存失败: %w", err)
+	}
+
+	perms, err := s.loadAdminPermissions(adminID)
+	if err != nil {
+		return nil, err
+	}
+
+	if data, err := json.Marshal(perms); err == nil {
+		rdb.Set(s.ctx, cacheKey, data, adminPermsTTL)
+	}
+	return perms, nil
+}
+
+func (s *PermissionService) loadAdminPermissions(adminID int32) ([]string, error) {
+	a := dao.MerMerchantAdmin
+	admin, err := a.WithContext(s.ctx).
+		Where(a.MerchantAdminID.Eq(adminID)).
+		Where(a.IsDel.Eq(0)).
+		First()
+	if err != nil {
+		return nil, fmt.Errorf("查询管理员失败: %w", err)
+	}
+
+	// 平台创建的商户主账号拥有全部权限
+	if admin.Level == 0 {
+		return []string{PermissionAll}, nil
+	}
+
+	roleIDs := parseRoleIDs(admin.Roles)
+	if len(roleIDs) == 0 {
+		return []string{}, nil
+	}
+
+	r := dao.MerSystemRole
+	roles, err := r.WithContext(s.ctx).
+		Where(r.RoleID.In(roleIDs...)).
+		Where(r.MerID.Eq(admin.MerID)).
+		Where(r.Status.Eq(1)).
+		Find()
+	if err != nil {
+		return nil, fmt.Errorf("查询角色失败: %w", err)
+	}
+
+	seen := make(map[string]bool)
+	perms := make([]string, 0)
+	for _, role := range roles {
+		for _, code := range splitRules(role.Rules) {
+			if !seen[code] {
+				seen[code] = true
+				perms = append(perms, code)
+			}
+		}
+	}
+	return perms, nil
+}
+
+// InvalidateAdmins 清除指定管理员的权限缓存
+func (s *PermissionService) InvalidateAdmins(adminIDs ...int32) error {
+	if len(adminIDs) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(adminIDs))
+	for _, id := range adminIDs {
+		keys = append(keys, fmt.Sprintf(adminPermsKeyFmt, id))
+	}
+	return redis.GetRedis().Del(s.ctx, keys...).Err()
+}
+
+// InvalidateMerchant 清除商户下所有管理员的权限缓存
+func (s *PermissionService) InvalidateMerchant(merID int32) error {
+	a := dao.MerMerchantAdmin
+	var adminIDs []int32
+	if err := a.WithContext(s.ctx).Where(a.MerID.Eq(merID)).Pluck(a.MerchantAdminID, &adminIDs); err != nil {
+		return fmt.Errorf("查询管理员失败: %w", err)
+	}
+	return s.InvalidateAdmins(adminIDs...)
+}
+
+// HasPermission 判断权限列表是否包含指定权限码
+func HasPermission(perms []string, code string) bool {
+	for _, p := range perms {
+		if p == PermissionAll || p == code {
+			return true
+		}
+	}
+	return false
+}
+
+// parseRoleIDs 解析 MerMerchantAdmin.Roles（逗号分隔的角色ID）
+func parseRoleIDs(roles *string) []int32 {
+	if roles == nil {
+		return nil
+	}
+	ids := make([]int32, 0)
+	for _, part := range strings.Split(*roles, ",") {
+		id, err := strconv.Atoi(strings.TrimSpace(part))
+		if err == nil && id > 0 {
+			ids = append(ids, int32(id))
+		}
+	}
+	return ids
+}
+
+// formatRoleIDs 将角色ID格式化为 MerMerchantAdmin.Roles 的存储格式
+func formatRoleIDs(ids []int32) string {
+	parts := make([]string, 0, len(ids))
+	for _, id := range ids {
+		parts = append(parts, strconv.Itoa(int(id)))
+	}
+	return strings.Join(parts, ",")
+}
+
+// splitRules 解析角色的权限码
+func splitRules(rules string) []string {
+	codes := make([]string, 0)
+	for _, part := range strings.Split(rules, ",") {
+		code := strings.TrimSpace(part)
+		if code != "" {
+			codes = append(codes, code)
+		}
+	}
+	return codes
+}
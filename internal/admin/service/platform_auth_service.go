@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"merchant_api/internal/dao"
+	"merchant_api/internal/model"
+	"merchant_api/internal/pkg/jwt"
+	"merchant_api/internal/pkg/utils"
+	"merchant_api/pkg/config"
+	"merchant_api/pkg/database"
+	"merchant_api/pkg/redis"
+	"time"
+
+	redisv8 "github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+)
+
+// Redis 键
+const (
+	platformTokenKeyFmt          = "platform:token:%s"           // 运营令牌 -> platformTokenData
+	platformOperatorTokensKeyFmt = "platform:operator:%d:tokens" // 运营账号签发过的令牌
+)
+
+// platformLoginPrefix 运营账号登录失败统计与商户管理员分开，避免同名账号互相影响
+const platformLoginPrefix = "platform:"
+
+// PlatformAuditAccount 运营账号在操作日志中的账号名，与商户管理员区分
+func PlatformAuditAccount(account string) string {
+	return platformLoginPrefix + account
+}
+
+// PlatformLoginResponse 运营登录响应
+type PlatformLoginResponse struct {
+	Token     string              `json:"token"`
+	ExpiresIn int                 `json:"expires_in"`
+	Operator  *model.PlatOperator `json:"operator"`
+}
+
+// platformTokenData 运营令牌在 Redis 中的存储结构，令牌绑定登录 IP
+type platformTokenData struct {
+	OperatorID int32  `json:"operator_id"`
+	IP         string `json:"ip"`
+}
+
+// PlatformAuthService 平台运营登录：令牌为 RolePlatform 的 JWT，不能访问商户接口；
+// 令牌有绝对有效期（platform.token_expire），无操作超过 platform.idle_timeout 后失效，不支持刷新
+type PlatformAuthService struct {
+	ctx context.Context
+}
+
+func NewPlatformAuthService(ctx context.Context) *PlatformAuthService {
+	dao.SetDefault(database.GetDB())
+	return &PlatformAuthService{ctx: ctx}
+}
+
+// Login 运营账号登录，失败次数限制与商户管理员相同（见 LoginSecurityService）
+func (s *PlatformAuthService) Login(account, password string, captcha *CaptchaInput, ip string) (*PlatformLoginResponse, error) {
+	security := NewLoginSecurityService(s.ctx)
+	subject := platformLoginPrefix + account
+	if err := security.Check(subject, nil, ip, captcha); err != nil {
+		return nil, err
+	}
+
+	o := dao.PlatOperator
+	operator, err := o.WithContext(s.ctx).Where(o.Account.Eq(account)).First()
+	if err != nil || !utils.CheckPassword(password, operator.Pwd) {
+		if err := security.RecordFailure(subject, nil, ip); err != nil {
+			var locked *LoginLockedError
+			if errors.As(err, &locked) {
+				return nil, err
+			}
+			fmt.Printf("记录登录失败失败: %v\n", err)
+		}
+		return nil, errors.New("账号或密码错误")
+	}
+	if operator.Status != 1 {
+		return nil, errors.New("账号已被禁用")
+	}
+	if err := security.RecordSuccess(subject, nil); err != nil {
+		fmt.Printf("清除登录失败记录失败: %v\n", err)
+	}
+
+	cfg := config.GlobalConfig.Platform
+	token, err := jwt.GenerateToken(uint(operator.OperatorID), 0, operator.Account, jwt.RolePlatform, uuid.New().String(), cfg.TokenExpire)
+	if err != nil {
+		return nil, fmt.Errorf("生成 Token 失败: %w", err)
+	}
+	data, err := json.Marshal(platformTokenData{OperatorID: operator.OperatorID, IP: ip})
+	if err != nil {
+		return nil, fmt.Errorf("生成 Token 失败: %w", err)
+	}
+
+	tokensKey := fmt.Sprintf(platformOperatorTokensKeyFmt, operator.OperatorID)
+	_, err = redis.GetRedis().TxPipelined(s.ctx, func(pipe redisv8.Pipeliner) error {
+		pipe.Set(s.ctx, fmt.Sprintf(platformTokenKeyFmt, token), data, s.idleTimeout())
+		pipe.SAdd(s.ctx, tokensKey, token)
+		pipe.Expire(s.ctx, tokensKey, time.Duration(cfg.TokenExpire)*time.Second)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("存储 Token 失败: %w", err)
+	}
+
+	now := time.Now()
+	_, err = o.WithContext(s.ctx).
+		Where(o.OperatorID.Eq(operator.OperatorID)).
+		Updates(map[string]interface{}{
+			"last_ip":   ip,
+			"last_time": now,
+		})
+	if err != nil {
+		fmt.Printf("更新登录信息失败: %v\n", err)
+	}
+
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		Account:    PlatformAuditAccount(operator.Account),
+		IP:         ip,
+		Action:     AuditActionOperatorLogin,
+		EntityType: AuditEntityOperator,
+		EntityID:   int64(operator.OperatorID),
+	})
+
+	return &PlatformLoginResponse{
+		Token:     token,
+		ExpiresIn: cfg.TokenExpire,
+		Operator:  operator,
+	}, nil
+}
+
+// VerifyToken 验证运营令牌并顺延空闲超时
+func (s *PlatformAuthService) VerifyToken(token, currentIP string) (*jwt.Claims, error) {
+	claims, err := jwt.ParseToken(token)
+	if err != nil || claims.Role != jwt.RolePlatform {
+		return nil, errors.New("Token 无效或已过期")
+	}
+
+	rdb := redis.GetRedis()
+	key := fmt.Sprintf(platformTokenKeyFmt, token)
+	value, err := rdb.Get(s.ctx, key).Result()
+	if err != nil {
+		if err == redisv8.Nil {
+			return nil, errors.New("Token 已失效")
+		}
+		return nil, fmt.Errorf("验证 Token 失败: %w", err)
+	}
+
+	var data platformTokenData
+	if err := json.Unmarshal([]byte(value), &data); err != nil {
+		return nil, errors.New("Token 数据格式错误")
+	}
+	if uint(data.OperatorID) != claims.UserID {
+		return nil, errors.New("Token 数据不匹配")
+	}
+	if !ipBindAllowed(IPBindStrict, data.IP, currentIP) {
+		return nil, errors.New("登录 IP 已变更，请重新登录")
+	}
+
+	if err := rdb.Expire(s.ctx, key, s.idleTimeout()).Err(); err != nil {
+		fmt.Printf("更新令牌有效期失败: %v\n", err)
+	}
+	return claims, nil
+}
+
+// Logout 登出
+func (s *PlatformAuthService) Logout(token string) error {
+	claims, err := jwt.ParseToken(token)
+	if err != nil || claims.Role != jwt.RolePlatform {
+		return nil
+	}
+
+	rdb := redis.GetRedis()
+	_, err = rdb.TxPipelined(s.ctx, func(pipe redisv8.Pipeliner) error {
+		pipe.Del(s.ctx, fmt.Sprintf(platformTokenKeyFmt, token))
+		pipe.SRem(s.ctx, fmt.Sprintf(platformOperatorTokensKeyFmt, claims.UserID), token)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("登出失败: %w", err)
+	}
+
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		Account:    PlatformAuditAccount(claims.Username),
+		Action:     AuditActionOperatorLogout,
+		EntityType: AuditEntityOperator,
+		EntityID:   int64(claims.UserID),
+	})
+	return nil
+}
+
+// RevokeAll 吊销运营账号的所有令牌（禁用账号、修改密码后调用）
+func (s *PlatformAuthService) RevokeAll(operatorID int32) error {
+	rdb := redis.GetRedis()
+	tokensKey := fmt.Sprintf(platformOperatorTokensKeyFmt, operatorID)
+	tokens, err := rdb.SMembers(s.ctx, tokensKey).Result()
+	if err != nil {
+		return fmt.Errorf("查询运营令牌失败: %w", err)
+	}
+
+	keys := []string{tokensKey}
+	for _, token := range tokens {
+		keys = append(keys, fmt.Sprintf(platformTokenKeyFmt, token))
+	}
+	if err := rdb.Del(s.ctx, keys...).Err(); err != nil {
+		return fmt.Errorf("吊销运营令牌失败: %w", err)
+	}
+	return nil
+}
+
+// idleTimeout 令牌空闲超时，未配置时使用令牌有效期
+func (s *PlatformAuthService) idleTimeout() time.Duration {
+	cfg := config.GlobalConfig.Platform
+	if cfg.IdleTimeout > 0 {
+		return time.Duration(cfg.IdleTimeout) * time.Second
+	}
+	return time.Duration(cfg.TokenExpire) * time.Second
+}
@@ -0,0 +1,370 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"merchant_api/internal/dao"
+	"merchant_api/internal/model"
+	"merchant_api/internal/pkg/fieldcrypt"
+	"merchant_api/internal/pkg/utils"
+	"merchant_api/pkg/database"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PlatformMerchantListRequest 商户列表查询条件
+type PlatformMerchantListRequest struct {
+	Page        int    `form:"page,default=1"`
+	PageSize    int    `form:"page_size,default=20" binding:"max=100"`
+	Keyword     string `form:"keyword"`                                      // 商户名称
+	Phone       string `form:"phone"`                                        // 商户手机号（精确匹配）
+	Status      *int32 `form:"status" binding:"omitempty,oneof=0 1"`         // 0 锁定 1 正常
+	AuditStatus *int32 `form:"audit_status" binding:"omitempty,oneof=0 1 2"` // 审核状态
+	CategoryID  *int32 `form:"category_id"`                                  // 商户分类
+}
+
+// CreateMerchantRequest 平台创建商户请求，创建后为待审核状态
+type CreateMerchantRequest struct {
+	MerName      string  `json:"mer_name" binding:"required,max=32"`
+	RealName     string  `json:"real_name" binding:"required,max=32"`
+	MerPhone     string  `json:"mer_phone" binding:"required"`
+	CategoryIDs  []int32 `json:"category_ids" binding:"required,min=1"`
+	MerAddress   string  `json:"mer_address" binding:"max=64"`
+	MerKeyword   string  `json:"mer_keyword" binding:"max=64"`
+	MerInfo      string  `json:"mer_info" binding:"max=256"`
+	ServicePhone string  `json:"service_phone" binding:"max=13"`
+	Timezone     string  `json:"timezone" binding:"max=64"` // IANA 时区，默认 Asia/Shanghai
+	Mark         string  `json:"mark" binding:"max=256"`    // 备注
+	Sort         int32   `json:"sort" binding:"min=0"`
+}
+
+// UpdateMerchantRequest 平台修改商户请求，不传的字段保持不变；店铺展示资料由商户自己维护
+type UpdateMerchantRequest struct {
+	MerName     *string  `json:"mer_name" binding:"omitempty,min=1,max=32"`
+	RealName    *string  `json:"real_name" binding:"omitempty,min=1,max=32"`
+	MerPhone    *string  `json:"mer_phone"`
+	CategoryIDs *[]int32 `json:"category_ids" binding:"omitempty,min=1"`
+	Mark        *string  `json:"mark" binding:"omitempty,max=256"`
+	Sort        *int32   `json:"sort" binding:"omitempty,min=0"`
+}
+
+// MerchantAuditRequest 审核商户请求，驳回时必须填写原因
+type MerchantAuditRequest struct {
+	Remark string `json:"remark" binding:"max=255"`
+}
+
+// PlatformMerchantService 平台运营管理商户：创建、审核、分配分类、锁定/解锁
+type PlatformMerchantService struct {
+	ctx context.Context
+}
+
+func NewPlatformMerchantService(ctx context.Context) *PlatformMerchantService {
+	dao.SetDefault(database.GetDB())
+	return &PlatformMerchantService{ctx: ctx}
+}
+
+// List 查询商户列表
+func (s *PlatformMerchantService) List(req *PlatformMerchantListRequest) ([]*model.MerMerchant, int64, error) {
+	m := dao.MerMerchant
+	query := m.WithContext(s.ctx).Where(m.IsDel.Eq(0))
+
+	if req.Keyword != "" {
+		query = query.Where(m.MerName.Like("%" + req.Keyword + "%"))
+	}
+	if req.Phone != "" {
+		hash, err := fieldcrypt.BlindIndex(req.Phone)
+		if err != nil {
+			return nil, 0, fmt.Errorf("计算手机号索引失败: %w", err)
+		}
+		query = query.Where(m.MerPhoneHash.Eq(hash))
+	}
+	if req.Status != nil {
+		query = query.Where(m.Status.Is(*req.Status == 1))
+	}
+	if req.AuditStatus != nil {
+		query = query.Where(m.AuditStatus.Eq(*req.AuditStatus))
+	}
+	if req.CategoryID != nil {
+		var merIDs []int32
+		err := database.GetDB().WithContext(s.ctx).
+			Model(&model.MerMerchant{}).
+			Where("is_del = 0 AND JSON_CONTAINS(category_ids, ?)", strconv.Itoa(int(*req.CategoryID))).
+			Pluck("mer_id", &merIDs).Error
+		if err != nil {
+			return nil, 0, fmt.Errorf("查询分类下的商户失败: %w", err)
+		}
+		if len(merIDs) == 0 {
+			return []*model.MerMerchant{}, 0, nil
+		}
+		query = query.Where(m.MerID.In(merIDs...))
+	}
+
+	total, err := query.Count()
+	if err != nil {
+		return nil, 0, fmt.Errorf("查询商户总数失败: %w", err)
+	}
+
+	list, err := query.
+		Order(m.MerID.Desc()).
+		Limit(req.PageSize).
+		Offset((req.Page - 1) * req.PageSize).
+		Find()
+	if err != nil {
+		return nil, 0, fmt.Errorf("查询商户列表失败: %w", err)
+	}
+	return list, total, nil
+}
+
+// Get 获取商户详情
+func (s *PlatformMerchantService) Get(merID int32) (*model.MerMerchant, error) {
+	return NewMerchantService(s.ctx).GetProfile(merID)
+}
+
+// Create 创建商户，审核通过前商户管理员不能登录
+func (s *PlatformMerchantService) Create(req *CreateMerchantRequest) (*model.MerMerchant, error) {
+	name := strings.TrimSpace(req.MerName)
+	if name == "" {
+		return nil, errors.New("商户名称不能为空")
+	}
+	phone := strings.TrimSpace(req.MerPhone)
+	phoneHash, err := s.checkPhoneAvailable(phone, 0)
+	if err != nil {
+		return nil, err
+	}
+	categoryIDs, err := NewMerchantCategoryService(s.ctx).checkCategoryIDs(req.CategoryIDs)
+	if err != nil {
+		return nil, err
+	}
+	servicePhone := strings.TrimSpace(req.ServicePhone)
+	if servicePhone != "" && !utils.IsValidPhone(servicePhone) {
+		return nil, errors.New("客服电话格式不正确")
+	}
+	timezone := req.Timezone
+	if timezone == "" {
+		timezone = defaultMerchantTimezone
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return nil, fmt.Errorf("无效的时区: %s", timezone)
+	}
+
+	now := time.Now()
+	merchant := &model.MerMerchant{
+		CategoryIds:  formatCategoryIDs(categoryIDs),
+		MerName:      name,
+		RealName:     strings.TrimSpace(req.RealName),
+		MerPhone:     phone,
+		MerPhoneHash: phoneHash,
+		MerAddress:   strings.TrimSpace(req.MerAddress),
+		MerKeyword:   strings.TrimSpace(req.MerKeyword),
+		MerInfo:      strings.TrimSpace(req.MerInfo),
+		ServicePhone: servicePhone,
+		Timezone:     timezone,
+		Mark:         strings.TrimSpace(req.Mark),
+		Sort:         req.Sort,
+		Status:       true,
+		AuditStatus:  MerchantAuditPending,
+		CreateAt:     now,
+		UpdateAt:     now,
+	}
+	if err := dao.MerMerchant.WithContext(s.ctx).Create(merchant); err != nil {
+		return nil, fmt.Errorf("创建商户失败: %w", err)
+	}
+
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		MerID:      merchant.MerID,
+		Action:     AuditActionMerchantCreate,
+		EntityType: AuditEntityMerchant,
+		EntityID:   int64(merchant.MerID),
+		After:      merchant,
+	})
+	return merchant, nil
+}
+
+// Update 修改商户基本信息和分类
+func (s *PlatformMerchantService) Update(merID int32, req *UpdateMerchantRequest) (*model.MerMerchant, error) {
+	before, err := s.Get(merID)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make(map[string]interface{})
+	if req.MerName != nil {
+		name := strings.TrimSpace(*req.MerName)
+		if name == "" {
+			return nil, errors.New("商户名称不能为空")
+		}
+		updates["mer_name"] = name
+	}
+	// map 更新不经过序列化器，需要手动加密
+	if req.RealName != nil {
+		if updates["real_name"], err = fieldcrypt.Encrypt(strings.TrimSpace(*req.RealName)); err != nil {
+			return nil, fmt.Errorf("加密商户姓名失败: %w", err)
+		}
+	}
+	if req.MerPhone != nil {
+		phone := strings.TrimSpace(*req.MerPhone)
+		phoneHash, err := s.checkPhoneAvailable(phone, merID)
+		if err != nil {
+			return nil, err
+		}
+		if updates["mer_phone"], err = fieldcrypt.Encrypt(phone); err != nil {
+			return nil, fmt.Errorf("加密商户手机号失败: %w", err)
+		}
+		updates["mer_phone_hash"] = phoneHash
+	}
+	if req.CategoryIDs != nil {
+		categoryIDs, err := NewMerchantCategoryService(s.ctx).checkCategoryIDs(*req.CategoryIDs)
+		if err != nil {
+			return nil, err
+		}
+		updates["category_ids"] = formatCategoryIDs(categoryIDs)
+	}
+	if req.Mark != nil {
+		updates["mark"] = strings.TrimSpace(*req.Mark)
+	}
+	if req.Sort != nil {
+		updates["sort"] = *req.Sort
+	}
+
+	if len(updates) == 0 {
+		return before, nil
+	}
+	updates["update_at"] = time.Now()
+
+	m := dao.MerMerchant
+	if _, err := m.WithContext(s.ctx).Where(m.MerID.Eq(merID)).Updates(updates); err != nil {
+		return nil, fmt.Errorf("修改商户失败: %w", err)
+	}
+
+	after, err := s.Get(merID)
+	if err != nil {
+		return nil, err
+	}
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		MerID:      merID,
+		Action:     AuditActionMerchantUpdate,
+		EntityType: AuditEntityMerchant,
+		EntityID:   int64(merID),
+		Before:     before,
+		After:      after,
+	})
+	return after, nil
+}
+
+// Approve 审核通过，待审核和已驳回的商户都可以通过
+func (s *PlatformMerchantService) Approve(operatorID, merID int32, req *MerchantAuditRequest) (*model.MerMerchant, error) {
+	return s.audit(operatorID, merID, MerchantAuditApproved, req.Remark, AuditActionMerchantApprove)
+}
+
+// Reject 驳回，只有待审核的商户可以驳回
+func (s *PlatformMerchantService) Reject(operatorID, merID int32, req *MerchantAuditRequest) (*model.MerMerchant, error) {
+	if strings.TrimSpace(req.Remark) == "" {
+		return nil, errors.New("请填写驳回原因")
+	}
+	return s.audit(operatorID, merID, MerchantAuditRejected, req.Remark, AuditActionMerchantReject)
+}
+
+// audit 变更审核状态，只有状态仍为读取时的状态才会更新
+func (s *PlatformMerchantService) audit(operatorID, merID int32, to int32, remark, action string) (*model.MerMerchant, error) {
+	before, err := s.Get(merID)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case before.AuditStatus == MerchantAuditApproved:
+		return nil, errors.New("商户已审核通过")
+	case to == MerchantAuditRejected && before.AuditStatus != MerchantAuditPending:
+		return nil, errors.New("只有待审核的商户可以驳回")
+	}
+
+	m := dao.MerMerchant
+	info, err := m.WithContext(s.ctx).
+		Where(m.MerID.Eq(merID), m.AuditStatus.Eq(before.AuditStatus)).
+		Updates(map[string]interface{}{
+			"audit_status": to,
+			"audit_remark": strings.TrimSpace(remark),
+			"audit_by":     operatorID,
+			"audit_at":     time.Now(),
+			"update_at":    time.Now(),
+		})
+	if err != nil {
+		return nil, fmt.Errorf("更新审核状态失败: %w", err)
+	}
+	if info.RowsAffected == 0 {
+		return nil, errors.New("商户审核状态已变更，请刷新后重试")
+	}
+	if err := NewMerchantService(s.ctx).InvalidateStatus(merID); err != nil {
+		return nil, err
+	}
+
+	after, err := s.Get(merID)
+	if err != nil {
+		return nil, err
+	}
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		MerID:      merID,
+		Action:     action,
+		EntityType: AuditEntityMerchant,
+		EntityID:   int64(merID),
+		Before:     before,
+		After:      after,
+	})
+	return after, nil
+}
+
+// SetLocked 锁定/解锁商户，锁定后该商户所有管理员的会话立即失效
+func (s *PlatformMerchantService) SetLocked(merID int32, locked bool) (*model.MerMerchant, error) {
+	before, err := s.Get(merID)
+	if err != nil {
+		return nil, err
+	}
+	if before.Status == !locked {
+		return before, nil
+	}
+	if err := NewMerchantService(s.ctx).SetStatus(merID, !locked); err != nil {
+		return nil, err
+	}
+
+	after, err := s.Get(merID)
+	if err != nil {
+		return nil, err
+	}
+	action := AuditActionMerchantUnlock
+	if locked {
+		action = AuditActionMerchantLock
+	}
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		MerID:      merID,
+		Action:     action,
+		EntityType: AuditEntityMerchant,
+		EntityID:   int64(merID),
+		Before:     before,
+		After:      after,
+	})
+	return after, nil
+}
+
+// checkPhoneAvailable 校验商户手机号格式及唯一性，返回手机号盲索引
+func (s *PlatformMerchantService) checkPhoneAvailable(phone string, excludeID int32) (string, error) {
+	if !utils.IsValidPhone(phone) {
+		return "", errors.New("手机号格式错误")
+	}
+	hash, err := fieldcrypt.BlindIndex(phone)
+	if err != nil {
+		return "", fmt.Errorf("计算手机号索引失败: %w", err)
+	}
+
+	m := dao.MerMerchant
+	count, err := m.WithContext(s.ctx).
+		Where(m.MerPhoneHash.Eq(hash), m.IsDel.Eq(0), m.MerID.Neq(excludeID)).
+		Count()
+	if err != nil {
+		return "", fmt.Errorf("查询手机号失败: %w", err)
+	}
+	if count > 0 {
+		return "", errors.New("手机号已被其他商户使用")
+	}
+	return hash, nil
+}
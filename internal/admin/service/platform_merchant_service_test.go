@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"database/sql/driver"
+	"strings"
+	"testing"
+)
+
+func TestPlatformMerchantCreateIsPending(t *testing.T) {
+	initTestCrypto(t)
+	fake := newFakeDB(t, func(query string, args []driver.Value) ([]string, [][]driver.Value) {
+		switch {
+		case strings.Contains(query, "FROM `mer_merchant_category`"):
+			return countRows(int64(len(args)))
+		case strings.Contains(query, "count("):
+			return countRows(0)
+		}
+		return nil, nil
+	})
+
+	merchant, err := NewPlatformMerchantService(context.Background()).Create(&CreateMerchantRequest{
+		MerName:     "街角咖啡",
+		RealName:    "王老板",
+		MerPhone:    "13800138000",
+		CategoryIDs: []int32{1, 3},
+	})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if merchant.AuditStatus != MerchantAuditPending {
+		t.Errorf("AuditStatus = %d, want %d", merchant.AuditStatus, MerchantAuditPending)
+	}
+
+	inserts := fake.inserts("mer_merchant")
+	if len(inserts) != 1 {
+		t.Fatalf("got %d inserts into mer_merchant, want 1", len(inserts))
+	}
+	value, ok := insertColumn(inserts[0], "audit_status")
+	if !ok {
+		t.Fatalf("audit_status not written: %s", inserts[0].Query)
+	}
+	if value != int64(MerchantAuditPending) {
+		t.Errorf("audit_status written as %v, want %d", value, MerchantAuditPending)
+	}
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"merchant_api/internal/dao"
+	"merchant_api/internal/model"
+	"merchant_api/internal/pkg/utils"
+	"merchant_api/pkg/database"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// CreateOperatorRequest 创建运营账号请求
+type CreateOperatorRequest struct {
+	Account  string `json:"account" binding:"required,min=4,max=20"`
+	Password string `json:"password" binding:"required"`
+	RealName string `json:"real_name" binding:"required,max=16"`
+}
+
+// OperatorPasswordRequest 修改运营账号密码请求
+type OperatorPasswordRequest struct {
+	OldPassword string `json:"old_password" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required"`
+}
+
+// PlatformOperatorService 平台运营账号，所有运营账号权限相同
+type PlatformOperatorService struct {
+	ctx context.Context
+}
+
+func NewPlatformOperatorService(ctx context.Context) *PlatformOperatorService {
+	dao.SetDefault(database.GetDB())
+	return &PlatformOperatorService{ctx: ctx}
+}
+
+// List 获取所有运营账号
+func (s *PlatformOperatorService) List() ([]*model.PlatOperator, error) {
+	o := dao.PlatOperator
+	list, err := o.WithContext(s.ctx).Order(o.OperatorID).Find()
+	if err != nil {
+		return nil, fmt.Errorf("查询运营账号失败: %w", err)
+	}
+	return list, nil
+}
+
+// Get 获取运营账号
+func (s *PlatformOperatorService) Get(operatorID int32) (*model.PlatOperator, error) {
+	o := dao.PlatOperator
+	operator, err := o.WithContext(s.ctx).Where(o.OperatorID.Eq(operatorID)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("运营账号不存在")
+		}
+		return nil, fmt.Errorf("查询运营账号失败: %w", err)
+	}
+	return operator, nil
+}
+
+// Create 创建运营账号，callerID 为 0 表示通过命令行创建
+func (s *PlatformOperatorService) Create(callerID int32, req *CreateOperatorRequest) (*model.PlatOperator, error) {
+	account := strings.TrimSpace(req.Account)
+	o := dao.PlatOperator
+	count, err := o.WithContext(s.ctx).Where(o.Account.Eq(account)).Count()
+	if err != nil {
+		return nil, fmt.Errorf("查询账号失败: %w", err)
+	}
+	if count > 0 {
+		return nil, errors.New("账号已存在")
+	}
+	if err := NewPasswordService(s.ctx).ValidatePolicy(req.Password); err != nil {
+		return nil, err
+	}
+	pwd, err := utils.HashPassword(req.Password)
+	if err != nil {
+		return nil, fmt.Errorf("密码加密失败: %w", err)
+	}
+
+	now := time.Now()
+	operator := &model.PlatOperator{
+		Account:   account,
+		Pwd:       pwd,
+		RealName:  strings.TrimSpace(req.RealName),
+		Status:    1,
+		CreatedBy: callerID,
+		CreateAt:  now,
+		UpdateAt:  now,
+	}
+	if err := o.WithContext(s.ctx).Create(operator); err != nil {
+		return nil, fmt.Errorf("创建运营账号失败: %w", err)
+	}
+
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		Action:     AuditActionOperatorCreate,
+		EntityType: AuditEntityOperator,
+		EntityID:   int64(operator.OperatorID),
+		After:      operator,
+	})
+	return operator, nil
+}
+
+// UpdateStatus 启用/禁用运营账号，禁用后已登录的令牌立即失效；不能禁用自己
+func (s *PlatformOperatorService) UpdateStatus(callerID, operatorID int32, status int32) error {
+	if callerID == operatorID && status != 1 {
+		return errors.New("不能禁用自己的账号")
+	}
+	before, err := s.Get(operatorID)
+	if err != nil {
+		return err
+	}
+
+	o := dao.PlatOperator
+	_, err = o.WithContext(s.ctx).
+		Where(o.OperatorID.Eq(operatorID)).
+		Updates(map[string]interface{}{
+			"status":    status,
+			"update_at": time.Now(),
+		})
+	if err != nil {
+		return fmt.Errorf("更新运营账号状态失败: %w", err)
+	}
+	if status != 1 {
+		if err := NewPlatformAuthService(s.ctx).RevokeAll(operatorID); err != nil {
+			return err
+		}
+	}
+
+	after := *before
+	after.Status = status
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		Action:     AuditActionOperatorStatus,
+		EntityType: AuditEntityOperator,
+		EntityID:   int64(operatorID),
+		Before:     before,
+		After:      &after,
+	})
+	return nil
+}
+
+// ChangePassword 修改自己的密码，修改后所有令牌失效，需要重新登录
+func (s *PlatformOperatorService) ChangePassword(operatorID int32, req *OperatorPasswordRequest) error {
+	operator, err := s.Get(operatorID)
+	if err != nil {
+		return err
+	}
+	if !utils.CheckPassword(req.OldPassword, operator.Pwd) {
+		return errors.New("原密码错误")
+	}
+	if req.OldPassword == req.NewPassword {
+		return errors.New("新密码不能与原密码相同")
+	}
+	if err := NewPasswordService(s.ctx).ValidatePolicy(req.NewPassword); err != nil {
+		return err
+	}
+	pwd, err := utils.HashPassword(req.NewPassword)
+	if err != nil {
+		return fmt.Errorf("密码加密失败: %w", err)
+	}
+
+	o := dao.PlatOperator
+	_, err = o.WithContext(s.ctx).
+		Where(o.OperatorID.Eq(operatorID)).
+		Updates(map[string]interface{}{
+			"pwd":       pwd,
+			"update_at": time.Now(),
+		})
+	if err != nil {
+		return fmt.Errorf("修改密码失败: %w", err)
+	}
+	if err := NewPlatformAuthService(s.ctx).RevokeAll(operatorID); err != nil {
+		return err
+	}
+
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		Action:     AuditActionOperatorPassword,
+		EntityType: AuditEntityOperator,
+		EntityID:   int64(operatorID),
+	})
+	return nil
+}
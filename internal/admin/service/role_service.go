@@ -0,0 +1,236 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"merchant_api/internal/dao"
+	"merchant_api/internal/model"
+	"merchant_api/pkg/database"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type RoleService struct {
+	ctx context.Context
+}
+
+func NewRoleService(ctx context.Context) *RoleService {
+	dao.SetDefault(database.GetDB())
+	return &RoleService{ctx: ctx}
+}
+
+// RoleRequest 创建/更新角色请求
+type RoleRequest struct {
+	RoleName string   `json:"role_name" binding:"required,max=32"`
+	Rules    []string `json:"rules" binding:"required,min=1"`
+	Status   *int32   `json:"status" binding:"omitempty,oneof=0 1"`
+}
+
+// validateRules 校验权限码并转换为存储格式，角色权限不能超出操作人自己的权限
+func validateRules(rules []string, callerPerms []string) (string, error) {
+	seen := make(map[string]bool)
+	codes := make([]string, 0, len(rules))
+	for _, code := range rules {
+		code = strings.TrimSpace(code)
+		if !isValidPermission(code) {
+			return "", fmt.Errorf("无效的权限码: %s", code)
+		}
+		if !HasPermission(callerPerms, code) {
+			return "", fmt.Errorf("不能授予自己没有的权限: %s", code)
+		}
+		if !seen[code] {
+			seen[code] = true
+			codes = append(codes, code)
+		}
+	}
+	return strings.Join(codes, ","), nil
+}
+
+// Create 创建角色
+func (s *RoleService) Create(callerID int32, req *RoleRequest, merID int32) (*model.MerSystemRole, error) {
+	perms, err := NewPermissionService(s.ctx).GetAdminPermissions(callerID)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := validateRules(req.Rules, perms)
+	if err != nil {
+		return nil, err
+	}
+
+	role := &model.MerSystemRole{
+		MerID:    merID,
+		RoleName: req.RoleName,
+		Rules:    rules,
+		Status:   1,
+		CreateAt: time.Now(),
+	}
+	if req.Status != nil {
+		role.Status = *req.Status
+	}
+
+	if err := dao.MerSystemRole.WithContext(s.ctx).Create(role); err != nil {
+		return nil, fmt.Errorf("创建角色失败: %w", err)
+	}
+	return role, nil
+}
+
+// Update 更新角色
+func (s *RoleService) Update(callerID int32, roleID int32, req *RoleRequest, merID int32) error {
+	if _, err := s.Get(roleID, merID); err != nil {
+		return err
+	}
+
+	perms, err := NewPermissionService(s.ctx).GetAdminPermissions(callerID)
+	if err != nil {
+		return err
+	}
+	rules, err := validateRules(req.Rules, perms)
+	if err != nil {
+		return err
+	}
+
+	updates := map[string]interface{}{
+		"role_name": req.RoleName,
+		"rules":     rules,
+	}
+	if req.Status != nil {
+		updates["status"] = *req.Status
+	}
+
+	r := dao.MerSystemRole
+	if _, err := r.WithContext(s.ctx).
+		Where(r.RoleID.Eq(roleID), r.MerID.Eq(merID)).
+		Updates(updates); err != nil {
+		return fmt.Errorf("更新角色失败: %w", err)
+	}
+
+	// 角色权限变更后，清除商户下管理员的权限缓存
+	return NewPermissionService(s.ctx).InvalidateMerchant(merID)
+}
+
+// Delete 删除角色（仍被管理员使用的角色不能删除）
+func (s *RoleService) Delete(roleID int32, merID int32) error {
+	if _, err := s.Get(roleID, merID); err != nil {
+		return err
+	}
+
+	a := dao.MerMerchantAdmin
+	admins, err := a.WithContext(s.ctx).
+		Where(a.MerID.Eq(merID), a.IsDel.Eq(0)).
+		Select(a.MerchantAdminID, a.Roles).
+		Find()
+	if err != nil {
+		return fmt.Errorf("查询管理员失败: %w", err)
+	}
+	for _, admin := range admins {
+		for _, id := range parseRoleIDs(admin.Roles) {
+			if id == roleID {
+				return errors.New("角色仍被管理员使用，无法删除")
+			}
+		}
+	}
+
+	r := dao.MerSystemRole
+	if _, err := r.WithContext(s.ctx).
+		Where(r.RoleID.Eq(roleID), r.MerID.Eq(merID)).
+		Delete(); err != nil {
+		return fmt.Errorf("删除角色失败: %w", err)
+	}
+	return nil
+}
+
+// Get 获取角色
+func (s *RoleService) Get(roleID int32, merID int32) (*model.MerSystemRole, error) {
+	r := dao.MerSystemRole
+	role, err := r.WithContext(s.ctx).
+		Where(r.RoleID.Eq(roleID), r.MerID.Eq(merID)).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("角色不存在或无权访问")
+		}
+		return nil, fmt.Errorf("查询角色失败: %w", err)
+	}
+	return role, nil
+}
+
+// GetList 获取商户的角色列表
+func (s *RoleService) GetList(merID int32) ([]*model.MerSystemRole, error) {
+	r := dao.MerSystemRole
+	return r.WithContext(s.ctx).
+		Where(r.MerID.Eq(merID)).
+		Order(r.RoleID.Desc()).
+		Find()
+}
+
+// AssignRoles 为商户管理员分配角色（覆盖原有角色），不能修改自己的角色
+func (s *RoleService) AssignRoles(callerID, adminID int32, roleIDs []int32, merID int32) error {
+	if callerID == adminID {
+		return errors.New("不能修改自己的角色")
+	}
+	if _, err := NewAdminService(s.ctx).loadEditable(callerID, adminID, merID); err != nil {
+		return err
+	}
+
+	if err := s.checkAssignable(callerID, roleIDs, merID); err != nil {
+		return err
+	}
+
+	a := dao.MerMerchantAdmin
+	if _, err := a.WithContext(s.ctx).
+		Where(a.MerchantAdminID.Eq(adminID)).
+		Update(a.Roles, formatRoleIDs(uniqueInt32(roleIDs))); err != nil {
+		return fmt.Errorf("分配角色失败: %w", err)
+	}
+
+	return NewPermissionService(s.ctx).InvalidateAdmins(adminID)
+}
+
+// checkAssignable 校验角色均属于当前商户，且角色包含的权限不超出操作人自己的权限
+func (s *RoleService) checkAssignable(callerID int32, roleIDs []int32, merID int32) error {
+	roleIDs = uniqueInt32(roleIDs)
+	if len(roleIDs) == 0 {
+		return nil
+	}
+
+	r := dao.MerSystemRole
+	roles, err := r.WithContext(s.ctx).
+		Where(r.RoleID.In(roleIDs...), r.MerID.Eq(merID)).
+		Find()
+	if err != nil {
+		return fmt.Errorf("查询角色失败: %w", err)
+	}
+	if len(roles) != len(roleIDs) {
+		return errors.New("角色不存在或无权访问")
+	}
+
+	perms, err := NewPermissionService(s.ctx).GetAdminPermissions(callerID)
+	if err != nil {
+		return err
+	}
+	// 停用的角色重新启用后即生效，同样需要校验
+	for _, role := range roles {
+		for _, code := range splitRules(role.Rules) {
+			if !HasPermission(perms, code) {
+				return fmt.Errorf("不能授予自己没有的权限: %s", code)
+			}
+		}
+	}
+	return nil
+}
+
+// uniqueInt32 去重并保持原有顺序
+func uniqueInt32(ids []int32) []int32 {
+	seen := make(map[int32]bool)
+	result := make([]int32, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+	return result
+}
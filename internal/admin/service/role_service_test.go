@@ -0,0 +1,34 @@
+package service
+
+import "testing"
+
+func TestValidateRules(t *testing.T) {
+	manager := []string{PermProductRead, PermProductWrite, PermRoleManage}
+
+	tests := []struct {
+		name    string
+		rules   []string
+		perms   []string
+		want    string
+		wantErr bool
+	}{
+		{"权限子集", []string{PermProductRead, " product:write "}, manager, "product:read,product:write", false},
+		{"去重", []string{PermProductRead, PermProductRead}, manager, "product:read", false},
+		{"主账号可授予全部权限", []string{PermFinanceWithdraw, PermAdminWrite}, []string{PermissionAll}, "finance:withdraw,admin:write", false},
+		{"超出自己的权限", []string{PermProductRead, PermFinanceWithdraw}, manager, "", true},
+		{"不能授予通配权限", []string{PermissionAll}, []string{PermissionAll}, "", true},
+		{"无效权限码", []string{"product:unknown"}, []string{PermissionAll}, "", true},
+		{"没有任何权限", []string{PermProductRead}, []string{}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := validateRules(tt.rules, tt.perms)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("validateRules() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("validateRules() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"merchant_api/internal/dao"
+	"merchant_api/internal/pkg/utils"
+	"merchant_api/pkg/database"
+	"merchant_api/pkg/redis"
+	"time"
+
+	redisv8 "github.com/go-redis/redis/v8"
+)
+
+// Redis 键
+const (
+	adminStepUpKeyFmt         = "admin:session:%s:step_up"  // 会话二次验证通过标记
+	adminStepUpAttemptsKeyFmt = "admin:%d:step_up_attempts" // 二次验证失败次数
+)
+
+const (
+	stepUpTTL         = 5 * time.Minute  // 二次验证通过后的有效期
+	stepUpMaxAttempts = 5                // 失败次数上限，达到后在 stepUpLockTTL 内不能再验证
+	stepUpLockTTL     = 15 * time.Minute // 失败次数的统计周期
+)
+
+// ErrStepUpRequired 当前会话未通过二次验证或已过期
+var ErrStepUpRequired = errors.New("该操作需要重新验证身份")
+
+// StepUpRequest 二次验证请求，已启用两步验证的管理员还需要验证码
+type StepUpRequest struct {
+	Password string `json:"password" binding:"required"`
+	Code     string `json:"code"` // 两步验证码或恢复码
+}
+
+// StepUpResponse 二次验证结果
+type StepUpResponse struct {
+	ExpireAt time.Time `json:"expire_at"`
+}
+
+// StepUpService 敏感操作（修改收款账户等）前的二次验证，验证结果绑定当前会话
+type StepUpService struct {
+	ctx context.Context
+}
+
+func NewStepUpService(ctx context.Context) *StepUpService {
+	dao.SetDefault(database.GetDB())
+	return &StepUpService{ctx: ctx}
+}
+
+// Verify 验证密码（及两步验证码），通过后当前会话在 stepUpTTL 内可以执行敏感操作
+func (s *StepUpService) Verify(adminID int32, sessionID string, req *StepUpRequest) (*StepUpResponse, error) {
+	if sessionID == "" {
+		return nil, errors.New("会话不存在")
+	}
+
+	rdb := redis.GetRedis()
+	attemptsKey := fmt.Sprintf(adminStepUpAttemptsKeyFmt, adminID)
+	attempts, err := rdb.Get(s.ctx, attemptsKey).Int()
+	if err != nil && err != redisv8.Nil {
+		return nil, fmt.Errorf("读取验证记录失败: %w", err)
+	}
+	if attempts >= stepUpMaxAttempts {
+		return nil, errors.New("验证失败次数过多，请稍后再试")
+	}
+
+	if err := s.check(adminID, req); err != nil {
+		pipe := rdb.TxPipeline()
+		pipe.Incr(s.ctx, attemptsKey)
+		pipe.Expire(s.ctx, attemptsKey, stepUpLockTTL)
+		if _, perr := pipe.Exec(s.ctx); perr != nil {
+			return nil, fmt.Errorf("记录验证失败次数失败: %w", perr)
+		}
+		return nil, err
+	}
+
+	expireAt := time.Now().Add(stepUpTTL)
+	pipe := rdb.TxPipeline()
+	pipe.Set(s.ctx, fmt.Sprintf(adminStepUpKeyFmt, sessionID), adminID, stepUpTTL)
+	pipe.Del(s.ctx, attemptsKey)
+	if _, err := pipe.Exec(s.ctx); err != nil {
+		return nil, fmt.Errorf("保存验证结果失败: %w", err)
+	}
+
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		Action:     AuditActionStepUp,
+		EntityType: AuditEntityAdmin,
+		EntityID:   int64(adminID),
+	})
+	return &StepUpResponse{ExpireAt: expireAt}, nil
+}
+
+// Check 检查当前会话是否已通过二次验证
+func (s *StepUpService) Check(adminID int32, sessionID string) error {
+	if sessionID == "" {
+		return ErrStepUpRequired
+	}
+	value, err := redis.GetRedis().Get(s.ctx, fmt.Sprintf(adminStepUpKeyFmt, sessionID)).Int()
+	if err == redisv8.Nil {
+		return ErrStepUpRequired
+	}
+	if err != nil {
+		return fmt.Errorf("读取验证结果失败: %w", err)
+	}
+	if int32(value) != adminID {
+		return ErrStepUpRequired
+	}
+	return nil
+}
+
+// check 校验密码，已启用两步验证时同时校验验证码
+func (s *StepUpService) check(adminID int32, req *StepUpRequest) error {
+	twoFactor := NewTwoFactorService(s.ctx)
+	admin, err := twoFactor.loadAdmin(adminID)
+	if err != nil {
+		return err
+	}
+	if !utils.CheckPassword(req.Password, admin.Pwd) {
+		return errors.New("密码错误")
+	}
+
+	record, err := twoFactor.load(adminID)
+	if err != nil {
+		return err
+	}
+	if record == nil || record.Enabled != 1 {
+		return nil
+	}
+	if req.Code == "" {
+		return errors.New("请输入两步验证码")
+	}
+	return twoFactor.verifyCode(adminID, req.Code)
+}
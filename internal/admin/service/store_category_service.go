@@ -2,9 +2,12 @@ package service
 
 import (
 	"context"
+	"errors"
 	"merchant_api/internal/dao"
 	"merchant_api/internal/model"
 	"merchant_api/pkg/database"
+
+	"gorm.io/gorm"
 )
 
 type StoreCategoryService struct {
@@ -18,29 +21,79 @@ func NewStoreCategoryService(ctx context.Context) *StoreCategoryService {
 
 // Create 创建分类
 func (s *StoreCategoryService) Create(req *model.MerStoreCategory) error {
-	return dao.MerStoreCategory.WithContext(s.ctx).Create(req)
+	if err := dao.MerStoreCategory.WithContext(s.ctx).Create(req); err != nil {
+		return err
+	}
+
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		Action:     AuditActionCategoryCreate,
+		EntityType: AuditEntityCategory,
+		EntityID:   int64(req.StoreCategoryID),
+		After:      req,
+	})
+	return nil
 }
 
 // Update 更新分类
 func (s *StoreCategoryService) Update(id int32, merId int32, req *model.MerStoreCategory) error {
 	c := dao.MerStoreCategory
 
+	before, err := s.Get(id, merId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+
 	// 确保只能更新自己商户的分类
-	_, err := c.WithContext(s.ctx).
+	_, err = c.WithContext(s.ctx).
 		Where(c.StoreCategoryID.Eq(id), c.MerID.Eq(merId)).
 		Updates(req)
-	return err
+	if err != nil {
+		return err
+	}
+
+	after, err := s.Get(id, merId)
+	if err == nil {
+		NewAuditService(s.ctx).Log(&AuditEntry{
+			Action:     AuditActionCategoryUpdate,
+			EntityType: AuditEntityCategory,
+			EntityID:   int64(id),
+			Before:     before,
+			After:      after,
+		})
+	}
+	return nil
 }
 
 // Delete 删除分类
 func (s *StoreCategoryService) Delete(id int32, merId int32) error {
 	c := dao.MerStoreCategory
 
+	before, err := s.Get(id, merId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+
 	// 确保只能删除自己商户的分类
-	_, err := c.WithContext(s.ctx).
+	_, err = c.WithContext(s.ctx).
 		Where(c.StoreCategoryID.Eq(id), c.MerID.Eq(merId)).
 		Delete()
-	return err
+	if err != nil {
+		return err
+	}
+
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		Action:     AuditActionCategoryDelete,
+		EntityType: AuditEntityCategory,
+		EntityID:   int64(id),
+		Before:     before,
+	})
+	return nil
 }
 
 // GetList 获取分类列表
@@ -0,0 +1,316 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"merchant_api/internal/dao"
+	"merchant_api/internal/model"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 商品批量操作类型
+const (
+	BulkProductList           = "list"            // 上架
+	BulkProductDelist         = "delist"          // 下架
+	BulkProductSoldOut        = "sold_out"        // 标记售完
+	BulkProductOnSale         = "on_sale"         // 恢复销售中
+	BulkProductChangeCategory = "change_category" // 修改分类
+	BulkProductAdjustPrice    = "adjust_price"    // 按百分比调整商品及 SKU 售价
+	BulkProductDelete         = "delete"          // 删除（软删除）
+)
+
+const (
+	bulkProductMax   = 5000 // 单次批量操作的商品上限
+	bulkProductBatch = 100  // 每个事务处理的商品数，避免长事务锁住大量商品
+)
+
+// 调价百分比范围
+const (
+	bulkPriceMinPercent = -90
+	bulkPriceMaxPercent = 1000
+)
+
+// BulkProductRequest 批量操作请求，ids 和 filter 只能传一个
+type BulkProductRequest struct {
+	Action  string         `json:"action" binding:"required,oneof=list delist sold_out on_sale change_category adjust_price delete"`
+	IDs     []int32        `json:"ids"`
+	Filter  *ProductFilter `json:"filter"`  // 按条件选择商品，空对象表示全部商品
+	CateID  int32          `json:"cate_id"` // change_category 的目标分类
+	Percent float64        `json:"percent"` // adjust_price 的调整百分比，10 表示涨价 10%，-10 表示降价 10%
+}
+
+// BulkProductItem 单个商品的操作结果
+type BulkProductItem struct {
+	ProductID int32  `json:"product_id"`
+	Success   bool   `json:"success"`
+	Error     string `json:"error,omitempty"`
+}
+
+// BulkProductResult 批量操作结果
+type BulkProductResult struct {
+	Action    string             `json:"action"`
+	Total     int                `json:"total"`
+	Succeeded int                `json:"succeeded"`
+	Failed    int                `json:"failed"`
+	Items     []*BulkProductItem `json:"items"`
+}
+
+// BulkActionPermission 批量操作除 product:write 外还需要的权限，没有时返回空字符串
+func BulkActionPermission(action string) string {
+	switch action {
+	case BulkProductDelete:
+		return PermProductDelete
+	case BulkProductAdjustPrice:
+		return PermProductPrice
+	}
+	return ""
+}
+
+// Bulk 批量操作商品。商品按 bulkProductBatch 个一批在独立事务中处理，
+// 单个商品失败只回滚该商品，不影响同批其他商品
+func (s *StoreProductService) Bulk(merID int32, req *BulkProductRequest) (*BulkProductResult, error) {
+	switch req.Action {
+	case BulkProductChangeCategory:
+		c := dao.MerStoreCategory
+		if _, err := c.WithContext(s.ctx).Where(c.StoreCategoryID.Eq(req.CateID), c.MerID.Eq(merID)).First(); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, errors.New("分类不存在或无权访问")
+			}
+			return nil, fmt.Errorf("查询分类失败: %w", err)
+		}
+	case BulkProductAdjustPrice:
+		if req.Percent == 0 || req.Percent < bulkPriceMinPercent || req.Percent > bulkPriceMaxPercent {
+			return nil, fmt.Errorf("调价百分比必须在 %d 到 %d 之间且不为 0", bulkPriceMinPercent, bulkPriceMaxPercent)
+		}
+	}
+
+	ids, err := s.bulkTargets(merID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &BulkProductResult{
+		Action: req.Action,
+		Total:  len(ids),
+		Items:  make([]*BulkProductItem, 0, len(ids)),
+	}
+	for start := 0; start < len(ids); start += bulkProductBatch {
+		end := start + bulkProductBatch
+		if end > len(ids) {
+			end = len(ids)
+		}
+		batch := ids[start:end]
+
+		items, audits, err := s.bulkBatch(merID, req, batch)
+		if err != nil {
+			// 事务提交失败时本批全部回滚
+			items = make([]*BulkProductItem, 0, len(batch))
+			for _, id := range batch {
+				items = append(items, &BulkProductItem{ProductID: id, Error: err.Error()})
+			}
+		} else {
+			for _, entry := range audits {
+				NewAuditService(s.ctx).Log(entry)
+			}
+		}
+		result.Items = append(result.Items, items...)
+	}
+
+	for _, item := range result.Items {
+		if item.Success {
+			result.Succeeded++
+		} else {
+			result.Failed++
+		}
+	}
+	return result, nil
+}
+
+// bulkTargets 确定批量操作的商品ID，按传入顺序去重；按条件选择时按商品ID排序
+func (s *StoreProductService) bulkTargets(merID int32, req *BulkProductRequest) ([]int32, error) {
+	if len(req.IDs) > 0 && req.Filter != nil {
+		return nil, errors.New("ids 和 filter 只能传一个")
+	}
+
+	if req.Filter == nil {
+		if len(req.IDs) == 0 {
+			return nil, errors.New("请传入 ids 或 filter")
+		}
+		seen := make(map[int32]bool, len(req.IDs))
+		ids := make([]int32, 0, len(req.IDs))
+		for _, id := range req.IDs {
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+		if len(ids) > bulkProductMax {
+			return nil, fmt.Errorf("单次最多操作 %d 个商品", bulkProductMax)
+		}
+		return ids, nil
+	}
+
+	p := dao.MerStoreProduct
+	var ids []int32
+	if err := s.filterQuery(merID, req.Filter).Order(p.ProductID).Limit(bulkProductMax+1).Pluck(p.ProductID, &ids); err != nil {
+		return nil, fmt.Errorf("查询商品失败: %w", err)
+	}
+	if len(ids) > bulkProductMax {
+		return nil, fmt.Errorf("匹配的商品超过 %d 个，请缩小筛选范围", bulkProductMax)
+	}
+	return ids, nil
+}
+
+// bulkBatch 在一个事务中处理一批商品，每个商品使用保存点，失败时只回滚该商品
+func (s *StoreProductService) bulkBatch(merID int32, req *BulkProductRequest, ids []int32) ([]*BulkProductItem, []*AuditEntry, error) {
+	var items []*BulkProductItem
+	var audits []*AuditEntry
+	err := dao.Q.Transaction(func(tx *dao.Query) error {
+		items = make([]*BulkProductItem, 0, len(ids))
+		audits = make([]*AuditEntry, 0, len(ids))
+
+		p := tx.MerStoreProduct
+		products, err := p.WithContext(s.ctx).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where(p.ProductID.In(ids...), p.MerID.Eq(merID), p.DeleteAt.IsNull()).
+			Find()
+		if err != nil {
+			return fmt.Errorf("查询商品失败: %w", err)
+		}
+		productMap := make(map[int32]*model.MerStoreProduct, len(products))
+		for _, product := range products {
+			productMap[product.ProductID] = product
+		}
+
+		for _, id := range ids {
+			product := productMap[id]
+			if product == nil {
+				items = append(items, &BulkProductItem{ProductID: id, Error: "商品不存在或无权访问"})
+				continue
+			}
+
+			var entry *AuditEntry
+			err := tx.Transaction(func(itx *dao.Query) error {
+				var err error
+				entry, err = s.bulkApply(itx, product, req)
+				return err
+			})
+			if err != nil {
+				items = append(items, &BulkProductItem{ProductID: id, Error: err.Error()})
+				continue
+			}
+			items = append(items, &BulkProductItem{ProductID: id, Success: true})
+			audits = append(audits, entry)
+		}
+		return nil
+	})
+	return items, audits, err
+}
+
+// bulkApply 对单个商品执行批量操作，返回操作日志
+func (s *StoreProductService) bulkApply(tx *dao.Query, product *model.MerStoreProduct, req *BulkProductRequest) (*AuditEntry, error) {
+	entry := &AuditEntry{
+		MerID:      product.MerID,
+		EntityType: AuditEntityProduct,
+		EntityID:   int64(product.ProductID),
+	}
+
+	var updates map[string]interface{}
+	switch req.Action {
+	case BulkProductList, BulkProductDelist:
+		isShow := int32(0)
+		if req.Action == BulkProductList {
+			isShow = 1
+		}
+		updates = map[string]interface{}{"is_show": isShow}
+		entry.Action = AuditActionProductListing
+		entry.Before = map[string]interface{}{"is_show": product.IsShow}
+		entry.After = updates
+	case BulkProductSoldOut, BulkProductOnSale:
+		saleStatus := req.Action == BulkProductOnSale
+		updates = map[string]interface{}{"sale_status": saleStatus}
+		entry.Action = AuditActionProductSoldOut
+		entry.Before = map[string]interface{}{"sale_status": product.SaleStatus}
+		entry.After = updates
+	case BulkProductChangeCategory:
+		updates = map[string]interface{}{"cate_id": req.CateID, "update_at": time.Now()}
+		entry.Action = AuditActionProductUpdate
+		entry.Before = map[string]interface{}{"cate_id": product.CateID}
+		entry.After = map[string]interface{}{"cate_id": req.CateID}
+	case BulkProductDelete:
+		updates = map[string]interface{}{"delete_at": int32(time.Now().Unix())}
+		entry.Action = AuditActionProductDelete
+		entry.Before = product
+	case BulkProductAdjustPrice:
+		return s.bulkAdjustPrice(tx, product, req.Percent, entry)
+	default:
+		return nil, fmt.Errorf("不支持的操作: %s", req.Action)
+	}
+
+	p := tx.MerStoreProduct
+	if _, err := p.WithContext(s.ctx).Where(p.ProductID.Eq(product.ProductID)).Updates(updates); err != nil {
+		return nil, fmt.Errorf("更新商品失败: %w", err)
+	}
+	return entry, nil
+}
+
+// bulkAdjustPrice 按百分比调整商品及其所有 SKU 的售价，结果四舍五入到分
+func (s *StoreProductService) bulkAdjustPrice(tx *dao.Query, product *model.MerStoreProduct, percent float64, entry *AuditEntry) (*AuditEntry, error) {
+	factor := 1 + percent/100
+	before := map[string]interface{}{}
+	after := map[string]interface{}{}
+
+	if product.Price != nil {
+		price, err := adjustPrice(*product.Price, factor)
+		if err != nil {
+			return nil, err
+		}
+		p := tx.MerStoreProduct
+		if _, err := p.WithContext(s.ctx).Where(p.ProductID.Eq(product.ProductID)).
+			Updates(map[string]interface{}{"price": price, "update_at": time.Now()}); err != nil {
+			return nil, fmt.Errorf("更新商品价格失败: %w", err)
+		}
+		before["price"], after["price"] = *product.Price, price
+	}
+
+	sk := tx.MerStoreProductSku
+	skus, err := sk.WithContext(s.ctx).Where(sk.ProductID.Eq(product.ProductID)).Find()
+	if err != nil {
+		return nil, fmt.Errorf("查询SKU失败: %w", err)
+	}
+	skuBefore := make(map[int32]float64, len(skus))
+	skuAfter := make(map[int32]float64, len(skus))
+	for _, sku := range skus {
+		if sku.Price == nil {
+			continue
+		}
+		price, err := adjustPrice(*sku.Price, factor)
+		if err != nil {
+			return nil, fmt.Errorf("SKU %d %w", sku.ProductSkuID, err)
+		}
+		if _, err := sk.WithContext(s.ctx).Where(sk.ProductSkuID.Eq(sku.ProductSkuID)).Update(sk.Price, price); err != nil {
+			return nil, fmt.Errorf("更新SKU价格失败: %w", err)
+		}
+		skuBefore[sku.ProductSkuID], skuAfter[sku.ProductSkuID] = *sku.Price, price
+	}
+	if len(skuBefore) > 0 {
+		before["sku_prices"], after["sku_prices"] = skuBefore, skuAfter
+	}
+
+	entry.Action = AuditActionProductUpdate
+	entry.Before, entry.After = before, after
+	return entry, nil
+}
+
+// adjustPrice 按比例调整价格并四舍五入到分，原价大于 0 时调整后不能低于 0.01
+func adjustPrice(price, factor float64) (float64, error) {
+	adjusted := math.Round(price*factor*100) / 100
+	if price > 0 && adjusted < 0.01 {
+		return 0, errors.New("调价后价格低于 0.01")
+	}
+	return adjusted, nil
+}
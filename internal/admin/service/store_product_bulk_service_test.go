@@ -0,0 +1,34 @@
+package service
+
+import "testing"
+
+func TestAdjustPrice(t *testing.T) {
+	tests := []struct {
+		name    string
+		price   float64
+		percent float64
+		want    float64
+		wantErr bool
+	}{
+		{"上调 10%", 100, 10, 110, false},
+		{"下调 15%", 59.9, -15, 50.92, false},
+		{"四舍五入到分", 19.99, 12.5, 22.49, false},
+		{"不调整", 0.01, 0, 0.01, false},
+		{"原价为 0", 0, 50, 0, false},
+		{"下调 100%", 100, -100, 0, true},
+		{"低于 0.01", 0.01, -60, 0, true},
+		{"半分向上取整", 0.01, -50, 0.01, false},
+		{"刚好 0.01", 0.02, -50, 0.01, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := adjustPrice(tt.price, 1+tt.percent/100)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("adjustPrice() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("adjustPrice(%v, %v%%) = %v, want %v", tt.price, tt.percent, got, tt.want)
+			}
+		})
+	}
+}
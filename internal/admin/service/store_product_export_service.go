@@ -0,0 +1,328 @@
+package service
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"merchant_api/internal/dao"
+	"merchant_api/internal/pkg/xlsx"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 商品导出格式
+const (
+	ProductExportCSV  = "csv"
+	ProductExportXLSX = "xlsx"
+	ProductExportJSON = "json"
+)
+
+// productExportBatch 每次查询的商品数，导出时逐批查询并写出，不把全部商品读入内存
+const productExportBatch = 200
+
+// productNumberColumns 导出到 XLSX 时按数字写入的列，条码等其余列按文本写入以免丢失前导零
+var productNumberColumns = map[string]bool{
+	ProductColSort: true, ProductColPrice: true, ProductColCost: true, ProductColOtPrice: true,
+	ProductColIsGood: true, ProductColProductType: true, ProductColRefundSwitch: true,
+	ProductColSkuPrice: true, ProductColSkuCost: true, ProductColSkuOtPrice: true,
+	ProductColSkuStock: true, ProductColSkuLowStock: true,
+}
+
+// ProductExportRequest 导出请求，筛选条件同商品列表
+type ProductExportRequest struct {
+	ProductFilter
+	Format string `form:"format,default=csv" binding:"oneof=csv xlsx json"`
+}
+
+// ProductExportContentType 导出格式对应的 Content-Type
+func ProductExportContentType(format string) string {
+	switch format {
+	case ProductExportXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case ProductExportJSON:
+		return "application/json; charset=utf-8"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// ProductExportFileName 导出文件名，例如 products_20260301150405.csv
+func ProductExportFileName(format string) string {
+	return fmt.Sprintf("products_%s.%s", time.Now().Format("20060102150405"), format)
+}
+
+// productColumnIndex 导入导出列名到列序号的映射
+var productColumnIndex = func() map[string]int {
+	index := make(map[string]int, len(ProductImportColumns))
+	for i, name := range ProductImportColumns {
+		index[name] = i
+	}
+	return index
+}()
+
+// productExporter 按格式写出商品
+type productExporter interface {
+	write(product *ProductDetailResponse) error
+	flush() error
+	close() error
+}
+
+// Export 按筛选条件导出商品及其 SKU、分类名称、规格和详情，按商品ID分批查询并直接写入 w。
+// CSV、XLSX 的列与导入一致，可以直接导入；开始写出后出错时输出不完整，调用方需要中断响应
+func (s *StoreProductService) Export(merID int32, req *ProductExportRequest, w io.Writer) error {
+	var exporter productExporter
+	var err error
+	switch req.Format {
+	case ProductExportXLSX:
+		exporter, err = newXLSXProductExporter(w)
+	case ProductExportJSON:
+		exporter, err = newJSONProductExporter(w)
+	default:
+		exporter, err = newCSVProductExporter(w)
+	}
+	if err != nil {
+		return fmt.Errorf("写入导出文件失败: %w", err)
+	}
+
+	p := dao.MerStoreProduct
+	var lastID int32
+	for {
+		products, err := s.filterQuery(merID, &req.ProductFilter).
+			Where(p.ProductID.Gt(lastID)).
+			Order(p.ProductID).
+			Limit(productExportBatch).
+			Find()
+		if err != nil {
+			return fmt.Errorf("查询商品失败: %w", err)
+		}
+		details, err := s.loadProductRelations(products, allProductIncludes)
+		if err != nil {
+			return err
+		}
+		for _, detail := range details {
+			if err := exporter.write(detail); err != nil {
+				return fmt.Errorf("写入导出文件失败: %w", err)
+			}
+		}
+		if err := exporter.flush(); err != nil {
+			return fmt.Errorf("写入导出文件失败: %w", err)
+		}
+
+		if len(products) < productExportBatch {
+			break
+		}
+		lastID = products[len(products)-1].ProductID
+	}
+
+	if err := exporter.close(); err != nil {
+		return fmt.Errorf("写入导出文件失败: %w", err)
+	}
+	return nil
+}
+
+// productExportRows 把商品转换为导入格式的行，每个 SKU 一行，商品字段只写在第一行；
+// 没有 SKU 的商品输出一行商品字段
+func productExportRows(product *ProductDetailResponse) [][]string {
+	col := productColumnIndex
+	newRow := func() []string {
+		row := make([]string, len(ProductImportColumns))
+		if product.BarCodeNumber != nil {
+			row[col[ProductColBarCode]] = *product.BarCodeNumber
+		}
+		return row
+	}
+
+	first := newRow()
+	set := func(name, value string) { first[col[name]] = value }
+	set(ProductColStoreName, product.StoreName)
+	if product.Category != nil {
+		set(ProductColCategory, product.Category.CateName)
+	}
+	set(ProductColUnitName, product.UnitName)
+	set(ProductColImage, product.Image)
+	set(ProductColSliderImage, product.SliderImage)
+	set(ProductColKeyword, product.Keyword)
+	set(ProductColStoreInfo, product.StoreInfo)
+	set(ProductColSort, strconv.Itoa(int(product.Sort)))
+	set(ProductColPrice, formatPrice(product.Price))
+	set(ProductColCost, formatPrice(product.Cost))
+	set(ProductColOtPrice, formatPrice(product.OtPrice))
+	if product.IsGood {
+		set(ProductColIsGood, "1")
+	} else {
+		set(ProductColIsGood, "0")
+	}
+	set(ProductColProductType, strconv.Itoa(int(product.ProductType)))
+	if product.RefundSwitch != nil {
+		set(ProductColRefundSwitch, strconv.Itoa(int(*product.RefundSwitch)))
+	}
+	if product.Content != nil {
+		set(ProductColContent, product.Content.Content)
+	}
+
+	// 规格值ID到所在规格组及名称，用来从 spec_key 还原 SKU 的规格值，spec_key 中的ID不按规格组顺序排列
+	type specValue struct {
+		group int
+		name  string
+	}
+	specNames := make([]string, 0, len(product.Specs))
+	valueNames := make(map[string]specValue)
+	for i, spec := range product.Specs {
+		specNames = append(specNames, spec.SpecName)
+		for _, value := range spec.Values {
+			valueNames[strconv.Itoa(int(value.ValueID))] = specValue{group: i, name: value.ValueName}
+		}
+	}
+	set(ProductColSpecNames, strings.Join(specNames, productSpecSeparator))
+
+	if len(product.Skus) == 0 {
+		return [][]string{first}
+	}
+	rows := make([][]string, 0, len(product.Skus))
+	for i, sku := range product.Skus {
+		row := first
+		if i > 0 {
+			row = newRow()
+		}
+		if sku.AttrName != nil {
+			row[col[ProductColSkuAttrName]] = *sku.AttrName
+		}
+		if len(specNames) > 0 && sku.SpecKey != nil && *sku.SpecKey != "" {
+			values := make([]string, len(specNames))
+			for _, id := range strings.Split(*sku.SpecKey, ",") {
+				if value, ok := valueNames[id]; ok {
+					values[value.group] = value.name
+				}
+			}
+			row[col[ProductColSkuSpecs]] = strings.Join(values, productSpecSeparator)
+		}
+		row[col[ProductColSkuPrice]] = formatPrice(sku.Price)
+		row[col[ProductColSkuCost]] = formatPrice(sku.Cost)
+		row[col[ProductColSkuOtPrice]] = formatPrice(sku.OtPrice)
+		if sku.Image != nil {
+			row[col[ProductColSkuImage]] = *sku.Image
+		}
+		if sku.Stock != nil {
+			row[col[ProductColSkuStock]] = strconv.Itoa(int(*sku.Stock))
+		}
+		row[col[ProductColSkuLowStock]] = strconv.Itoa(int(sku.LowStock))
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// formatPrice 价格按最短形式输出，nil 输出空字符串
+func formatPrice(price *float64) string {
+	if price == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*price, 'f', -1, 64)
+}
+
+// csvProductExporter 导出 UTF-8 CSV，带 BOM 以便 Excel 正确识别编码
+type csvProductExporter struct {
+	w *csv.Writer
+}
+
+func newCSVProductExporter(w io.Writer) (*csvProductExporter, error) {
+	if _, err := io.WriteString(w, "\xef\xbb\xbf"); err != nil {
+		return nil, err
+	}
+	cw := csv.NewWriter(w)
+	if err := cw.Write(ProductImportColumns); err != nil {
+		return nil, err
+	}
+	return &csvProductExporter{w: cw}, nil
+}
+
+func (e *csvProductExporter) write(product *ProductDetailResponse) error {
+	return e.w.WriteAll(productExportRows(product))
+}
+
+func (e *csvProductExporter) flush() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+func (e *csvProductExporter) close() error {
+	return e.flush()
+}
+
+// xlsxProductExporter 导出 XLSX，第一行为表头
+type xlsxProductExporter struct {
+	w *xlsx.Writer
+}
+
+func newXLSXProductExporter(w io.Writer) (*xlsxProductExporter, error) {
+	xw, err := xlsx.NewWriter(w, "products")
+	if err != nil {
+		return nil, err
+	}
+	header := make([]xlsx.Cell, 0, len(ProductImportColumns))
+	for _, name := range ProductImportColumns {
+		header = append(header, xlsx.Cell{Value: name})
+	}
+	if err := xw.WriteRow(header); err != nil {
+		return nil, err
+	}
+	return &xlsxProductExporter{w: xw}, nil
+}
+
+func (e *xlsxProductExporter) write(product *ProductDetailResponse) error {
+	for _, row := range productExportRows(product) {
+		cells := make([]xlsx.Cell, len(row))
+		for i, value := range row {
+			cells[i] = xlsx.Cell{Value: value, Number: productNumberColumns[ProductImportColumns[i]]}
+		}
+		if err := e.w.WriteRow(cells); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *xlsxProductExporter) flush() error {
+	return e.w.Flush()
+}
+
+func (e *xlsxProductExporter) close() error {
+	return e.w.Close()
+}
+
+// jsonProductExporter 导出商品详情组成的 JSON 数组，字段同商品详情接口
+type jsonProductExporter struct {
+	w     *bufio.Writer
+	enc   *json.Encoder
+	count int
+}
+
+func newJSONProductExporter(w io.Writer) (*jsonProductExporter, error) {
+	bw := bufio.NewWriter(w)
+	if _, err := bw.WriteString("["); err != nil {
+		return nil, err
+	}
+	return &jsonProductExporter{w: bw, enc: json.NewEncoder(bw)}, nil
+}
+
+func (e *jsonProductExporter) write(product *ProductDetailResponse) error {
+	if e.count > 0 {
+		if _, err := e.w.WriteString(","); err != nil {
+			return err
+		}
+	}
+	e.count++
+	return e.enc.Encode(product)
+}
+
+func (e *jsonProductExporter) flush() error {
+	return e.w.Flush()
+}
+
+func (e *jsonProductExporter) close() error {
+	if _, err := e.w.WriteString("]\n"); err != nil {
+		return err
+	}
+	return e.w.Flush()
+}
@@ -0,0 +1,805 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"merchant_api/internal/dao"
+	"merchant_api/internal/model"
+	"merchant_api/internal/pkg/utils"
+	"merchant_api/internal/pkg/xlsx"
+	"merchant_api/pkg/redis"
+	"mime/multipart"
+	"path/filepath"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	redisv8 "github.com/go-redis/redis/v8"
+)
+
+// 商品导入导出的列名，表头使用这些名称，列的顺序不限。
+// 商品字段以同一条码的第一行为准，之后的行只需要填写 SKU 字段
+const (
+	ProductColBarCode      = "bar_code_number" // 商品条码，用来把多行归为同一商品，已存在时更新该商品
+	ProductColStoreName    = "store_name"
+	ProductColCategory     = "category" // 分类名称
+	ProductColUnitName     = "unit_name"
+	ProductColImage        = "image"
+	ProductColSliderImage  = "slider_image"
+	ProductColKeyword      = "keyword"
+	ProductColStoreInfo    = "store_info"
+	ProductColSort         = "sort"
+	ProductColPrice        = "price"
+	ProductColCost         = "cost"
+	ProductColOtPrice      = "ot_price"
+	ProductColIsGood       = "is_good"
+	ProductColProductType  = "product_type"
+	ProductColRefundSwitch = "refund_switch"
+	ProductColContent      = "content"
+	ProductColSpecNames    = "spec_names" // 规格组名称，多个用 | 分隔，例如 颜色|尺码
+	ProductColSkuAttrName  = "sku_attr_name"
+	ProductColSkuSpecs     = "sku_specs" // SKU 的规格值，按规格组顺序用 | 分隔，例如 红|S
+	ProductColSkuPrice     = "sku_price"
+	ProductColSkuCost      = "sku_cost"
+	ProductColSkuOtPrice   = "sku_ot_price"
+	ProductColSkuImage     = "sku_image"
+	ProductColSkuStock     = "sku_stock"
+	ProductColSkuLowStock  = "sku_low_stock"
+)
+
+// ProductImportColumns 导入支持的列，导出按此顺序输出表头
+var ProductImportColumns = []string{
+	ProductColBarCode, ProductColStoreName, ProductColCategory, ProductColUnitName, ProductColImage,
+	ProductColSliderImage, ProductColKeyword, ProductColStoreInfo, ProductColSort, ProductColPrice,
+	ProductColCost, ProductColOtPrice, ProductColIsGood, ProductColProductType, ProductColRefundSwitch,
+	ProductColContent, ProductColSpecNames, ProductColSkuAttrName, ProductColSkuSpecs, ProductColSkuPrice,
+	ProductColSkuCost, ProductColSkuOtPrice, ProductColSkuImage, ProductColSkuStock, ProductColSkuLowStock,
+}
+
+// productImportRequired 必须存在的列
+var productImportRequired = []string{
+	ProductColBarCode, ProductColStoreName, ProductColCategory, ProductColUnitName, ProductColImage, ProductColSkuPrice,
+}
+
+// productSpecSeparator 规格组及规格值在单元格中的分隔符
+const productSpecSeparator = "|"
+
+// maxProductSpecNameLen 规格组及规格值名称的长度上限，与 ProductSpecReq 的校验一致
+const maxProductSpecNameLen = 32
+
+const (
+	productImportMaxSize = 10 * 1024 * 1024 // 导入文件大小上限
+	productImportMaxRows = 10000            // 数据行数上限（不含表头）
+	productImportMaxCols = 100              // 列数上限
+	productImportSyncMax = 100              // 商品数超过此值时转为后台任务
+	productImportJobTTL  = 24 * time.Hour   // 导入任务进度保留时间
+	productImportLockTTL = time.Hour        // 同一商户同时只能有一个导入在执行
+
+	productImportProgressInterval = 2 * time.Second // 后台任务保存进度的最小间隔
+)
+
+// Redis 键
+const (
+	productImportJobKeyFmt  = "product:import:job:%s"  // 导入任务 -> ProductImportJob
+	productImportLockKeyFmt = "product:import:lock:%d" // 商户正在执行的导入
+)
+
+// 商品导入操作
+const (
+	ProductImportCreate = "create"
+	ProductImportUpdate = "update"
+)
+
+// 导入任务状态
+const (
+	ProductImportJobRunning = "running"
+	ProductImportJobDone    = "done"
+	ProductImportJobFailed  = "failed" // 后台任务异常中止，已处理的商品保留
+)
+
+var (
+	// ErrProductImportRunning 商户已有导入正在执行
+	ErrProductImportRunning = errors.New("已有商品导入正在进行，请稍后再试")
+	// ErrProductImportJobNotFound 导入任务不存在或已过期
+	ErrProductImportJobNotFound = errors.New("导入任务不存在或已过期")
+)
+
+// ProductImportRowError 行级校验错误，row 为表格中的行号（表头为第 1 行）
+type ProductImportRowError struct {
+	Row    int    `json:"row"`
+	Column string `json:"column,omitempty"`
+	Error  string `json:"error"`
+}
+
+// ProductImportItem 单个商品的导入结果
+type ProductImportItem struct {
+	Row           int    `json:"row"` // 商品第一行的行号
+	BarCodeNumber string `json:"bar_code_number"`
+	StoreName     string `json:"store_name"`
+	Action        string `json:"action"` // create 或 update
+	ProductID     int32  `json:"product_id,omitempty"`
+	Success       bool   `json:"success"`
+	Error         string `json:"error,omitempty"`
+}
+
+// ProductImportResult 导入结果；试运行时 success 表示校验通过
+type ProductImportResult struct {
+	DryRun  bool                     `json:"dry_run"`
+	Rows    int                      `json:"rows"`  // 数据行数
+	Total   int                      `json:"total"` // 商品数
+	Created int                      `json:"created"`
+	Updated int                      `json:"updated"`
+	Failed  int                      `json:"failed"`
+	Errors  []*ProductImportRowError `json:"errors"`
+	Items   []*ProductImportItem     `json:"items"`
+}
+
+// ProductImportJob 后台导入任务
+type ProductImportJob struct {
+	JobID     string               `json:"job_id"`
+	MerID     int32                `json:"mer_id"`
+	FileName  string               `json:"file_name"`
+	Status    string               `json:"status"`
+	Total     int                  `json:"total"`     // 商品数
+	Processed int                  `json:"processed"` // 已处理的商品数
+	CreateAt  time.Time            `json:"create_at"`
+	FinishAt  *time.Time           `json:"finish_at"`
+	Error     string               `json:"error,omitempty"` // 任务失败的原因
+	Result    *ProductImportResult `json:"result"`          // 执行中只包含已处理商品的结果
+}
+
+// importProduct 从表格中解析出的一个商品
+type importProduct struct {
+	row       int
+	barCode   string
+	req       *CreateProductRequest
+	productID int32 // 已存在的商品，0 表示新建
+	err       error // 商品级校验错误，记录在第一行
+	rowErrors []*ProductImportRowError
+}
+
+// Import 导入商品。dryRun 时只校验不写入；商品数超过 productImportSyncMax 时转为后台任务，
+// 返回任务信息，否则直接返回导入结果。canChangePrice 为 false 时不能修改已有商品的价格
+func (s *StoreProductService) Import(merID int32, file *multipart.FileHeader, dryRun, canChangePrice bool) (*ProductImportResult, *ProductImportJob, error) {
+	rows, err := readImportFile(file)
+	if err != nil {
+		return nil, nil, err
+	}
+	if dryRun {
+		return s.dryRunImport(merID, rows, canChangePrice)
+	}
+
+	// 同一商户的导入串行执行，避免并发导入按条码重复创建商品。
+	// 按条码匹配已有商品必须在持有锁之后进行，否则其他导入可能在匹配后提交
+	rdb := redis.GetRedis()
+	lockKey := fmt.Sprintf(productImportLockKeyFmt, merID)
+	ok, err := rdb.SetNX(s.ctx, lockKey, time.Now().Unix(), productImportLockTTL).Result()
+	if err != nil {
+		return nil, nil, fmt.Errorf("获取导入锁失败: %w", err)
+	}
+	if !ok {
+		return nil, nil, ErrProductImportRunning
+	}
+
+	products, result, err := s.parseImport(merID, rows, canChangePrice)
+	if err != nil {
+		rdb.Del(s.ctx, lockKey)
+		return nil, nil, err
+	}
+
+	if len(products) <= productImportSyncMax {
+		defer rdb.Del(context.WithoutCancel(s.ctx), lockKey)
+		s.runImport(products, result, merID, nil)
+		return result, nil, nil
+	}
+
+	jobID, err := utils.RandomToken(16)
+	if err != nil {
+		rdb.Del(s.ctx, lockKey)
+		return nil, nil, fmt.Errorf("生成任务ID失败: %w", err)
+	}
+	job := &ProductImportJob{
+		JobID:    jobID,
+		MerID:    merID,
+		FileName: file.Filename,
+		Status:   ProductImportJobRunning,
+		Total:    len(products),
+		CreateAt: time.Now(),
+		Result:   result,
+	}
+	if err := s.saveImportJob(job); err != nil {
+		rdb.Del(s.ctx, lockKey)
+		return nil, nil, err
+	}
+
+	// 请求结束后继续执行，保留请求中的操作人信息用于操作日志
+	bg := &StoreProductService{ctx: context.WithoutCancel(s.ctx)}
+	go func() {
+		defer rdb.Del(bg.ctx, lockKey)
+		// 后台协程中的 panic 不会被请求的 Recovery 中间件捕获，会导致整个服务退出
+		defer func() {
+			if r := recover(); r != nil {
+				fmt.Printf("商品导入任务 %s 异常中止: %v\n%s\n", job.JobID, r, debug.Stack())
+				now := time.Now()
+				job.Status = ProductImportJobFailed
+				job.Error = "导入任务异常中止"
+				job.FinishAt = &now
+				result.tally()
+				_ = bg.saveImportJob(job)
+			}
+		}()
+		bg.runImport(products, result, merID, job)
+	}()
+
+	// 任务由后台协程继续修改，返回副本
+	started := *job
+	started.Result = nil
+	return nil, &started, nil
+}
+
+// dryRunImport 只校验不写入，不需要持有导入锁
+func (s *StoreProductService) dryRunImport(merID int32, rows [][]string, canChangePrice bool) (*ProductImportResult, *ProductImportJob, error) {
+	products, result, err := s.parseImport(merID, rows, canChangePrice)
+	if err != nil {
+		return nil, nil, err
+	}
+	result.DryRun = true
+	for _, product := range products {
+		item := newImportItem(product)
+		if product.err == nil && len(product.rowErrors) == 0 {
+			item.Success = true
+		} else {
+			item.Error = importProductError(product)
+		}
+		result.Items = append(result.Items, item)
+	}
+	result.tally()
+	return result, nil, nil
+}
+
+// GetImportJob 查询导入任务进度
+func (s *StoreProductService) GetImportJob(merID int32, jobID string) (*ProductImportJob, error) {
+	data, err := redis.GetRedis().Get(s.ctx, fmt.Sprintf(productImportJobKeyFmt, jobID)).Bytes()
+	if err != nil {
+		if errors.Is(err, redisv8.Nil) {
+			return nil, ErrProductImportJobNotFound
+		}
+		return nil, fmt.Errorf("查询导入任务失败: %w", err)
+	}
+	var job ProductImportJob
+	if err := json.Unmarshal(data, &job); err != nil {
+		return nil, fmt.Errorf("解析导入任务失败: %w", err)
+	}
+	if job.MerID != merID {
+		return nil, ErrProductImportJobNotFound
+	}
+	return &job, nil
+}
+
+func (s *StoreProductService) saveImportJob(job *ProductImportJob) error {
+	data, err := json.Marshal(job)
+	if err != nil {
+		return fmt.Errorf("序列化导入任务失败: %w", err)
+	}
+	if err := redis.GetRedis().Set(s.ctx, fmt.Sprintf(productImportJobKeyFmt, job.JobID), data, productImportJobTTL).Err(); err != nil {
+		return fmt.Errorf("保存导入任务失败: %w", err)
+	}
+	return nil
+}
+
+// runImport 逐个创建或更新商品，校验未通过的商品跳过；job 不为 nil 时按 productImportProgressInterval 保存进度。
+// 每次保存都要序列化全部结果，逐个商品保存时大量商品的导入会产生平方级的序列化和 Redis 流量
+func (s *StoreProductService) runImport(products []*importProduct, result *ProductImportResult, merID int32, job *ProductImportJob) {
+	lastSave := time.Now()
+	for i, product := range products {
+		item := newImportItem(product)
+		if product.err != nil || len(product.rowErrors) > 0 {
+			item.Error = importProductError(product)
+		} else if product.productID == 0 {
+			detail, err := s.Create(product.req, merID)
+			if err != nil {
+				item.Error = err.Error()
+			} else {
+				item.ProductID = detail.ProductID
+				item.Success = true
+			}
+		} else {
+			if err := s.Update(product.productID, product.req, merID); err != nil {
+				item.Error = err.Error()
+			} else {
+				item.Success = true
+			}
+		}
+		if !item.Success && product.err == nil && len(product.rowErrors) == 0 {
+			result.Errors = append(result.Errors, &ProductImportRowError{Row: product.row, Error: item.Error})
+		}
+		result.Items = append(result.Items, item)
+
+		if job != nil {
+			job.Processed = i + 1
+			if time.Since(lastSave) >= productImportProgressInterval {
+				result.tally()
+				// 进度写入失败不影响导入，最终状态会再次写入
+				_ = s.saveImportJob(job)
+				lastSave = time.Now()
+			}
+		}
+	}
+	result.tally()
+
+	if job != nil {
+		now := time.Now()
+		job.Status = ProductImportJobDone
+		job.FinishAt = &now
+		_ = s.saveImportJob(job)
+	}
+}
+
+func newImportItem(product *importProduct) *ProductImportItem {
+	item := &ProductImportItem{
+		Row:           product.row,
+		BarCodeNumber: product.barCode,
+		Action:        ProductImportCreate,
+		ProductID:     product.productID,
+	}
+	if product.req != nil {
+		item.StoreName = product.req.StoreName
+	}
+	if product.productID != 0 {
+		item.Action = ProductImportUpdate
+	}
+	return item
+}
+
+// importProductError 商品校验失败的说明，行级错误已在 errors 中列出
+func importProductError(product *importProduct) string {
+	if product.err != nil {
+		return product.err.Error()
+	}
+	return fmt.Sprintf("有 %d 处数据错误", len(product.rowErrors))
+}
+
+// tally 统计创建、更新及失败的商品数，试运行时按校验通过的商品计划执行的操作统计
+func (r *ProductImportResult) tally() {
+	r.Created, r.Updated, r.Failed = 0, 0, 0
+	for _, item := range r.Items {
+		switch {
+		case !item.Success:
+			r.Failed++
+		case item.Action == ProductImportCreate:
+			r.Created++
+		default:
+			r.Updated++
+		}
+	}
+}
+
+// readImportFile 按扩展名读取 CSV 或 XLSX 文件的所有行
+func readImportFile(file *multipart.FileHeader) ([][]string, error) {
+	if file.Size > productImportMaxSize {
+		return nil, fmt.Errorf("文件大小不能超过 %dMB", productImportMaxSize/1024/1024)
+	}
+	ext := strings.ToLower(filepath.Ext(file.Filename))
+	if ext != ".csv" && ext != ".xlsx" {
+		return nil, fmt.Errorf("不支持的文件类型: %s，请上传 csv 或 xlsx 文件", ext)
+	}
+
+	f, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("读取文件失败: %w", err)
+	}
+	defer f.Close()
+	data, err := io.ReadAll(io.LimitReader(f, productImportMaxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("读取文件失败: %w", err)
+	}
+
+	if ext == ".xlsx" {
+		rows, err := xlsx.ReadRows(bytes.NewReader(data), int64(len(data)), xlsx.Limits{
+			MaxRows:    productImportMaxRows + 1,
+			MaxColumns: productImportMaxCols,
+		})
+		switch {
+		case errors.Is(err, xlsx.ErrTooManyRows):
+			return nil, fmt.Errorf("单次最多导入 %d 行", productImportMaxRows)
+		case errors.Is(err, xlsx.ErrTooManyColumns):
+			return nil, fmt.Errorf("列数不能超过 %d", productImportMaxCols)
+		}
+		return rows, err
+	}
+
+	// Excel 另存的 UTF-8 CSV 带有 BOM
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	rows, err := r.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("解析 CSV 失败: %w", err)
+	}
+	return rows, nil
+}
+
+// importRow 表格中的一行数据
+type importRow struct {
+	num    int
+	cells  []string
+	cols   map[string]int
+	errors []*ProductImportRowError
+}
+
+func (r *importRow) get(col string) string {
+	i, ok := r.cols[col]
+	if !ok || i >= len(r.cells) {
+		return ""
+	}
+	return strings.TrimSpace(r.cells[i])
+}
+
+func (r *importRow) fail(col, format string, args ...interface{}) {
+	r.errors = append(r.errors, &ProductImportRowError{Row: r.num, Column: col, Error: fmt.Sprintf(format, args...)})
+}
+
+func (r *importRow) required(col string) string {
+	v := r.get(col)
+	if v == "" {
+		r.fail(col, "不能为空")
+	}
+	return v
+}
+
+func (r *importRow) optionalString(col string) *string {
+	v := r.get(col)
+	if v == "" {
+		return nil
+	}
+	return &v
+}
+
+func (r *importRow) float(col string) *float64 {
+	v := r.get(col)
+	if v == "" {
+		return nil
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil || f < 0 {
+		r.fail(col, "%s 不是有效的金额", v)
+		return nil
+	}
+	return &f
+}
+
+func (r *importRow) int32(col string) *int32 {
+	v := r.get(col)
+	if v == "" {
+		return nil
+	}
+	n, err := strconv.ParseInt(v, 10, 32)
+	if err != nil {
+		// XLSX 中的整数可能以 10.0 的形式保存
+		f, ferr := strconv.ParseFloat(v, 64)
+		if ferr != nil || f != float64(int32(f)) {
+			r.fail(col, "%s 不是有效的整数", v)
+			return nil
+		}
+		n = int64(f)
+	}
+	i := int32(n)
+	return &i
+}
+
+func (r *importRow) bool(col string) bool {
+	switch strings.ToLower(r.get(col)) {
+	case "", "0", "false", "no", "否":
+		return false
+	case "1", "true", "yes", "是":
+		return true
+	}
+	r.fail(col, "%s 不是有效的布尔值，请填写 1 或 0", r.get(col))
+	return false
+}
+
+// splitSpecs 按分隔符拆分规格名称，去掉首尾空格
+func splitSpecs(v string) []string {
+	if v == "" {
+		return nil
+	}
+	parts := strings.Split(v, productSpecSeparator)
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+	return parts
+}
+
+// parseImport 解析表格并校验：按条码把行归为商品，按名称查找分类，按条码匹配已有商品。
+// 只在文件格式错误时返回 error，数据错误记录在结果的 errors 中
+func (s *StoreProductService) parseImport(merID int32, rows [][]string, canChangePrice bool) ([]*importProduct, *ProductImportResult, error) {
+	// 去掉末尾的空行
+	for len(rows) > 0 && isBlankRow(rows[len(rows)-1]) {
+		rows = rows[:len(rows)-1]
+	}
+	if len(rows) < 2 {
+		return nil, nil, errors.New("文件中没有数据行")
+	}
+	if len(rows)-1 > productImportMaxRows {
+		return nil, nil, fmt.Errorf("单次最多导入 %d 行", productImportMaxRows)
+	}
+
+	cols := make(map[string]int)
+	for i, name := range rows[0] {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		if _, dup := cols[name]; dup {
+			return nil, nil, fmt.Errorf("表头 %s 重复", name)
+		}
+		cols[name] = i
+	}
+	for _, name := range productImportRequired {
+		if _, ok := cols[name]; !ok {
+			return nil, nil, fmt.Errorf("缺少列 %s", name)
+		}
+	}
+
+	categories, err := s.importCategories(merID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := &ProductImportResult{
+		Rows:   len(rows) - 1,
+		Errors: []*ProductImportRowError{},
+		Items:  []*ProductImportItem{},
+	}
+	var products []*importProduct
+	byBarCode := make(map[string]*importProduct)
+	specValues := make(map[*importProduct][][]string)
+	for i := 1; i < len(rows); i++ {
+		if isBlankRow(rows[i]) {
+			continue
+		}
+		row := &importRow{num: i + 1, cells: rows[i], cols: cols}
+		barCode := row.required(ProductColBarCode)
+		if barCode == "" {
+			result.Errors = append(result.Errors, row.errors...)
+			continue
+		}
+
+		product := byBarCode[barCode]
+		if product == nil {
+			product = &importProduct{row: row.num, barCode: barCode, req: parseImportProduct(row, categories)}
+			product.req.BarCodeNumber = &barCode
+			byBarCode[barCode] = product
+			products = append(products, product)
+		}
+
+		sku := CreateProductSkuReq{
+			AttrName: row.optionalString(ProductColSkuAttrName),
+			Price:    row.float(ProductColSkuPrice),
+			Cost:     row.float(ProductColSkuCost),
+			OtPrice:  row.float(ProductColSkuOtPrice),
+			Image:    row.optionalString(ProductColSkuImage),
+			Stock:    row.int32(ProductColSkuStock),
+			LowStock: row.int32(ProductColSkuLowStock),
+		}
+		if sku.Price == nil && row.get(ProductColSkuPrice) == "" {
+			row.fail(ProductColSkuPrice, "不能为空")
+		}
+		if sku.Stock != nil && *sku.Stock < 0 {
+			row.fail(ProductColSkuStock, "不能为负数")
+		}
+		if sku.LowStock != nil && *sku.LowStock < 0 {
+			row.fail(ProductColSkuLowStock, "不能为负数")
+		}
+		if len(product.req.Specs) > 0 {
+			sku.Specs = splitSpecs(row.get(ProductColSkuSpecs))
+			if len(sku.Specs) != len(product.req.Specs) {
+				row.fail(ProductColSkuSpecs, "需要为每个规格组填写一个规格值，用 %s 分隔", productSpecSeparator)
+			} else {
+				for _, name := range sku.Specs {
+					if utf8.RuneCountInString(name) > maxProductSpecNameLen {
+						row.fail(ProductColSkuSpecs, "规格值 %s 超过 %d 个字符", name, maxProductSpecNameLen)
+					}
+				}
+				specValues[product] = append(specValues[product], sku.Specs)
+			}
+		} else if row.get(ProductColSkuSpecs) != "" {
+			row.fail(ProductColSkuSpecs, "商品没有设置规格（%s），不能填写规格值", ProductColSpecNames)
+		}
+		product.req.Skus = append(product.req.Skus, sku)
+		product.rowErrors = append(product.rowErrors, row.errors...)
+	}
+
+	// 规格值按 SKU 行中出现的顺序收集
+	for _, product := range products {
+		for g := range product.req.Specs {
+			seen := make(map[string]bool)
+			for _, names := range specValues[product] {
+				if !seen[names[g]] {
+					seen[names[g]] = true
+					product.req.Specs[g].Values = append(product.req.Specs[g].Values, ProductSpecValueReq{Name: names[g]})
+				}
+			}
+		}
+		if len(product.rowErrors) == 0 {
+			product.err = validateProductSkus(product.req)
+		}
+	}
+
+	if err := s.matchImportProducts(merID, products, canChangePrice); err != nil {
+		return nil, nil, err
+	}
+
+	for _, product := range products {
+		result.Errors = append(result.Errors, product.rowErrors...)
+		if product.err != nil {
+			result.Errors = append(result.Errors, &ProductImportRowError{Row: product.row, Error: product.err.Error()})
+		}
+	}
+	result.Total = len(products)
+	return products, result, nil
+}
+
+// parseImportProduct 从商品的第一行读取商品字段
+func parseImportProduct(row *importRow, categories map[string][]int32) *CreateProductRequest {
+	req := &CreateProductRequest{
+		StoreName:    row.required(ProductColStoreName),
+		StoreInfo:    row.optionalString(ProductColStoreInfo),
+		Keyword:      row.get(ProductColKeyword),
+		UnitName:     row.required(ProductColUnitName),
+		Price:        row.float(ProductColPrice),
+		Cost:         row.float(ProductColCost),
+		OtPrice:      row.float(ProductColOtPrice),
+		IsGood:       row.bool(ProductColIsGood),
+		Image:        row.required(ProductColImage),
+		SliderImage:  row.get(ProductColSliderImage),
+		RefundSwitch: row.int32(ProductColRefundSwitch),
+		Content:      row.optionalString(ProductColContent),
+	}
+	if sort := row.int32(ProductColSort); sort != nil {
+		req.Sort = *sort
+	}
+	if productType := row.int32(ProductColProductType); productType != nil {
+		req.ProductType = *productType
+	}
+
+	if name := row.required(ProductColCategory); name != "" {
+		switch ids := categories[name]; len(ids) {
+		case 0:
+			row.fail(ProductColCategory, "分类 %s 不存在", name)
+		case 1:
+			req.CateID = ids[0]
+		default:
+			row.fail(ProductColCategory, "有多个名为 %s 的分类，无法确定", name)
+		}
+	}
+
+	for _, name := range splitSpecs(row.get(ProductColSpecNames)) {
+		if utf8.RuneCountInString(name) > maxProductSpecNameLen {
+			row.fail(ProductColSpecNames, "规格组名称 %s 超过 %d 个字符", name, maxProductSpecNameLen)
+		}
+		req.Specs = append(req.Specs, ProductSpecReq{Name: name})
+	}
+	return req
+}
+
+func isBlankRow(cells []string) bool {
+	for _, cell := range cells {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// importCategories 商户的分类名称到分类ID的映射
+func (s *StoreProductService) importCategories(merID int32) (map[string][]int32, error) {
+	c := dao.MerStoreCategory
+	list, err := c.WithContext(s.ctx).Where(c.MerID.Eq(merID)).Find()
+	if err != nil {
+		return nil, fmt.Errorf("查询分类失败: %w", err)
+	}
+	categories := make(map[string][]int32, len(list))
+	for _, category := range list {
+		name := strings.TrimSpace(category.CateName)
+		categories[name] = append(categories[name], category.StoreCategoryID)
+	}
+	return categories, nil
+}
+
+// matchImportProducts 按条码匹配商户未删除的商品，匹配到的商品改为更新，
+// SKU 按属性名称匹配已有 SKU，保留 SKU ID 及库存
+func (s *StoreProductService) matchImportProducts(merID int32, products []*importProduct, canChangePrice bool) error {
+	if len(products) == 0 {
+		return nil
+	}
+	barCodes := make([]string, 0, len(products))
+	for _, product := range products {
+		barCodes = append(barCodes, product.barCode)
+	}
+
+	p := dao.MerStoreProduct
+	existing := make(map[string][]*model.MerStoreProduct)
+	for start := 0; start < len(barCodes); start += bulkProductBatch {
+		end := min(start+bulkProductBatch, len(barCodes))
+		list, err := p.WithContext(s.ctx).
+			Where(p.MerID.Eq(merID), p.DeleteAt.IsNull(), p.BarCodeNumber.In(barCodes[start:end]...)).
+			Find()
+		if err != nil {
+			return fmt.Errorf("查询商品失败: %w", err)
+		}
+		for _, product := range list {
+			existing[*product.BarCodeNumber] = append(existing[*product.BarCodeNumber], product)
+		}
+	}
+
+	var productIDs []int32
+	for _, product := range products {
+		switch matched := existing[product.barCode]; len(matched) {
+		case 0:
+		case 1:
+			product.productID = matched[0].ProductID
+			productIDs = append(productIDs, product.productID)
+		default:
+			product.err = fmt.Errorf("条码 %s 对应多个商品，无法确定更新哪一个", product.barCode)
+		}
+	}
+	if len(productIDs) == 0 {
+		return nil
+	}
+
+	sk := dao.MerStoreProductSku
+	skus := make(map[int32][]*model.MerStoreProductSku)
+	for start := 0; start < len(productIDs); start += bulkProductBatch {
+		end := min(start+bulkProductBatch, len(productIDs))
+		list, err := sk.WithContext(s.ctx).Where(sk.ProductID.In(productIDs[start:end]...)).Order(sk.ProductSkuID).Find()
+		if err != nil {
+			return fmt.Errorf("查询SKU失败: %w", err)
+		}
+		for _, sku := range list {
+			skus[sku.ProductID] = append(skus[sku.ProductID], sku)
+		}
+	}
+
+	for _, product := range products {
+		if product.productID == 0 {
+			continue
+		}
+		used := make(map[int32]bool)
+		for i := range product.req.Skus {
+			sku := &product.req.Skus[i]
+			attrName := ""
+			if len(sku.Specs) > 0 {
+				attrName = strings.Join(sku.Specs, "/")
+			} else if sku.AttrName != nil {
+				attrName = *sku.AttrName
+			}
+			for _, existingSku := range skus[product.productID] {
+				existingName := ""
+				if existingSku.AttrName != nil {
+					existingName = *existingSku.AttrName
+				}
+				if !used[existingSku.ProductSkuID] && existingName == attrName {
+					used[existingSku.ProductSkuID] = true
+					sku.ProductSkuID = &existingSku.ProductSkuID
+					break
+				}
+			}
+		}
+
+		// 没有改价权限时不能通过导入修改已有商品的价格
+		if !canChangePrice && product.err == nil && len(product.rowErrors) == 0 {
+			if err := s.CheckPriceUnchanged(product.productID, product.req, merID); err != nil {
+				product.err = err
+			}
+		}
+	}
+	return nil
+}
@@ -4,14 +4,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"merchant_api/internal/dao"
 	"merchant_api/internal/model"
 	"merchant_api/pkg/database"
+	"strings"
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// ErrPriceChangeForbidden 没有改价权限时修改了价格
+var ErrPriceChangeForbidden = errors.New("无权修改商品价格")
+
 type StoreProductService struct {
 	ctx context.Context
 }
@@ -39,17 +45,21 @@ type CreateProductRequest struct {
 	RefundSwitch  *int32                `json:"refund_switch"`
 	BarCodeNumber *string               `json:"bar_code_number"`
 	Content       *string               `json:"content"`
+	Specs         []ProductSpecReq      `json:"specs" binding:"omitempty,dive"` // 规格组，不传表示没有规格，SKU 使用自由填写的 attr_name
 	Skus          []CreateProductSkuReq `json:"skus" binding:"required,min=1"`
 }
 
 // CreateProductSkuReq SKU请求
 type CreateProductSkuReq struct {
 	ProductSkuID *int32   `json:"product_sku_id"` // SKU ID，更新时传入，创建时不传
-	AttrName     *string  `json:"attr_name"`
+	AttrName     *string  `json:"attr_name"`      // 设置了规格时由规格值生成，传入的值被忽略
+	Specs        []string `json:"specs"`          // 规格值名称，按规格组顺序，每种组合恰好一个 SKU
 	Price        *float64 `json:"price" binding:"required"`
 	Cost         *float64 `json:"cost"`
 	OtPrice      *float64 `json:"ot_price"`
 	Image        *string  `json:"image"`
+	Stock        *int32   `json:"stock" binding:"omitempty,min=0"`     // 初始库存，只在创建 SKU 时生效，之后通过库存接口调整
+	LowStock     *int32   `json:"low_stock" binding:"omitempty,min=0"` // 低库存预警值，0 表示不预警
 }
 
 // ProductDetailResponse 商品详情响应
@@ -57,12 +67,15 @@ type ProductDetailResponse struct {
 	*model.MerStoreProduct
 	Category *model.MerStoreCategory       `json:"category"`
 	Content  *model.MerStoreProductContent `json:"content"`
+	Specs    []*ProductSpec                `json:"specs"`
 	Skus     []*model.MerStoreProductSku   `json:"skus"`
 }
 
 // Create 创建商品
 func (s *StoreProductService) Create(req *CreateProductRequest, merID int32) (*ProductDetailResponse, error) {
-	db := database.GetDB()
+	if err := validateProductSkus(req); err != nil {
+		return nil, err
+	}
 
 	// 验证分类是否存在且属于该商户
 	category, err := dao.MerStoreCategory.WithContext(s.ctx).
@@ -79,9 +92,7 @@ func (s *StoreProductService) Create(req *CreateProductRequest, merID int32) (*P
 	var result *ProductDetailResponse
 
 	// 使用事务创建商品及关联数据
-	err = db.Transaction(func(tx *gorm.DB) error {
-		dao.SetDefault(tx)
-
+	err = dao.Q.Transaction(func(tx *dao.Query) error {
 		// 创建商品主表
 		now := time.Now()
 		product := &model.MerStoreProduct{
@@ -89,8 +100,8 @@ func (s *StoreProductService) Create(req *CreateProductRequest, merID int32) (*P
 			StoreName:     req.StoreName,
 			StoreInfo:     "",
 			Keyword:       req.Keyword,
-			IsShow:        1,             // 默认上架
-			SaleStatus:    boolPtr(true), // 默认销售中
+			IsShow:        1,                             // 默认上架
+			SaleStatus:    boolPtr(skusOnSale(req.Skus)), // 与库存变动后的规则相同，见 InventoryService.refreshSaleStatus
 			CateID:        req.CateID,
 			UnitName:      req.UnitName,
 			Sort:          req.Sort,
@@ -111,7 +122,7 @@ func (s *StoreProductService) Create(req *CreateProductRequest, merID int32) (*P
 			product.StoreInfo = *req.StoreInfo
 		}
 
-		if err := dao.MerStoreProduct.WithContext(s.ctx).Create(product); err != nil {
+		if err := tx.MerStoreProduct.WithContext(s.ctx).Create(product); err != nil {
 			return fmt.Errorf("创建商品失败: %w", err)
 		}
 
@@ -124,23 +135,26 @@ func (s *StoreProductService) Create(req *CreateProductRequest, merID int32) (*P
 			ProductID: product.ProductID,
 			Content:   contentStr,
 		}
-		if err := dao.MerStoreProductContent.WithContext(s.ctx).Create(content); err != nil {
+		if err := tx.MerStoreProductContent.WithContext(s.ctx).Create(content); err != nil {
 			return fmt.Errorf("创建商品详情失败: %w", err)
 		}
 
+		// 创建规格组及规格值
+		specValues, err := s.saveProductSpecs(tx, product.ProductID, req.Specs)
+		if err != nil {
+			return err
+		}
+		specs, err := s.loadProductSpecs(tx, []int32{product.ProductID})
+		if err != nil {
+			return err
+		}
+
 		// 创建商品SKU
 		skus := make([]*model.MerStoreProductSku, 0, len(req.Skus))
 		for _, skuReq := range req.Skus {
-			sku := &model.MerStoreProductSku{
-				ProductID: product.ProductID,
-				AttrName:  skuReq.AttrName,
-				Price:     skuReq.Price,
-				Cost:      skuReq.Cost,
-				OtPrice:   skuReq.OtPrice,
-				Image:     skuReq.Image,
-			}
-			if err := dao.MerStoreProductSku.WithContext(s.ctx).Create(sku); err != nil {
-				return fmt.Errorf("创建商品SKU失败: %w", err)
+			sku, err := s.createSku(tx, merID, product.ProductID, &skuReq, specValues)
+			if err != nil {
+				return err
 			}
 			skus = append(skus, sku)
 		}
@@ -149,34 +163,85 @@ func (s *StoreProductService) Create(req *CreateProductRequest, merID int32) (*P
 			MerStoreProduct: product,
 			Category:        category,
 			Content:         content,
+			Specs:           specs[product.ProductID],
 			Skus:            skus,
 		}
 
 		return nil
 	})
-
 	if err != nil {
 		return nil, err
 	}
 
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		MerID:      merID,
+		Action:     AuditActionProductCreate,
+		EntityType: AuditEntityProduct,
+		EntityID:   int64(result.ProductID),
+		After:      result,
+	})
+
 	return result, nil
 }
 
+// skusOnSale 新建商品的销售状态：有 SKU 没有传初始库存（不限制销售）或初始库存大于 0 时为销售中
+func skusOnSale(skus []CreateProductSkuReq) bool {
+	for _, sku := range skus {
+		if skuOnSale(sku.Stock, 0) {
+			return true
+		}
+	}
+	return false
+}
+
+// createSku 创建SKU，有初始库存时记录库存变动，需要在事务中调用
+func (s *StoreProductService) createSku(tx *dao.Query, merID, productID int32, req *CreateProductSkuReq, specs [][]*model.MerStoreProductSpecValue) (*model.MerStoreProductSku, error) {
+	specKey, attrName := skuSpec(specs, req)
+	sku := &model.MerStoreProductSku{
+		ProductID: productID,
+		AttrName:  attrName,
+		SpecKey:   specKey,
+		Price:     req.Price,
+		Cost:      req.Cost,
+		OtPrice:   req.OtPrice,
+		Image:     req.Image,
+		Stock:     req.Stock,
+	}
+	if req.LowStock != nil {
+		sku.LowStock = *req.LowStock
+	}
+	if err := tx.MerStoreProductSku.WithContext(s.ctx).Create(sku); err != nil {
+		return nil, fmt.Errorf("创建商品SKU失败: %w", err)
+	}
+
+	if sku.Stock != nil && *sku.Stock > 0 {
+		movement := &model.MerStoreStockMovement{
+			MerID:        merID,
+			ProductID:    productID,
+			ProductSkuID: sku.ProductSkuID,
+			Type:         StockMovementInitial,
+			StockChange:  *sku.Stock,
+			StockAfter:   sku.Stock,
+			CreatedBy:    AuditActorFrom(s.ctx).AdminID,
+			CreateAt:     time.Now(),
+		}
+		if err := tx.MerStoreStockMovement.WithContext(s.ctx).Create(movement); err != nil {
+			return nil, fmt.Errorf("记录库存变动失败: %w", err)
+		}
+	}
+	return sku, nil
+}
+
 // Update 更新商品
 func (s *StoreProductService) Update(productID int32, req *CreateProductRequest, merID int32) error {
-	db := database.GetDB()
+	if err := validateProductSkus(req); err != nil {
+		return err
+	}
 
-	// 验证商品是否存在且属于该商户
-	product, err := dao.MerStoreProduct.WithContext(s.ctx).
-		Where(dao.MerStoreProduct.ProductID.Eq(productID)).
-		Where(dao.MerStoreProduct.MerID.Eq(merID)).
-		Where(dao.MerStoreProduct.DeleteAt.IsNull()).
-		First()
+	// 验证商品是否存在且属于该商户，同时作为操作日志的修改前数据
+	before, err := s.Get(productID, merID)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return errors.New("商品不存在或无权访问")
-		}
-		return fmt.Errorf("查询商品失败: %w", err)
+		return err
 	}
 
 	// 验证分类是否存在且属于该商户
@@ -192,9 +257,7 @@ func (s *StoreProductService) Update(productID int32, req *CreateProductRequest,
 	}
 
 	// 使用事务更新商品及关联数据
-	return db.Transaction(func(tx *gorm.DB) error {
-		dao.SetDefault(tx)
-
+	err = dao.Q.Transaction(func(tx *dao.Query) error {
 		// 更新商品主表
 		now := time.Now()
 		updates := map[string]interface{}{
@@ -218,8 +281,8 @@ func (s *StoreProductService) Update(productID int32, req *CreateProductRequest,
 			updates["store_info"] = *req.StoreInfo
 		}
 
-		_, err := dao.MerStoreProduct.WithContext(s.ctx).
-			Where(dao.MerStoreProduct.ProductID.Eq(productID)).
+		_, err := tx.MerStoreProduct.WithContext(s.ctx).
+			Where(tx.MerStoreProduct.ProductID.Eq(productID)).
 			Updates(updates)
 		if err != nil {
 			return fmt.Errorf("更新商品失败: %w", err)
@@ -227,8 +290,8 @@ func (s *StoreProductService) Update(productID int32, req *CreateProductRequest,
 
 		// 更新商品详情
 		if req.Content != nil {
-			_, err = dao.MerStoreProductContent.WithContext(s.ctx).
-				Where(dao.MerStoreProductContent.ProductID.Eq(productID)).
+			_, err = tx.MerStoreProductContent.WithContext(s.ctx).
+				Where(tx.MerStoreProductContent.ProductID.Eq(productID)).
 				Updates(map[string]interface{}{
 					"content": *req.Content,
 				})
@@ -237,6 +300,12 @@ func (s *StoreProductService) Update(productID int32, req *CreateProductRequest,
 			}
 		}
 
+		// 同步规格组及规格值，未修改的规格值保留原ID
+		specValues, err := s.saveProductSpecs(tx, productID, req.Specs)
+		if err != nil {
+			return err
+		}
+
 		// 收集请求中的SKU ID
 		reqSkuIDs := make(map[int32]bool)
 		for _, skuReq := range req.Skus {
@@ -245,19 +314,33 @@ func (s *StoreProductService) Update(productID int32, req *CreateProductRequest,
 			}
 		}
 
-		// 查询当前商品的所有SKU
-		existingSkus, err := dao.MerStoreProductSku.WithContext(s.ctx).
-			Where(dao.MerStoreProductSku.ProductID.Eq(productID)).
+		// 查询当前商品的所有SKU，加锁避免删除时并发预占
+		existingSkus, err := tx.MerStoreProductSku.WithContext(s.ctx).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where(tx.MerStoreProductSku.ProductID.Eq(productID)).
 			Find()
 		if err != nil {
 			return fmt.Errorf("查询现有SKU失败: %w", err)
 		}
 
-		// 删除不在请求中的SKU
+		// 删除不在请求中的SKU，有未完成订单预占的SKU不能删除；保留的SKU按规格组合记录，
+		// 删除后重新添加相同组合的SKU不算重复
+		skusChanged := false
+		existingSkuMap := make(map[int32]*model.MerStoreProductSku, len(existingSkus))
+		existingSpecKeys := make(map[string]int32, len(existingSkus))
 		for _, existingSku := range existingSkus {
-			if !reqSkuIDs[existingSku.ProductSkuID] {
-				_, err = dao.MerStoreProductSku.WithContext(s.ctx).
-					Where(dao.MerStoreProductSku.ProductSkuID.Eq(existingSku.ProductSkuID)).
+			if reqSkuIDs[existingSku.ProductSkuID] {
+				existingSkuMap[existingSku.ProductSkuID] = existingSku
+				if existingSku.SpecKey != nil {
+					existingSpecKeys[normalizeSpecKey(*existingSku.SpecKey)] = existingSku.ProductSkuID
+				}
+			} else {
+				if existingSku.Reserved > 0 {
+					return fmt.Errorf("SKU %d 有未完成的订单预占，不能删除", existingSku.ProductSkuID)
+				}
+				skusChanged = true
+				_, err = tx.MerStoreProductSku.WithContext(s.ctx).
+					Where(tx.MerStoreProductSku.ProductSkuID.Eq(existingSku.ProductSkuID)).
 					Delete()
 				if err != nil {
 					return fmt.Errorf("删除旧SKU失败: %w", err)
@@ -265,47 +348,76 @@ func (s *StoreProductService) Update(productID int32, req *CreateProductRequest,
 			}
 		}
 
-		// 更新或创建SKU
+		// 更新或创建SKU，现有SKU的库存不在这里修改
 		for _, skuReq := range req.Skus {
+			specKey, attrName := skuSpec(specValues, &skuReq)
 			if skuReq.ProductSkuID != nil {
-				// 更新现有SKU
-				_, err = dao.MerStoreProductSku.WithContext(s.ctx).
-					Where(dao.MerStoreProductSku.ProductSkuID.Eq(*skuReq.ProductSkuID)).
-					Where(dao.MerStoreProductSku.ProductID.Eq(productID)).
-					Updates(map[string]interface{}{
-						"attr_name": skuReq.AttrName,
-						"price":     skuReq.Price,
-						"cost":      skuReq.Cost,
-						"ot_price":  skuReq.OtPrice,
-						"image":     skuReq.Image,
-					})
+				// 更新现有SKU，已有规格组合的SKU不能改成其他组合，避免库存对应到错误的规格
+				if existing := existingSkuMap[*skuReq.ProductSkuID]; existing != nil &&
+					existing.SpecKey != nil && specKey != nil && normalizeSpecKey(*existing.SpecKey) != *specKey {
+					return fmt.Errorf("SKU %d 的规格组合不能修改，请删除后重新添加", existing.ProductSkuID)
+				}
+				skuUpdates := map[string]interface{}{
+					"attr_name": attrName,
+					"spec_key":  specKey,
+					"price":     skuReq.Price,
+					"cost":      skuReq.Cost,
+					"ot_price":  skuReq.OtPrice,
+					"image":     skuReq.Image,
+				}
+				if skuReq.LowStock != nil {
+					skuUpdates["low_stock"] = *skuReq.LowStock
+				}
+				_, err = tx.MerStoreProductSku.WithContext(s.ctx).
+					Where(tx.MerStoreProductSku.ProductSkuID.Eq(*skuReq.ProductSkuID)).
+					Where(tx.MerStoreProductSku.ProductID.Eq(productID)).
+					Updates(skuUpdates)
 				if err != nil {
 					return fmt.Errorf("更新SKU失败: %w", err)
 				}
 			} else {
-				// 创建新SKU
-				sku := &model.MerStoreProductSku{
-					ProductID: product.ProductID,
-					AttrName:  skuReq.AttrName,
-					Price:     skuReq.Price,
-					Cost:      skuReq.Cost,
-					OtPrice:   skuReq.OtPrice,
-					Image:     skuReq.Image,
+				// 创建新SKU，规格组合已存在时需要传入原SKU ID
+				if specKey != nil {
+					if skuID, ok := existingSpecKeys[*specKey]; ok {
+						return fmt.Errorf("规格组合 %s 已存在，请传入 product_sku_id %d", *attrName, skuID)
+					}
 				}
-				if err := dao.MerStoreProductSku.WithContext(s.ctx).Create(sku); err != nil {
-					return fmt.Errorf("创建新SKU失败: %w", err)
+				if _, err := s.createSku(tx, merID, productID, &skuReq, specValues); err != nil {
+					return err
 				}
+				skusChanged = true
 			}
 		}
 
+		// SKU 增删会改变可售库存，重新计算销售状态；只改资料时保留手动设置的售完状态
+		if skusChanged {
+			inventory := &InventoryService{ctx: s.ctx}
+			return inventory.refreshSaleStatus(tx, []int32{productID})
+		}
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+
+	after, err := s.Get(productID, merID)
+	if err == nil {
+		NewAuditService(s.ctx).Log(&AuditEntry{
+			MerID:      merID,
+			Action:     AuditActionProductUpdate,
+			EntityType: AuditEntityProduct,
+			EntityID:   int64(productID),
+			Before:     before,
+			After:      after,
+		})
+	}
+	return nil
 }
 
 // Delete 删除商品（软删除）
 func (s *StoreProductService) Delete(productID int32, merID int32) error {
 	// 验证商品是否存在且属于该商户
-	_, err := dao.MerStoreProduct.WithContext(s.ctx).
+	product, err := dao.MerStoreProduct.WithContext(s.ctx).
 		Where(dao.MerStoreProduct.ProductID.Eq(productID)).
 		Where(dao.MerStoreProduct.MerID.Eq(merID)).
 		Where(dao.MerStoreProduct.DeleteAt.IsNull()).
@@ -324,7 +436,18 @@ func (s *StoreProductService) Delete(productID int32, merID int32) error {
 		Updates(map[string]interface{}{
 			"delete_at": now,
 		})
-	return err
+	if err != nil {
+		return err
+	}
+
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		MerID:      merID,
+		Action:     AuditActionProductDelete,
+		EntityType: AuditEntityProduct,
+		EntityID:   int64(productID),
+		Before:     product,
+	})
+	return nil
 }
 
 // Get 获取商品详情
@@ -342,50 +465,85 @@ func (s *StoreProductService) Get(productID int32, merID int32) (*ProductDetailR
 		return nil, fmt.Errorf("查询商品失败: %w", err)
 	}
 
-	// 查询分类
-	category, err := dao.MerStoreCategory.WithContext(s.ctx).
-		Where(dao.MerStoreCategory.StoreCategoryID.Eq(product.CateID)).
-		First()
-	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-		return nil, fmt.Errorf("查询分类失败: %w", err)
-	}
-
-	// 查询商品详情
-	content, err := dao.MerStoreProductContent.WithContext(s.ctx).
-		Where(dao.MerStoreProductContent.ProductID.Eq(productID)).
-		First()
-	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-		return nil, fmt.Errorf("查询商品详情失败: %w", err)
-	}
-
-	// 查询SKU列表
-	skus, err := dao.MerStoreProductSku.WithContext(s.ctx).
-		Where(dao.MerStoreProductSku.ProductID.Eq(productID)).
-		Find()
+	// 查询分类、商品详情、规格及SKU
+	result, err := s.loadProductRelations([]*model.MerStoreProduct{product}, allProductIncludes)
 	if err != nil {
-		return nil, fmt.Errorf("查询SKU失败: %w", err)
+		return nil, err
 	}
+	return result[0], nil
+}
 
-	return &ProductDetailResponse{
-		MerStoreProduct: product,
-		Category:        category,
-		Content:         content,
-		Skus:            skus,
-	}, nil
+// ProductFilter 商品筛选条件，商品列表及批量操作共用
+type ProductFilter struct {
+	CateID     *int32 `form:"cate_id" json:"cate_id"`
+	IsShow     *int32 `form:"is_show" json:"is_show"`
+	SaleStatus *bool  `form:"sale_status" json:"sale_status"`
+	Keyword    string `form:"keyword" json:"keyword"`
+	SpecValue  string `form:"spec_value" json:"spec_value"` // 规格值名称，例如 红色
 }
 
 // ListRequest 列表请求
 type ListRequest struct {
-	Page       int    `form:"page,default=1"`
-	PageSize   int    `form:"page_size,default=20"`
-	CateID     *int32 `form:"cate_id"`
-	IsShow     *int32 `form:"is_show"`
-	SaleStatus *bool  `form:"sale_status"`
-	Keyword    string `form:"keyword"`
+	Page     int `form:"page,default=1"`
+	PageSize int `form:"page_size,default=20"`
+	ProductFilter
+	Include string `form:"include"` // 逗号分隔的关联数据：category、skus、specs、content，默认不含 content
+}
+
+// 商品列表可以加载的关联数据
+const (
+	ProductIncludeCategory = "category"
+	ProductIncludeContent  = "content" // 商品详情为 longtext，列表默认不返回
+	ProductIncludeSkus     = "skus"
+	ProductIncludeSpecs    = "specs"
+)
+
+// defaultProductIncludes 不传 include 时加载的关联数据
+const defaultProductIncludes = "category,skus,specs"
+
+// allProductIncludes 商品详情加载全部关联数据
+var allProductIncludes = map[string]bool{
+	ProductIncludeCategory: true,
+	ProductIncludeContent:  true,
+	ProductIncludeSkus:     true,
+	ProductIncludeSpecs:    true,
 }
 
 // GetList 获取商品列表
 func (s *StoreProductService) GetList(merID int32, req *ListRequest) ([]*ProductDetailResponse, int64, error) {
+	includes, err := parseProductIncludes(req.Include)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	p := dao.MerStoreProduct
+	query := s.filterQuery(merID, &req.ProductFilter)
+
+	// 获取总数
+	total, err := query.Count()
+	if err != nil {
+		return nil, 0, fmt.Errorf("查询商品总数失败: %w", err)
+	}
+
+	// 获取列表
+	products, err := query.
+		Order(p.Sort.Desc(), p.ProductID.Desc()).
+		Limit(req.PageSize).
+		Offset((req.Page - 1) * req.PageSize).
+		Find()
+	if err != nil {
+		return nil, 0, fmt.Errorf("查询商品列表失败: %w", err)
+	}
+
+	result, err := s.loadProductRelations(products, includes)
+	if err != nil {
+		return nil, 0, err
+	}
+	return result, total, nil
+}
+
+// filterQuery 按筛选条件构造商户未删除商品的查询
+func (s *StoreProductService) filterQuery(merID int32, filter *ProductFilter) dao.IMerStoreProductDo {
 	p := dao.MerStoreProduct
 
 	query := p.WithContext(s.ctx).
@@ -393,74 +551,140 @@ func (s *StoreProductService) GetList(merID int32, req *ListRequest) ([]*Product
 		Where(p.DeleteAt.IsNull())
 
 	// 分类筛选
-	if req.CateID != nil {
-		query = query.Where(p.CateID.Eq(*req.CateID))
+	if filter.CateID != nil {
+		query = query.Where(p.CateID.Eq(*filter.CateID))
 	}
 
 	// 上架状态筛选
-	if req.IsShow != nil {
-		query = query.Where(p.IsShow.Eq(*req.IsShow))
+	if filter.IsShow != nil {
+		query = query.Where(p.IsShow.Eq(*filter.IsShow))
 	}
 
 	// 售完状态筛选
-	if req.SaleStatus != nil {
-		query = query.Where(p.SaleStatus.Is(*req.SaleStatus))
+	if filter.SaleStatus != nil {
+		query = query.Where(p.SaleStatus.Is(*filter.SaleStatus))
 	}
 
 	// 关键字搜索
-	if req.Keyword != "" {
-		query = query.Where(p.StoreName.Like("%" + req.Keyword + "%"))
+	if filter.Keyword != "" {
+		query = query.Where(p.StoreName.Like("%" + filter.Keyword + "%"))
 	}
 
-	// 获取总数
-	total, err := query.Count()
-	if err != nil {
-		return nil, 0, fmt.Errorf("查询商品总数失败: %w", err)
+	// 规格值筛选
+	if filter.SpecValue != "" {
+		sv := dao.MerStoreProductSpecValue
+		query = query.Where(p.Columns(p.ProductID).In(
+			sv.WithContext(s.ctx).Select(sv.ProductID).Where(sv.ValueName.Eq(filter.SpecValue)),
+		))
 	}
+	return query
+}
 
-	// 获取列表
-	products, err := query.
-		Order(p.Sort.Desc(), p.ProductID.Desc()).
-		Limit(req.PageSize).
-		Offset((req.Page - 1) * req.PageSize).
-		Find()
-	if err != nil {
-		return nil, 0, fmt.Errorf("查询商品列表失败: %w", err)
+// loadProductRelations 按 include 批量加载商品的关联数据，每种关联只查询一次
+func (s *StoreProductService) loadProductRelations(products []*model.MerStoreProduct, includes map[string]bool) ([]*ProductDetailResponse, error) {
+	result := make([]*ProductDetailResponse, 0, len(products))
+	if len(products) == 0 {
+		return result, nil
 	}
 
-	// 组装详情数据
-	result := make([]*ProductDetailResponse, 0, len(products))
+	productIDs := make([]int32, 0, len(products))
+	cateIDs := make([]int32, 0, len(products))
+	seenCate := make(map[int32]bool)
 	for _, product := range products {
-		// 查询分类
-		category, _ := dao.MerStoreCategory.WithContext(s.ctx).
-			Where(dao.MerStoreCategory.StoreCategoryID.Eq(product.CateID)).
-			First()
-
-		// 查询商品详情
-		content, _ := dao.MerStoreProductContent.WithContext(s.ctx).
-			Where(dao.MerStoreProductContent.ProductID.Eq(product.ProductID)).
-			First()
-
-		// 查询SKU列表
-		skus, _ := dao.MerStoreProductSku.WithContext(s.ctx).
-			Where(dao.MerStoreProductSku.ProductID.Eq(product.ProductID)).
-			Find()
+		productIDs = append(productIDs, product.ProductID)
+		if !seenCate[product.CateID] {
+			seenCate[product.CateID] = true
+			cateIDs = append(cateIDs, product.CateID)
+		}
+	}
 
-		result = append(result, &ProductDetailResponse{
+	// 分类
+	categories := make(map[int32]*model.MerStoreCategory)
+	if includes[ProductIncludeCategory] {
+		c := dao.MerStoreCategory
+		list, err := c.WithContext(s.ctx).Where(c.StoreCategoryID.In(cateIDs...)).Find()
+		if err != nil {
+			return nil, fmt.Errorf("查询分类失败: %w", err)
+		}
+		for _, category := range list {
+			categories[category.StoreCategoryID] = category
+		}
+	}
+
+	// 商品详情
+	contents := make(map[int32]*model.MerStoreProductContent)
+	if includes[ProductIncludeContent] {
+		pc := dao.MerStoreProductContent
+		list, err := pc.WithContext(s.ctx).Where(pc.ProductID.In(productIDs...)).Find()
+		if err != nil {
+			return nil, fmt.Errorf("查询商品详情失败: %w", err)
+		}
+		for _, content := range list {
+			contents[content.ProductID] = content
+		}
+	}
+
+	// SKU
+	skus := make(map[int32][]*model.MerStoreProductSku)
+	if includes[ProductIncludeSkus] {
+		sk := dao.MerStoreProductSku
+		list, err := sk.WithContext(s.ctx).Where(sk.ProductID.In(productIDs...)).Order(sk.ProductSkuID).Find()
+		if err != nil {
+			return nil, fmt.Errorf("查询SKU失败: %w", err)
+		}
+		for _, sku := range list {
+			skus[sku.ProductID] = append(skus[sku.ProductID], sku)
+		}
+	}
+
+	// 规格
+	specs := make(map[int32][]*ProductSpec)
+	if includes[ProductIncludeSpecs] {
+		var err error
+		if specs, err = s.loadProductSpecs(dao.Q, productIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, product := range products {
+		item := &ProductDetailResponse{
 			MerStoreProduct: product,
-			Category:        category,
-			Content:         content,
-			Skus:            skus,
-		})
+			Category:        categories[product.CateID],
+			Content:         contents[product.ProductID],
+			Specs:           specs[product.ProductID],
+			Skus:            skus[product.ProductID],
+		}
+		if includes[ProductIncludeSkus] && item.Skus == nil {
+			item.Skus = []*model.MerStoreProductSku{}
+		}
+		result = append(result, item)
 	}
+	return result, nil
+}
 
-	return result, total, nil
+// parseProductIncludes 解析 include 参数，为空时使用默认的关联数据
+func parseProductIncludes(raw string) (map[string]bool, error) {
+	if strings.TrimSpace(raw) == "" {
+		raw = defaultProductIncludes
+	}
+	includes := make(map[string]bool)
+	for _, name := range strings.Split(raw, ",") {
+		name = strings.TrimSpace(name)
+		switch name {
+		case ProductIncludeCategory, ProductIncludeContent, ProductIncludeSkus, ProductIncludeSpecs:
+			includes[name] = true
+		case "":
+		default:
+			return nil, fmt.Errorf("不支持的 include: %s", name)
+		}
+	}
+	return includes, nil
 }
 
 // UpdateListingStatus 更新上架状态
 func (s *StoreProductService) UpdateListingStatus(productID int32, merID int32, isShow int32) error {
 	// 验证商品是否存在且属于该商户
-	_, err := dao.MerStoreProduct.WithContext(s.ctx).
+	product, err := dao.MerStoreProduct.WithContext(s.ctx).
 		Where(dao.MerStoreProduct.ProductID.Eq(productID)).
 		Where(dao.MerStoreProduct.MerID.Eq(merID)).
 		Where(dao.MerStoreProduct.DeleteAt.IsNull()).
@@ -478,13 +702,25 @@ func (s *StoreProductService) UpdateListingStatus(productID int32, merID int32,
 		Updates(map[string]interface{}{
 			"is_show": isShow,
 		})
-	return err
+	if err != nil {
+		return err
+	}
+
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		MerID:      merID,
+		Action:     AuditActionProductListing,
+		EntityType: AuditEntityProduct,
+		EntityID:   int64(productID),
+		Before:     map[string]interface{}{"is_show": product.IsShow},
+		After:      map[string]interface{}{"is_show": isShow},
+	})
+	return nil
 }
 
 // UpdateSoldOutStatus 更新售完状态
 func (s *StoreProductService) UpdateSoldOutStatus(productID int32, merID int32, saleStatus bool) error {
 	// 验证商品是否存在且属于该商户
-	_, err := dao.MerStoreProduct.WithContext(s.ctx).
+	product, err := dao.MerStoreProduct.WithContext(s.ctx).
 		Where(dao.MerStoreProduct.ProductID.Eq(productID)).
 		Where(dao.MerStoreProduct.MerID.Eq(merID)).
 		Where(dao.MerStoreProduct.DeleteAt.IsNull()).
@@ -502,7 +738,77 @@ func (s *StoreProductService) UpdateSoldOutStatus(productID int32, merID int32,
 		Updates(map[string]interface{}{
 			"sale_status": saleStatus,
 		})
-	return err
+	if err != nil {
+		return err
+	}
+
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		MerID:      merID,
+		Action:     AuditActionProductSoldOut,
+		EntityType: AuditEntityProduct,
+		EntityID:   int64(productID),
+		Before:     map[string]interface{}{"sale_status": product.SaleStatus},
+		After:      map[string]interface{}{"sale_status": saleStatus},
+	})
+	return nil
+}
+
+// CheckPriceUnchanged 校验更新请求没有修改商品及 SKU 价格（用于没有改价权限的管理员）
+func (s *StoreProductService) CheckPriceUnchanged(productID int32, req *CreateProductRequest, merID int32) error {
+	product, err := dao.MerStoreProduct.WithContext(s.ctx).
+		Where(dao.MerStoreProduct.ProductID.Eq(productID)).
+		Where(dao.MerStoreProduct.MerID.Eq(merID)).
+		Where(dao.MerStoreProduct.DeleteAt.IsNull()).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("商品不存在或无权访问")
+		}
+		return fmt.Errorf("查询商品失败: %w", err)
+	}
+
+	if !priceEqual(product.Price, req.Price) || !priceEqual(product.Cost, req.Cost) || !priceEqual(product.OtPrice, req.OtPrice) {
+		return ErrPriceChangeForbidden
+	}
+
+	skus, err := dao.MerStoreProductSku.WithContext(s.ctx).
+		Where(dao.MerStoreProductSku.ProductID.Eq(productID)).
+		Find()
+	if err != nil {
+		return fmt.Errorf("查询SKU失败: %w", err)
+	}
+	skuMap := make(map[int32]*model.MerStoreProductSku, len(skus))
+	for _, sku := range skus {
+		skuMap[sku.ProductSkuID] = sku
+	}
+
+	for _, skuReq := range req.Skus {
+		// 新增 SKU 需要设置价格，同样视为改价
+		if skuReq.ProductSkuID == nil {
+			return ErrPriceChangeForbidden
+		}
+		sku, ok := skuMap[*skuReq.ProductSkuID]
+		if !ok {
+			return ErrPriceChangeForbidden
+		}
+		if !priceEqual(sku.Price, skuReq.Price) || !priceEqual(sku.Cost, skuReq.Cost) || !priceEqual(sku.OtPrice, skuReq.OtPrice) {
+			return ErrPriceChangeForbidden
+		}
+	}
+
+	return nil
+}
+
+// priceEqual 按分比较两个价格，nil 视为 0
+func priceEqual(a, b *float64) bool {
+	var x, y float64
+	if a != nil {
+		x = *a
+	}
+	if b != nil {
+		y = *b
+	}
+	return math.Round(x*100) == math.Round(y*100)
 }
 
 // Helper function
@@ -0,0 +1,24 @@
+package service
+
+import "testing"
+
+func TestSkusOnSale(t *testing.T) {
+	stock := func(n int32) *int32 { return &n }
+	tests := []struct {
+		name string
+		skus []CreateProductSkuReq
+		want bool
+	}{
+		{"没有传库存", []CreateProductSkuReq{{}, {}}, true},
+		{"有 SKU 有库存", []CreateProductSkuReq{{Stock: stock(0)}, {Stock: stock(5)}}, true},
+		{"部分传库存且为 0", []CreateProductSkuReq{{}, {Stock: stock(0)}}, true},
+		{"传了库存且全部为 0", []CreateProductSkuReq{{Stock: stock(0)}, {Stock: stock(0)}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := skusOnSale(tt.skus); got != tt.want {
+				t.Errorf("skusOnSale() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
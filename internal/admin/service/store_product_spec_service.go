@@ -0,0 +1,361 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"merchant_api/internal/dao"
+	"merchant_api/internal/model"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// 商品规格数量限制
+const (
+	maxProductSpecs      = 3   // 每个商品最多的规格组数
+	maxProductSpecValues = 20  // 每个规格组最多的规格值数
+	maxProductSpecSkus   = 200 // 规格组合（SKU）数量上限
+)
+
+// ProductSpecReq 规格组请求
+type ProductSpecReq struct {
+	SpecID *int32                `json:"spec_id"` // 更新时传入可以修改规格组名称；不传时按名称匹配已有规格组
+	Name   string                `json:"name" binding:"required,max=32"`
+	Values []ProductSpecValueReq `json:"values" binding:"required,min=1,dive"`
+}
+
+// ProductSpecValueReq 规格值请求
+type ProductSpecValueReq struct {
+	ValueID *int32 `json:"value_id"` // 更新时传入可以修改规格值名称，SKU 的规格组合保持不变；不传时按名称匹配
+	Name    string `json:"name" binding:"required,max=32"`
+}
+
+// SpecMatrixRequest 生成规格组合请求
+type SpecMatrixRequest struct {
+	Specs []ProductSpecReq `json:"specs" binding:"required,min=1,dive"`
+}
+
+// SpecCombination 规格组合，specs 按规格组顺序排列
+type SpecCombination struct {
+	Specs    []string `json:"specs"`
+	AttrName string   `json:"attr_name"`
+}
+
+// ProductSpec 商品规格组及其规格值
+type ProductSpec struct {
+	*model.MerStoreProductSpec
+	Values []*model.MerStoreProductSpecValue `json:"values"`
+}
+
+// SpecMatrix 按规格组生成全部规格组合（笛卡尔积），用于创建商品前填写每个 SKU 的价格和库存
+func (s *StoreProductService) SpecMatrix(req *SpecMatrixRequest) ([]*SpecCombination, error) {
+	return buildSpecMatrix(req.Specs)
+}
+
+// buildSpecMatrix 校验规格组并生成全部规格组合，名称去掉首尾空格后写回请求
+func buildSpecMatrix(specs []ProductSpecReq) ([]*SpecCombination, error) {
+	if len(specs) > maxProductSpecs {
+		return nil, fmt.Errorf("规格组不能超过 %d 个", maxProductSpecs)
+	}
+
+	total := 1
+	specNames := make(map[string]bool, len(specs))
+	for i := range specs {
+		spec := &specs[i]
+		spec.Name = strings.TrimSpace(spec.Name)
+		if spec.Name == "" {
+			return nil, errors.New("规格组名称不能为空")
+		}
+		if specNames[spec.Name] {
+			return nil, fmt.Errorf("规格组 %s 重复", spec.Name)
+		}
+		specNames[spec.Name] = true
+
+		if len(spec.Values) == 0 {
+			return nil, fmt.Errorf("规格组 %s 没有规格值", spec.Name)
+		}
+		if len(spec.Values) > maxProductSpecValues {
+			return nil, fmt.Errorf("规格组 %s 的规格值不能超过 %d 个", spec.Name, maxProductSpecValues)
+		}
+		valueNames := make(map[string]bool, len(spec.Values))
+		for j := range spec.Values {
+			value := &spec.Values[j]
+			value.Name = strings.TrimSpace(value.Name)
+			if value.Name == "" {
+				return nil, fmt.Errorf("规格组 %s 的规格值不能为空", spec.Name)
+			}
+			if valueNames[value.Name] {
+				return nil, fmt.Errorf("规格组 %s 的规格值 %s 重复", spec.Name, value.Name)
+			}
+			valueNames[value.Name] = true
+		}
+
+		total *= len(spec.Values)
+		if total > maxProductSpecSkus {
+			return nil, fmt.Errorf("规格组合不能超过 %d 个", maxProductSpecSkus)
+		}
+	}
+
+	combinations := [][]string{{}}
+	for _, spec := range specs {
+		next := make([][]string, 0, len(combinations)*len(spec.Values))
+		for _, prefix := range combinations {
+			for _, value := range spec.Values {
+				combination := make([]string, len(prefix), len(prefix)+1)
+				copy(combination, prefix)
+				next = append(next, append(combination, value.Name))
+			}
+		}
+		combinations = next
+	}
+
+	result := make([]*SpecCombination, 0, len(combinations))
+	for _, combination := range combinations {
+		result = append(result, &SpecCombination{
+			Specs:    combination,
+			AttrName: strings.Join(combination, "/"),
+		})
+	}
+	return result, nil
+}
+
+// validateProductSkus 校验 SKU 库存及规格：设置了规格时，每种规格组合必须恰好有一个 SKU
+func validateProductSkus(req *CreateProductRequest) error {
+	for i, sku := range req.Skus {
+		if (sku.Stock != nil && *sku.Stock < 0) || (sku.LowStock != nil && *sku.LowStock < 0) {
+			return fmt.Errorf("第 %d 个SKU的库存不能为负数", i+1)
+		}
+	}
+
+	if len(req.Specs) == 0 {
+		for _, sku := range req.Skus {
+			if len(sku.Specs) > 0 {
+				return errors.New("商品没有设置规格，SKU 不能选择规格值")
+			}
+		}
+		return nil
+	}
+
+	combinations, err := buildSpecMatrix(req.Specs)
+	if err != nil {
+		return err
+	}
+	expected := make(map[string]bool, len(combinations))
+	for _, combination := range combinations {
+		expected[specTupleKey(combination.Specs)] = true
+	}
+
+	seen := make(map[string]bool, len(req.Skus))
+	for i := range req.Skus {
+		names := req.Skus[i].Specs
+		if len(names) != len(req.Specs) {
+			return fmt.Errorf("第 %d 个SKU需要为每个规格组选择一个规格值", i+1)
+		}
+		for j := range names {
+			names[j] = strings.TrimSpace(names[j])
+		}
+		key := specTupleKey(names)
+		if !expected[key] {
+			return fmt.Errorf("规格组合 %s 不存在", strings.Join(names, "/"))
+		}
+		if seen[key] {
+			return fmt.Errorf("规格组合 %s 重复", strings.Join(names, "/"))
+		}
+		seen[key] = true
+	}
+
+	for _, combination := range combinations {
+		if !seen[specTupleKey(combination.Specs)] {
+			return fmt.Errorf("缺少规格组合 %s", combination.AttrName)
+		}
+	}
+	return nil
+}
+
+// specTupleKey 规格值名称组合的比较键
+func specTupleKey(names []string) string {
+	return strings.Join(names, "\x00")
+}
+
+// saveProductSpecs 保存商品的规格组及规格值：按 ID 或名称匹配已有数据以保留规格值ID，
+// 请求中没有的规格组和规格值被删除。返回按请求顺序排列的规格值，需要在事务中调用
+func (s *StoreProductService) saveProductSpecs(tx *dao.Query, productID int32, specs []ProductSpecReq) ([][]*model.MerStoreProductSpecValue, error) {
+	sp := tx.MerStoreProductSpec
+	sv := tx.MerStoreProductSpecValue
+
+	existingSpecs, err := sp.WithContext(s.ctx).Where(sp.ProductID.Eq(productID)).Find()
+	if err != nil {
+		return nil, fmt.Errorf("查询规格组失败: %w", err)
+	}
+	existingValues, err := sv.WithContext(s.ctx).Where(sv.ProductID.Eq(productID)).Find()
+	if err != nil {
+		return nil, fmt.Errorf("查询规格值失败: %w", err)
+	}
+	valuesBySpec := make(map[int32][]*model.MerStoreProductSpecValue)
+	for _, value := range existingValues {
+		valuesBySpec[value.SpecID] = append(valuesBySpec[value.SpecID], value)
+	}
+
+	keepSpecs := make(map[int32]bool)
+	keepValues := make(map[int32]bool)
+	result := make([][]*model.MerStoreProductSpecValue, 0, len(specs))
+	for i, specReq := range specs {
+		var spec *model.MerStoreProductSpec
+		for _, existing := range existingSpecs {
+			if keepSpecs[existing.SpecID] {
+				continue
+			}
+			if (specReq.SpecID != nil && existing.SpecID == *specReq.SpecID) ||
+				(specReq.SpecID == nil && existing.SpecName == specReq.Name) {
+				spec = existing
+				break
+			}
+		}
+		if specReq.SpecID != nil && spec == nil {
+			return nil, fmt.Errorf("规格组 %d 不存在", *specReq.SpecID)
+		}
+
+		if spec == nil {
+			spec = &model.MerStoreProductSpec{ProductID: productID, SpecName: specReq.Name, Sort: int32(i)}
+			if err := sp.WithContext(s.ctx).Create(spec); err != nil {
+				return nil, fmt.Errorf("创建规格组失败: %w", err)
+			}
+		} else if spec.SpecName != specReq.Name || spec.Sort != int32(i) {
+			spec.SpecName, spec.Sort = specReq.Name, int32(i)
+			if _, err := sp.WithContext(s.ctx).Where(sp.SpecID.Eq(spec.SpecID)).
+				Updates(map[string]interface{}{"spec_name": spec.SpecName, "sort": spec.Sort}); err != nil {
+				return nil, fmt.Errorf("更新规格组失败: %w", err)
+			}
+		}
+		keepSpecs[spec.SpecID] = true
+
+		values := make([]*model.MerStoreProductSpecValue, 0, len(specReq.Values))
+		for j, valueReq := range specReq.Values {
+			var value *model.MerStoreProductSpecValue
+			for _, existing := range valuesBySpec[spec.SpecID] {
+				if keepValues[existing.ValueID] {
+					continue
+				}
+				if (valueReq.ValueID != nil && existing.ValueID == *valueReq.ValueID) ||
+					(valueReq.ValueID == nil && existing.ValueName == valueReq.Name) {
+					value = existing
+					break
+				}
+			}
+			if valueReq.ValueID != nil && value == nil {
+				return nil, fmt.Errorf("规格组 %s 中不存在规格值 %d", spec.SpecName, *valueReq.ValueID)
+			}
+
+			if value == nil {
+				value = &model.MerStoreProductSpecValue{SpecID: spec.SpecID, ProductID: productID, ValueName: valueReq.Name, Sort: int32(j)}
+				if err := sv.WithContext(s.ctx).Create(value); err != nil {
+					return nil, fmt.Errorf("创建规格值失败: %w", err)
+				}
+			} else if value.ValueName != valueReq.Name || value.Sort != int32(j) {
+				value.ValueName, value.Sort = valueReq.Name, int32(j)
+				if _, err := sv.WithContext(s.ctx).Where(sv.ValueID.Eq(value.ValueID)).
+					Updates(map[string]interface{}{"value_name": value.ValueName, "sort": value.Sort}); err != nil {
+					return nil, fmt.Errorf("更新规格值失败: %w", err)
+				}
+			}
+			keepValues[value.ValueID] = true
+			values = append(values, value)
+		}
+		result = append(result, values)
+	}
+
+	for _, spec := range existingSpecs {
+		if !keepSpecs[spec.SpecID] {
+			if _, err := sp.WithContext(s.ctx).Where(sp.SpecID.Eq(spec.SpecID)).Delete(); err != nil {
+				return nil, fmt.Errorf("删除规格组失败: %w", err)
+			}
+		}
+	}
+	for _, value := range existingValues {
+		if !keepValues[value.ValueID] {
+			if _, err := sv.WithContext(s.ctx).Where(sv.ValueID.Eq(value.ValueID)).Delete(); err != nil {
+				return nil, fmt.Errorf("删除规格值失败: %w", err)
+			}
+		}
+	}
+	return result, nil
+}
+
+// skuSpec 根据 SKU 选择的规格值名称计算 spec_key 及属性名称；商品没有规格时返回 nil 和请求中的属性名称。
+// spec_key 中的规格值ID按从小到大排列，调整规格组顺序不改变 spec_key
+func skuSpec(specs [][]*model.MerStoreProductSpecValue, req *CreateProductSkuReq) (*string, *string) {
+	if len(specs) == 0 {
+		return nil, req.AttrName
+	}
+	ids := make([]int, 0, len(specs))
+	for i, values := range specs {
+		for _, value := range values {
+			if value.ValueName == req.Specs[i] {
+				ids = append(ids, int(value.ValueID))
+				break
+			}
+		}
+	}
+	key := joinSpecKey(ids)
+	attrName := strings.Join(req.Specs, "/")
+	return &key, &attrName
+}
+
+// joinSpecKey 规格值ID排序后以逗号连接
+func joinSpecKey(ids []int) string {
+	sort.Ints(ids)
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = strconv.Itoa(id)
+	}
+	return strings.Join(parts, ",")
+}
+
+// normalizeSpecKey 把已保存的 spec_key 转换为排序后的形式，兼容之前按规格组顺序保存的数据
+func normalizeSpecKey(key string) string {
+	ids := make([]int, 0, maxProductSpecs)
+	for _, part := range strings.Split(key, ",") {
+		id, err := strconv.Atoi(part)
+		if err != nil {
+			return key
+		}
+		ids = append(ids, id)
+	}
+	return joinSpecKey(ids)
+}
+
+// loadProductSpecs 批量查询商品的规格组及规格值，按顺序排列
+func (s *StoreProductService) loadProductSpecs(q *dao.Query, productIDs []int32) (map[int32][]*ProductSpec, error) {
+	result := make(map[int32][]*ProductSpec)
+	if len(productIDs) == 0 {
+		return result, nil
+	}
+
+	sp := q.MerStoreProductSpec
+	specs, err := sp.WithContext(s.ctx).Where(sp.ProductID.In(productIDs...)).Order(sp.Sort, sp.SpecID).Find()
+	if err != nil {
+		return nil, fmt.Errorf("查询规格组失败: %w", err)
+	}
+	if len(specs) == 0 {
+		return result, nil
+	}
+
+	sv := q.MerStoreProductSpecValue
+	values, err := sv.WithContext(s.ctx).Where(sv.ProductID.In(productIDs...)).Order(sv.Sort, sv.ValueID).Find()
+	if err != nil {
+		return nil, fmt.Errorf("查询规格值失败: %w", err)
+	}
+	valuesBySpec := make(map[int32][]*model.MerStoreProductSpecValue)
+	for _, value := range values {
+		valuesBySpec[value.SpecID] = append(valuesBySpec[value.SpecID], value)
+	}
+
+	for _, spec := range specs {
+		specValues := valuesBySpec[spec.SpecID]
+		if specValues == nil {
+			specValues = []*model.MerStoreProductSpecValue{}
+		}
+		result[spec.ProductID] = append(result[spec.ProductID], &ProductSpec{MerStoreProductSpec: spec, Values: specValues})
+	}
+	return result, nil
+}
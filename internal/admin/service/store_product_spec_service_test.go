@@ -0,0 +1,152 @@
+package service
+
+import (
+	"merchant_api/internal/model"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestSkuSpecKeyIgnoresGroupOrder(t *testing.T) {
+	color := []*model.MerStoreProductSpecValue{{ValueID: 34, ValueName: "红"}, {ValueID: 35, ValueName: "蓝"}}
+	size := []*model.MerStoreProductSpecValue{{ValueID: 12, ValueName: "S"}, {ValueID: 13, ValueName: "M"}}
+
+	tests := []struct {
+		name     string
+		specs    [][]*model.MerStoreProductSpecValue
+		selected []string
+		wantKey  string
+		wantAttr string
+	}{
+		{"颜色在前", [][]*model.MerStoreProductSpecValue{color, size}, []string{"红", "S"}, "12,34", "红/S"},
+		{"尺码在前", [][]*model.MerStoreProductSpecValue{size, color}, []string{"S", "红"}, "12,34", "S/红"},
+		{"单个规格组", [][]*model.MerStoreProductSpecValue{size}, []string{"M"}, "13", "M"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, attr := skuSpec(tt.specs, &CreateProductSkuReq{Specs: tt.selected})
+			if key == nil || *key != tt.wantKey {
+				t.Errorf("spec_key = %v, want %s", key, tt.wantKey)
+			}
+			if attr == nil || *attr != tt.wantAttr {
+				t.Errorf("attr_name = %v, want %s", attr, tt.wantAttr)
+			}
+		})
+	}
+
+	attr := "自定义"
+	if key, got := skuSpec(nil, &CreateProductSkuReq{AttrName: &attr}); key != nil || got != &attr {
+		t.Errorf("没有规格时 skuSpec = %v, %v, want nil, attr_name", key, got)
+	}
+}
+
+func TestNormalizeSpecKey(t *testing.T) {
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{"12,34", "12,34"},
+		{"34,12", "12,34"},
+		{"9,100,23", "9,23,100"},
+		{"7", "7"},
+		{"a,1", "a,1"}, // 无法解析时原样返回
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			if got := normalizeSpecKey(tt.key); got != tt.want {
+				t.Errorf("normalizeSpecKey(%q) = %q, want %q", tt.key, got, tt.want)
+			}
+		})
+	}
+}
+
+// specReq 规格组请求，values 为规格值名称
+func specReq(name string, values ...string) ProductSpecReq {
+	spec := ProductSpecReq{Name: name}
+	for _, value := range values {
+		spec.Values = append(spec.Values, ProductSpecValueReq{Name: value})
+	}
+	return spec
+}
+
+func TestBuildSpecMatrix(t *testing.T) {
+	manyValues := make([]string, maxProductSpecValues+1)
+	for i := range manyValues {
+		manyValues[i] = strconv.Itoa(i)
+	}
+	tenValues := manyValues[:10]
+
+	tests := []struct {
+		name    string
+		specs   []ProductSpecReq
+		want    []string // 各组合的 attr_name
+		wantErr bool
+	}{
+		{"单个规格组", []ProductSpecReq{specReq("颜色", "红", "蓝")}, []string{"红", "蓝"}, false},
+		{"按规格组顺序组合", []ProductSpecReq{specReq("颜色", "红", "蓝"), specReq("尺码", "S", "M")}, []string{"红/S", "红/M", "蓝/S", "蓝/M"}, false},
+		{"去掉首尾空格", []ProductSpecReq{specReq(" 颜色 ", " 红 ")}, []string{"红"}, false},
+		{"规格组过多", []ProductSpecReq{specReq("a", "1"), specReq("b", "1"), specReq("c", "1"), specReq("d", "1")}, nil, true},
+		{"规格组重复", []ProductSpecReq{specReq("颜色", "红"), specReq("颜色 ", "蓝")}, nil, true},
+		{"规格组名称为空", []ProductSpecReq{specReq(" ", "红")}, nil, true},
+		{"没有规格值", []ProductSpecReq{specReq("颜色")}, nil, true},
+		{"规格值重复", []ProductSpecReq{specReq("颜色", "红", " 红")}, nil, true},
+		{"规格值为空", []ProductSpecReq{specReq("颜色", "")}, nil, true},
+		{"规格值过多", []ProductSpecReq{specReq("颜色", manyValues...)}, nil, true},
+		{"组合过多", []ProductSpecReq{specReq("a", tenValues...), specReq("b", tenValues...), specReq("c", tenValues[:3]...)}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildSpecMatrix(tt.specs)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("buildSpecMatrix() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			names := make([]string, len(got))
+			for i, combination := range got {
+				names[i] = combination.AttrName
+				if len(combination.Specs) != len(tt.specs) {
+					t.Errorf("combination %s has %d specs, want %d", combination.AttrName, len(combination.Specs), len(tt.specs))
+				}
+			}
+			if !reflect.DeepEqual(names, tt.want) {
+				t.Errorf("buildSpecMatrix() = %v, want %v", names, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateProductSkus(t *testing.T) {
+	price := 10.0
+	sku := func(specs ...string) CreateProductSkuReq {
+		return CreateProductSkuReq{Specs: specs, Price: &price}
+	}
+	stock := func(n int32) *int32 { return &n }
+	colorSize := []ProductSpecReq{specReq("颜色", "红", "蓝"), specReq("尺码", "S")}
+
+	tests := []struct {
+		name    string
+		req     *CreateProductRequest
+		wantErr bool
+	}{
+		{"没有规格", &CreateProductRequest{Skus: []CreateProductSkuReq{sku()}}, false},
+		{"没有规格时选择规格值", &CreateProductRequest{Skus: []CreateProductSkuReq{sku("红")}}, true},
+		{"覆盖全部组合", &CreateProductRequest{Specs: colorSize, Skus: []CreateProductSkuReq{sku("蓝", "S"), sku("红", "S")}}, false},
+		{"规格值名称去掉空格后匹配", &CreateProductRequest{Specs: colorSize, Skus: []CreateProductSkuReq{sku(" 红", "S "), sku("蓝", "S")}}, false},
+		{"缺少组合", &CreateProductRequest{Specs: colorSize, Skus: []CreateProductSkuReq{sku("红", "S")}}, true},
+		{"组合重复", &CreateProductRequest{Specs: colorSize, Skus: []CreateProductSkuReq{sku("红", "S"), sku("红", "S"), sku("蓝", "S")}}, true},
+		{"组合不存在", &CreateProductRequest{Specs: colorSize, Skus: []CreateProductSkuReq{sku("红", "S"), sku("绿", "S")}}, true},
+		{"规格值顺序错误", &CreateProductRequest{Specs: colorSize, Skus: []CreateProductSkuReq{sku("S", "红"), sku("蓝", "S")}}, true},
+		{"规格值数量不对", &CreateProductRequest{Specs: colorSize, Skus: []CreateProductSkuReq{sku("红"), sku("蓝", "S")}}, true},
+		{"库存为负数", &CreateProductRequest{Skus: []CreateProductSkuReq{{Price: &price, Stock: stock(-1)}}}, true},
+		{"预警值为负数", &CreateProductRequest{Skus: []CreateProductSkuReq{{Price: &price, LowStock: stock(-1)}}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateProductSkus(tt.req); (err != nil) != tt.wantErr {
+				t.Errorf("validateProductSkus() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,542 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"merchant_api/internal/dao"
+	"merchant_api/internal/model"
+	"merchant_api/internal/pkg/totp"
+	"merchant_api/internal/pkg/utils"
+	"merchant_api/pkg/config"
+	"merchant_api/pkg/database"
+	"merchant_api/pkg/redis"
+	"strings"
+	"time"
+
+	redisv8 "github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Redis 键
+const (
+	adminTwoFactorChallengeKeyFmt = "admin:2fa_challenge:%s"          // 登录验证挑战 -> twoFactorChallenge
+	adminTwoFactorAttemptsKeyFmt  = "admin:2fa_challenge:%s:attempts" // 登录验证挑战的失败次数
+)
+
+const (
+	twoFactorSkew        = 1  // 允许前后各一个时间步的误差
+	twoFactorMaxAttempts = 5  // 单个验证挑战最多失败次数
+	recoveryCodeCount    = 10 // 恢复码数量
+)
+
+var ErrTwoFactorCodeInvalid = errors.New("验证码错误")
+
+// twoFactorChallenge 登录第一步通过后保存的验证挑战
+type twoFactorChallenge struct {
+	AdminID int32      `json:"admin_id"`
+	Enroll  bool       `json:"enroll"` // 尚未绑定，需要先绑定
+	Client  ClientInfo `json:"client"`
+}
+
+// TwoFactorSetupResponse 绑定信息，uri 用于生成二维码
+type TwoFactorSetupResponse struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
+}
+
+// TwoFactorStatus 两步验证状态
+type TwoFactorStatus struct {
+	Enabled           bool `json:"enabled"`
+	Required          bool `json:"required"` // 商户是否要求启用
+	RecoveryCodesLeft int  `json:"recovery_codes_left"`
+}
+
+type TwoFactorService struct {
+	ctx context.Context
+}
+
+func NewTwoFactorService(ctx context.Context) *TwoFactorService {
+	dao.SetDefault(database.GetDB())
+	return &TwoFactorService{ctx: ctx}
+}
+
+// BeginLogin 密码验证通过后判断是否需要两步验证，需要时返回验证挑战，否则返回 nil
+func (s *TwoFactorService) BeginLogin(admin *model.MerMerchantAdmin, client *ClientInfo) (*LoginResponse, error) {
+	record, err := s.load(admin.MerchantAdminID)
+	if err != nil {
+		return nil, err
+	}
+	enabled := record != nil && record.Enabled == 1
+	if !enabled {
+		required, err := s.merchantRequires(admin.MerID)
+		if err != nil {
+			return nil, err
+		}
+		if !required {
+			return nil, nil
+		}
+	}
+
+	token, err := utils.RandomToken(32)
+	if err != nil {
+		return nil, fmt.Errorf("生成验证挑战失败: %w", err)
+	}
+	data, err := json.Marshal(twoFactorChallenge{
+		AdminID: admin.MerchantAdminID,
+		Enroll:  !enabled,
+		Client:  *client,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("生成验证挑战失败: %w", err)
+	}
+
+	ttl := time.Duration(config.GlobalConfig.TwoFactor.ChallengeExpire) * time.Second
+	if err := redis.GetRedis().Set(s.ctx, fmt.Sprintf(adminTwoFactorChallengeKeyFmt, token), data, ttl).Err(); err != nil {
+		return nil, fmt.Errorf("存储验证挑战失败: %w", err)
+	}
+
+	return &LoginResponse{
+		TwoFactorRequired: true,
+		TwoFactorEnroll:   !enabled,
+		ChallengeToken:    token,
+	}, nil
+}
+
+// EnrollChallenge 商户要求两步验证但尚未绑定时，凭验证挑战获取绑定信息
+func (s *TwoFactorService) EnrollChallenge(token, ip string) (*TwoFactorSetupResponse, error) {
+	challenge, err := s.loadChallenge(token, ip)
+	if err != nil {
+		return nil, err
+	}
+	if !challenge.Enroll {
+		return nil, errors.New("已启用两步验证，无需重新绑定")
+	}
+
+	admin, err := s.loadAdmin(challenge.AdminID)
+	if err != nil {
+		return nil, err
+	}
+	return s.setup(admin)
+}
+
+// VerifyLogin 校验验证挑战和验证码（或恢复码），通过后签发令牌完成登录
+// 需要绑定时，验证码用于确认绑定，登录成功后返回恢复码
+func (s *TwoFactorService) VerifyLogin(token, code, ip string) (*LoginResponse, error) {
+	challenge, err := s.loadChallenge(token, ip)
+	if err != nil {
+		return nil, err
+	}
+
+	admin, err := s.loadAdmin(challenge.AdminID)
+	if err != nil {
+		return nil, err
+	}
+
+	security := NewLoginSecurityService(s.ctx)
+	if err := security.CheckLocked(admin.Account, admin, ip); err != nil {
+		return nil, err
+	}
+
+	var recoveryCodes []string
+	if challenge.Enroll {
+		recoveryCodes, err = s.Enable(admin.MerchantAdminID, code)
+	} else {
+		err = s.verifyCode(admin.MerchantAdminID, code)
+	}
+	if err != nil {
+		if !errors.Is(err, ErrTwoFactorCodeInvalid) {
+			return nil, err
+		}
+		return nil, s.challengeFailed(security, token, admin, ip)
+	}
+
+	// 验证挑战只能使用一次
+	deleted, err := redis.GetRedis().Del(s.ctx,
+		fmt.Sprintf(adminTwoFactorChallengeKeyFmt, token),
+		fmt.Sprintf(adminTwoFactorAttemptsKeyFmt, token),
+	).Result()
+	if err != nil {
+		return nil, fmt.Errorf("验证失败: %w", err)
+	}
+	if deleted == 0 {
+		return nil, errors.New("验证已失效，请重新登录")
+	}
+
+	resp, err := NewAdminAuthService(s.ctx).completeLogin(admin, &challenge.Client)
+	if err != nil {
+		return nil, err
+	}
+	resp.RecoveryCodes = recoveryCodes
+	return resp, nil
+}
+
+// challengeFailed 记录验证码错误：计入登录失败次数，同一验证挑战失败过多后失效
+func (s *TwoFactorService) challengeFailed(security *LoginSecurityService, token string, admin *model.MerMerchantAdmin, ip string) error {
+	rdb := redis.GetRedis()
+	attemptsKey := fmt.Sprintf(adminTwoFactorAttemptsKeyFmt, token)
+	attempts, err := rdb.Incr(s.ctx, attemptsKey).Result()
+	if err == nil {
+		rdb.Expire(s.ctx, attemptsKey, time.Duration(config.GlobalConfig.TwoFactor.ChallengeExpire)*time.Second)
+		if attempts >= twoFactorMaxAttempts {
+			rdb.Del(s.ctx, fmt.Sprintf(adminTwoFactorChallengeKeyFmt, token), attemptsKey)
+		}
+	}
+
+	if err := security.RecordFailure(admin.Account, admin, ip); err != nil {
+		var locked *LoginLockedError
+		if errors.As(err, &locked) {
+			rdb.Del(s.ctx, fmt.Sprintf(adminTwoFactorChallengeKeyFmt, token), attemptsKey)
+			return err
+		}
+		fmt.Printf("记录登录失败失败: %v\n", err)
+	}
+	return ErrTwoFactorCodeInvalid
+}
+
+// loadChallenge 读取验证挑战，只能在登录时的 IP 使用
+func (s *TwoFactorService) loadChallenge(token, ip string) (*twoFactorChallenge, error) {
+	raw, err := redis.GetRedis().Get(s.ctx, fmt.Sprintf(adminTwoFactorChallengeKeyFmt, token)).Result()
+	if err != nil {
+		if err == redisv8.Nil {
+			return nil, errors.New("验证已失效，请重新登录")
+		}
+		return nil, fmt.Errorf("查询验证挑战失败: %w", err)
+	}
+
+	var challenge twoFactorChallenge
+	if err := json.Unmarshal([]byte(raw), &challenge); err != nil {
+		return nil, errors.New("验证挑战数据格式错误")
+	}
+	if challenge.Client.IP != ip {
+		return nil, errors.New("验证已失效，请重新登录")
+	}
+	return &challenge, nil
+}
+
+// Status 获取两步验证状态
+func (s *TwoFactorService) Status(adminID int32, merID int32) (*TwoFactorStatus, error) {
+	record, err := s.load(adminID)
+	if err != nil {
+		return nil, err
+	}
+	required, err := s.merchantRequires(merID)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &TwoFactorStatus{Required: required}
+	if record != nil && record.Enabled == 1 {
+		status.Enabled = true
+		status.RecoveryCodesLeft = len(parseRecoveryCodes(record.RecoveryCodes))
+	}
+	return status, nil
+}
+
+// Setup 生成新的密钥，验证码确认后才会启用
+func (s *TwoFactorService) Setup(adminID int32) (*TwoFactorSetupResponse, error) {
+	admin, err := s.loadAdmin(adminID)
+	if err != nil {
+		return nil, err
+	}
+	return s.setup(admin)
+}
+
+func (s *TwoFactorService) setup(admin *model.MerMerchantAdmin) (*TwoFactorSetupResponse, error) {
+	record, err := s.load(admin.MerchantAdminID)
+	if err != nil {
+		return nil, err
+	}
+	if record != nil && record.Enabled == 1 {
+		return nil, errors.New("已启用两步验证，如需更换请先关闭")
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, fmt.Errorf("生成密钥失败: %w", err)
+	}
+
+	err = dao.MerMerchantAdminTotp.WithContext(s.ctx).
+		Clauses(clause.OnConflict{UpdateAll: true}).
+		Create(&model.MerMerchantAdminTotp{
+			MerchantAdminID: admin.MerchantAdminID,
+			Secret:          secret,
+			CreateAt:        time.Now(),
+		})
+	if err != nil {
+		return nil, fmt.Errorf("保存密钥失败: %w", err)
+	}
+
+	return &TwoFactorSetupResponse{
+		Secret: secret,
+		URI:    totp.ProvisioningURI(config.GlobalConfig.TwoFactor.Issuer, admin.Account, secret),
+	}, nil
+}
+
+// Enable 使用验证码确认绑定并启用，返回恢复码（只返回一次）
+func (s *TwoFactorService) Enable(adminID int32, code string) ([]string, error) {
+	record, err := s.load(adminID)
+	if err != nil {
+		return nil, err
+	}
+	if record == nil {
+		return nil, errors.New("请先获取绑定信息")
+	}
+	if record.Enabled == 1 {
+		return nil, errors.New("已启用两步验证")
+	}
+
+	counter, ok := totp.Validate(record.Secret, code, time.Now(), twoFactorSkew)
+	if !ok {
+		return nil, ErrTwoFactorCodeInvalid
+	}
+
+	codes, hashed, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	t := dao.MerMerchantAdminTotp
+	info, err := t.WithContext(s.ctx).
+		Where(t.MerchantAdminID.Eq(adminID), t.Enabled.Eq(0)).
+		Updates(map[string]interface{}{
+			"enabled":        1,
+			"last_counter":   counter,
+			"recovery_codes": hashed,
+			"enable_at":      now,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("启用两步验证失败: %w", err)
+	}
+	if info.RowsAffected == 0 {
+		return nil, errors.New("已启用两步验证")
+	}
+
+	// 登录时绑定的请求尚未登录，操作人从账号信息中获取
+	if admin, err := s.loadAdmin(adminID); err == nil {
+		NewAuditService(s.ctx).Log(&AuditEntry{
+			MerID:      admin.MerID,
+			AdminID:    admin.MerchantAdminID,
+			Account:    admin.Account,
+			Action:     AuditActionTwoFactorOn,
+			EntityType: AuditEntityAdmin,
+			EntityID:   int64(adminID),
+		})
+	}
+	return codes, nil
+}
+
+// Disable 关闭两步验证，需验证密码和验证码；商户要求启用时不能关闭
+func (s *TwoFactorService) Disable(adminID int32, merID int32, password, code string) error {
+	required, err := s.merchantRequires(merID)
+	if err != nil {
+		return err
+	}
+	if required {
+		return errors.New("商户要求启用两步验证，不能关闭")
+	}
+
+	admin, err := s.loadAdmin(adminID)
+	if err != nil {
+		return err
+	}
+	if !utils.CheckPassword(password, admin.Pwd) {
+		return errors.New("密码错误")
+	}
+	if err := s.verifyCode(adminID, code); err != nil {
+		return err
+	}
+
+	if err := s.remove(adminID); err != nil {
+		return err
+	}
+
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		Action:     AuditActionTwoFactorOff,
+		EntityType: AuditEntityAdmin,
+		EntityID:   int64(adminID),
+	})
+	return nil
+}
+
+// RegenerateRecoveryCodes 重新生成恢复码，原恢复码全部失效
+func (s *TwoFactorService) RegenerateRecoveryCodes(adminID int32, code string) ([]string, error) {
+	if err := s.verifyCode(adminID, code); err != nil {
+		return nil, err
+	}
+
+	codes, hashed, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	t := dao.MerMerchantAdminTotp
+	if _, err := t.WithContext(s.ctx).
+		Where(t.MerchantAdminID.Eq(adminID)).
+		Update(t.RecoveryCodes, hashed); err != nil {
+		return nil, fmt.Errorf("生成恢复码失败: %w", err)
+	}
+	return codes, nil
+}
+
+// Reset 重置子账号的两步验证（丢失设备时由管理员操作），下次登录需要重新绑定
+func (s *TwoFactorService) Reset(callerID, adminID int32, merID int32) error {
+	if callerID == adminID {
+		return errors.New("不能重置自己的两步验证")
+	}
+	if _, err := NewAdminService(s.ctx).loadEditable(callerID, adminID, merID); err != nil {
+		return err
+	}
+	if err := s.remove(adminID); err != nil {
+		return err
+	}
+
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		Action:     AuditActionTwoFactorReset,
+		EntityType: AuditEntityAdmin,
+		EntityID:   int64(adminID),
+	})
+	return nil
+}
+
+// verifyCode 校验已启用的两步验证：6 位验证码按 TOTP 校验（拒绝重放），其他按恢复码校验（用后作废）
+func (s *TwoFactorService) verifyCode(adminID int32, code string) error {
+	record, err := s.load(adminID)
+	if err != nil {
+		return err
+	}
+	if record == nil || record.Enabled != 1 {
+		return errors.New("未启用两步验证")
+	}
+
+	t := dao.MerMerchantAdminTotp
+	code = strings.TrimSpace(code)
+	if len(code) == totp.Digits {
+		counter, ok := totp.Validate(record.Secret, code, time.Now(), twoFactorSkew)
+		if !ok {
+			return ErrTwoFactorCodeInvalid
+		}
+		info, err := t.WithContext(s.ctx).
+			Where(t.MerchantAdminID.Eq(adminID), t.LastCounter.Lt(counter)).
+			Update(t.LastCounter, counter)
+		if err != nil {
+			return fmt.Errorf("验证失败: %w", err)
+		}
+		if info.RowsAffected == 0 {
+			return ErrTwoFactorCodeInvalid
+		}
+		return nil
+	}
+
+	hashes := parseRecoveryCodes(record.RecoveryCodes)
+	normalized := normalizeRecoveryCode(code)
+	for i, hash := range hashes {
+		if !utils.CheckPassword(normalized, hash) {
+			continue
+		}
+		remaining := append(hashes[:i:i], hashes[i+1:]...)
+		data, err := json.Marshal(remaining)
+		if err != nil {
+			return fmt.Errorf("验证失败: %w", err)
+		}
+		info, err := t.WithContext(s.ctx).
+			Where(t.MerchantAdminID.Eq(adminID), t.RecoveryCodes.Eq(*record.RecoveryCodes)).
+			Update(t.RecoveryCodes, string(data))
+		if err != nil {
+			return fmt.Errorf("验证失败: %w", err)
+		}
+		if info.RowsAffected == 0 {
+			return ErrTwoFactorCodeInvalid
+		}
+		return nil
+	}
+	return ErrTwoFactorCodeInvalid
+}
+
+// remove 删除两步验证绑定
+func (s *TwoFactorService) remove(adminID int32) error {
+	t := dao.MerMerchantAdminTotp
+	if _, err := t.WithContext(s.ctx).Where(t.MerchantAdminID.Eq(adminID)).Delete(); err != nil {
+		return fmt.Errorf("关闭两步验证失败: %w", err)
+	}
+	return nil
+}
+
+// load 查询两步验证绑定记录，不存在时返回 nil
+func (s *TwoFactorService) load(adminID int32) (*model.MerMerchantAdminTotp, error) {
+	t := dao.MerMerchantAdminTotp
+	record, err := t.WithContext(s.ctx).Where(t.MerchantAdminID.Eq(adminID)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("查询两步验证失败: %w", err)
+	}
+	return record, nil
+}
+
+// loadAdmin 查询可用的管理员
+func (s *TwoFactorService) loadAdmin(adminID int32) (*model.MerMerchantAdmin, error) {
+	a := dao.MerMerchantAdmin
+	admin, err := a.WithContext(s.ctx).
+		Where(a.MerchantAdminID.Eq(adminID), a.IsDel.Eq(0)).
+		First()
+	if err != nil || admin.Status != 1 {
+		return nil, errors.New("账号不存在或已被禁用")
+	}
+	return admin, nil
+}
+
+// merchantRequires 商户是否要求所有管理员启用两步验证
+func (s *TwoFactorService) merchantRequires(merID int32) (bool, error) {
+	setting, err := NewMerchantSecurityService(s.ctx).Get(merID)
+	if err != nil {
+		return false, err
+	}
+	return setting.RequireTwoFactor == 1, nil
+}
+
+// generateRecoveryCodes 生成恢复码，返回明文和哈希后的 JSON
+func generateRecoveryCodes() ([]string, string, error) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		raw, err := utils.RandomToken(5)
+		if err != nil {
+			return nil, "", fmt.Errorf("生成恢复码失败: %w", err)
+		}
+		codes[i] = raw[:5] + "-" + raw[5:]
+		hashes[i], err = utils.HashPassword(raw)
+		if err != nil {
+			return nil, "", fmt.Errorf("生成恢复码失败: %w", err)
+		}
+	}
+
+	data, err := json.Marshal(hashes)
+	if err != nil {
+		return nil, "", fmt.Errorf("生成恢复码失败: %w", err)
+	}
+	return codes, string(data), nil
+}
+
+// parseRecoveryCodes 解析恢复码哈希列表
+func parseRecoveryCodes(raw *string) []string {
+	if raw == nil || *raw == "" {
+		return nil
+	}
+	var hashes []string
+	if err := json.Unmarshal([]byte(*raw), &hashes); err != nil {
+		return nil
+	}
+	return hashes
+}
+
+// normalizeRecoveryCode 恢复码忽略大小写和分隔符
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	code = strings.ReplaceAll(code, "-", "")
+	return strings.ReplaceAll(code, " ", "")
+}
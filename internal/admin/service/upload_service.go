@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"merchant_api/pkg/config"
 	"mime/multipart"
 	"os"
 	"path/filepath"
@@ -13,6 +14,12 @@ import (
 	"github.com/google/uuid"
 )
 
+// uploadImageDir 图片上传目录，通过静态文件服务以 /uploads/images 访问
+const uploadImageDir = "uploads/images"
+
+// uploadDocumentDir 资质文件上传目录，不通过静态文件服务公开，只能由平台运营下载
+const uploadDocumentDir = "storage/documents"
+
 type UploadService struct {
 	ctx context.Context
 }
@@ -42,18 +49,53 @@ func (s *UploadService) UploadImage(file *multipart.FileHeader) (string, error)
 		return "", fmt.Errorf("文件大小超过限制 (5MB)")
 	}
 
-	// 3. Generate unique filename
+	return s.save(file, uploadImageDir, ext)
+}
+
+// UploadDocument 上传资质文件（图片或 PDF），返回相对 storage/documents 的路径
+func (s *UploadService) UploadDocument(file *multipart.FileHeader) (string, error) {
+	ext := strings.ToLower(filepath.Ext(file.Filename))
+	allowedExts := map[string]bool{
+		".jpg":  true,
+		".jpeg": true,
+		".png":  true,
+		".pdf":  true,
+	}
+	if !allowedExts[ext] {
+		return "", fmt.Errorf("不支持的文件类型: %s", ext)
+	}
+	if file.Size > 10*1024*1024 {
+		return "", fmt.Errorf("文件大小超过限制 (10MB)")
+	}
+
+	dst, err := s.save(file, uploadDocumentDir, ext)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimPrefix(dst, "/"+uploadDocumentDir+"/"), nil
+}
+
+// DocumentFile 返回资质文件在磁盘上的路径，路径不在上传目录内或文件不存在时返回错误
+func (s *UploadService) DocumentFile(path string) (string, error) {
+	file := filepath.Join(uploadDocumentDir, filepath.Clean("/"+path))
+	info, err := os.Stat(file)
+	if err != nil || !info.Mode().IsRegular() {
+		return "", fmt.Errorf("文件不存在: %s", path)
+	}
+	return file, nil
+}
+
+// save 按日期分目录保存上传文件，文件名使用 UUID，返回以 / 开头的相对路径
+func (s *UploadService) save(file *multipart.FileHeader, baseDir, ext string) (string, error) {
 	filename := uuid.New().String() + ext
 
-	// 4. Create upload directory if not exists
 	// Organize by date to avoid too many files in one directory
 	dateDir := time.Now().Format("20060102")
-	uploadDir := filepath.Join("uploads", "images", dateDir)
+	uploadDir := filepath.Join(baseDir, dateDir)
 	if err := os.MkdirAll(uploadDir, 0755); err != nil {
 		return "", fmt.Errorf("创建上传目录失败: %v", err)
 	}
 
-	// 5. Save file
 	dst := filepath.Join(uploadDir, filename)
 	src, err := file.Open()
 	if err != nil {
@@ -71,7 +113,24 @@ func (s *UploadService) UploadImage(file *multipart.FileHeader) (string, error)
 		return "", fmt.Errorf("保存文件失败: %v", err)
 	}
 
-	// 6. Return relative path or URL
-	// Assuming the static file server will serve from /uploads
 	return "/" + dst, nil
 }
+
+// IsUploadedImage 判断图片地址是否为上传接口返回的地址（相对路径或带域名的完整地址）且文件存在
+func (s *UploadService) IsUploadedImage(url string) bool {
+	path := url
+	if domain := config.GlobalConfig.Server.Admin.Domain; domain != "" {
+		path = strings.TrimPrefix(path, domain)
+	}
+	if !strings.HasPrefix(path, "/"+uploadImageDir+"/") {
+		return false
+	}
+
+	// 清理路径，防止通过 ../ 指向上传目录之外的文件
+	file := filepath.Clean(strings.TrimPrefix(path, "/"))
+	if !strings.HasPrefix(file, filepath.Clean(uploadImageDir)+string(filepath.Separator)) {
+		return false
+	}
+	info, err := os.Stat(file)
+	return err == nil && info.Mode().IsRegular()
+}
@@ -0,0 +1,441 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"merchant_api/internal/dao"
+	"merchant_api/internal/model"
+	"merchant_api/internal/pkg/payout"
+	"merchant_api/pkg/config"
+	"merchant_api/pkg/database"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// 提现状态
+const (
+	WithdrawalPending  = "pending"  // 待审核（资金已冻结）
+	WithdrawalApproved = "approved" // 已审核，已提交出款渠道
+	WithdrawalPaid     = "paid"     // 已到账
+	WithdrawalFailed   = "failed"   // 出款失败，资金已退回
+	WithdrawalRejected = "rejected" // 已驳回，资金已退回
+	WithdrawalReversed = "reversed" // 到账后被退回，资金已退回
+)
+
+// withdrawalTransitions 允许的状态变更
+var withdrawalTransitions = map[string][]string{
+	WithdrawalPending:  {WithdrawalApproved, WithdrawalRejected},
+	WithdrawalApproved: {WithdrawalPaid, WithdrawalFailed},
+	WithdrawalPaid:     {WithdrawalReversed},
+}
+
+// withdrawalRefType 提现在资金流水中的关联单据类型
+const withdrawalRefType = "withdrawal"
+
+// ErrWithdrawalStateChanged 提现状态已被其他请求修改
+var ErrWithdrawalStateChanged = errors.New("提现状态已变更，请刷新后重试")
+
+// WithdrawalRequest 申请提现请求
+type WithdrawalRequest struct {
+	Amount    float64 `json:"amount" binding:"required,gt=0"`
+	AccountID int32   `json:"account_id" binding:"required"` // 收款账户ID
+	Remark    string  `json:"remark" binding:"max=255"`
+}
+
+// WithdrawalListRequest 提现记录查询条件
+type WithdrawalListRequest struct {
+	Page      int    `form:"page,default=1"`
+	PageSize  int    `form:"page_size,default=20" binding:"max=100"`
+	MerID     int32  `form:"mer_id"` // 平台运营查询时按商户筛选
+	Status    string `form:"status" binding:"omitempty,oneof=pending approved paid failed rejected reversed"`
+	StartTime string `form:"start_time"` // 格式 2006-01-02 15:04:05
+	EndTime   string `form:"end_time"`
+}
+
+type WithdrawalService struct {
+	ctx context.Context
+}
+
+func NewWithdrawalService(ctx context.Context) *WithdrawalService {
+	dao.SetDefault(database.GetDB())
+	return &WithdrawalService{ctx: ctx}
+}
+
+// Create 申请提现，提现金额从可用余额转入冻结金额，保存收款账户的快照
+func (s *WithdrawalService) Create(callerID int32, merID int32, req *WithdrawalRequest) (*model.MerMerchantWithdrawal, error) {
+	amount := toCents(req.Amount)
+	if math.Abs(req.Amount*100-float64(amount)) > 1e-6 {
+		return nil, errors.New("提现金额最多两位小数")
+	}
+	if minAmount := config.GlobalConfig.Payout.MinAmount; req.Amount < minAmount {
+		return nil, fmt.Errorf("单笔提现金额不能低于 %.2f", minAmount)
+	}
+
+	account, err := NewPayoutAccountService(s.ctx).load(merID, req.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	withdrawal := &model.MerMerchantWithdrawal{
+		PayoutNo:      strings.ReplaceAll(uuid.New().String(), "-", ""),
+		MerID:         merID,
+		Amount:        fromCents(amount),
+		Method:        account.AccountType,
+		AccountID:     account.AccountID,
+		HolderName:    account.HolderName,
+		BankName:      account.BankName,
+		BankCode:      account.BankCode,
+		AccountNo:     account.AccountNo,
+		Chain:         account.Chain,
+		WalletAddress: account.WalletAddress,
+		Status:        WithdrawalPending,
+		Remark:        strings.TrimSpace(req.Remark),
+		RequestedBy:   callerID,
+		CreateAt:      now,
+		UpdateAt:      now,
+	}
+
+	err = dao.Q.Transaction(func(tx *dao.Query) error {
+		if err := tx.MerMerchantWithdrawal.WithContext(s.ctx).Create(withdrawal); err != nil {
+			return fmt.Errorf("创建提现申请失败: %w", err)
+		}
+		_, err := NewLedgerService(s.ctx).PostTx(tx, withdrawalLedger(withdrawal, WithdrawalPending, callerID))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	NewAuditService(s.ctx).Log(&AuditEntry{
+		Action:     AuditActionWithdrawalCreate,
+		EntityType: AuditEntityWithdrawal,
+		EntityID:   int64(withdrawal.WithdrawalID),
+		After:      withdrawal,
+	})
+	return withdrawal, nil
+}
+
+// List 查询提现记录，merID 为 0 时查询所有商户（平台运营）
+func (s *WithdrawalService) List(merID int32, req *WithdrawalListRequest) ([]*model.MerMerchantWithdrawal, int64, error) {
+	w := dao.MerMerchantWithdrawal
+	query := w.WithContext(s.ctx)
+
+	if merID == 0 {
+		merID = req.MerID
+	}
+	if merID > 0 {
+		query = query.Where(w.MerID.Eq(merID))
+	}
+	if req.Status != "" {
+		query = query.Where(w.Status.Eq(req.Status))
+	}
+	if req.StartTime != "" {
+		start, err := time.ParseInLocation(time.DateTime, req.StartTime, time.Local)
+		if err != nil {
+			return nil, 0, fmt.Errorf("开始时间格式错误: %w", err)
+		}
+		query = query.Where(w.CreateAt.Gte(start))
+	}
+	if req.EndTime != "" {
+		end, err := time.ParseInLocation(time.DateTime, req.EndTime, time.Local)
+		if err != nil {
+			return nil, 0, fmt.Errorf("结束时间格式错误: %w", err)
+		}
+		query = query.Where(w.CreateAt.Lte(end))
+	}
+
+	total, err := query.Count()
+	if err != nil {
+		return nil, 0, fmt.Errorf("查询提现记录总数失败: %w", err)
+	}
+
+	list, err := query.
+		Order(w.WithdrawalID.Desc()).
+		Limit(req.PageSize).
+		Offset((req.Page - 1) * req.PageSize).
+		Find()
+	if err != nil {
+		return nil, 0, fmt.Errorf("查询提现记录失败: %w", err)
+	}
+	return list, total, nil
+}
+
+// Get 获取提现详情，merID 为 0 时不限商户（平台运营）
+func (s *WithdrawalService) Get(merID int32, withdrawalID int32) (*model.MerMerchantWithdrawal, error) {
+	w := dao.MerMerchantWithdrawal
+	query := w.WithContext(s.ctx).Where(w.WithdrawalID.Eq(withdrawalID))
+	if merID > 0 {
+		query = query.Where(w.MerID.Eq(merID))
+	}
+	withdrawal, err := query.First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("提现记录不存在")
+		}
+		return nil, fmt.Errorf("查询提现记录失败: %w", err)
+	}
+	return withdrawal, nil
+}
+
+// Approve 平台审核通过并提交默认出款渠道
+func (s *WithdrawalService) Approve(operatorID int32, withdrawalID int32, remark string) (*model.MerMerchantWithdrawal, error) {
+	withdrawal, err := s.Get(0, withdrawalID)
+	if err != nil {
+		return nil, err
+	}
+	provider, err := payout.Default()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	err = s.transition(withdrawal, WithdrawalApproved, map[string]interface{}{
+		"provider":      provider.Name(),
+		"review_remark": remark,
+		"reviewed_by":   operatorID,
+		"review_at":     now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	approved, err := s.Get(0, withdrawalID)
+	if err != nil {
+		return nil, err
+	}
+	s.audit(AuditActionWithdrawalApprove, withdrawal, approved)
+	return s.submit(approved, provider)
+}
+
+// Reject 平台驳回提现申请，冻结金额退回可用余额
+func (s *WithdrawalService) Reject(operatorID int32, withdrawalID int32, remark string) (*model.MerMerchantWithdrawal, error) {
+	if strings.TrimSpace(remark) == "" {
+		return nil, errors.New("请填写驳回原因")
+	}
+	withdrawal, err := s.Get(0, withdrawalID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.transition(withdrawal, WithdrawalRejected, map[string]interface{}{
+		"review_remark": remark,
+		"reviewed_by":   operatorID,
+		"review_at":     time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rejected, err := s.Get(0, withdrawalID)
+	if err != nil {
+		return nil, err
+	}
+	s.audit(AuditActionWithdrawalReject, withdrawal, rejected)
+	return rejected, nil
+}
+
+// Submit 重新提交出款：提交渠道时结果未知（网络异常等）的提现保持已审核状态，
+// 可以重新提交，渠道按出款单号保证不会重复出款
+func (s *WithdrawalService) Submit(withdrawalID int32) (*model.MerMerchantWithdrawal, error) {
+	withdrawal, err := s.Get(0, withdrawalID)
+	if err != nil {
+		return nil, err
+	}
+	if withdrawal.Status != WithdrawalApproved {
+		return nil, errors.New("只有已审核的提现可以提交出款")
+	}
+	provider, err := payout.Get(withdrawal.Provider)
+	if err != nil {
+		return nil, err
+	}
+	s.audit(AuditActionWithdrawalSubmit, nil, withdrawal)
+	return s.submit(withdrawal, provider)
+}
+
+// audit 记录平台对提现的操作，记录在提现所属的商户下
+func (s *WithdrawalService) audit(action string, before, after *model.MerMerchantWithdrawal) {
+	entry := &AuditEntry{
+		MerID:      after.MerID,
+		Action:     action,
+		EntityType: AuditEntityWithdrawal,
+		EntityID:   int64(after.WithdrawalID),
+		After:      after,
+	}
+	if before != nil {
+		entry.Before = before
+	}
+	NewAuditService(s.ctx).Log(entry)
+}
+
+// HandleCallback 处理出款渠道的结果通知；重复或过期的通知直接返回成功
+func (s *WithdrawalService) HandleCallback(providerName string, header http.Header, body []byte) error {
+	provider, err := payout.Get(providerName)
+	if err != nil {
+		return err
+	}
+	callback, err := provider.ParseCallback(header, body)
+	if err != nil {
+		return err
+	}
+
+	w := dao.MerMerchantWithdrawal
+	withdrawal, err := w.WithContext(s.ctx).Where(w.PayoutNo.Eq(callback.PayoutNo)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("出款单不存在: %s", callback.PayoutNo)
+		}
+		return fmt.Errorf("查询提现记录失败: %w", err)
+	}
+	if withdrawal.Provider != providerName {
+		return errors.New("出款渠道不匹配")
+	}
+
+	_, err = s.applyResult(withdrawal, callback.Status, callback.ProviderRef, callback.Reason)
+	return err
+}
+
+// submit 提交出款渠道，并按同步返回的结果更新状态
+func (s *WithdrawalService) submit(withdrawal *model.MerMerchantWithdrawal, provider payout.Provider) (*model.MerMerchantWithdrawal, error) {
+	result, err := provider.Submit(s.ctx, &payout.Request{
+		PayoutNo:      withdrawal.PayoutNo,
+		Amount:        withdrawal.Amount,
+		Method:        withdrawal.Method,
+		HolderName:    withdrawal.HolderName,
+		BankName:      withdrawal.BankName,
+		BankCode:      withdrawal.BankCode,
+		AccountNo:     string(withdrawal.AccountNo),
+		Chain:         withdrawal.Chain,
+		WalletAddress: string(withdrawal.WalletAddress),
+	})
+	if err != nil {
+		return withdrawal, fmt.Errorf("提交出款失败，请稍后重新提交: %w", err)
+	}
+	return s.applyResult(withdrawal, result.Status, result.ProviderRef, result.Reason)
+}
+
+// applyResult 按渠道返回的出款结果变更状态，已经处于该状态（或之后的状态）时视为重复通知
+func (s *WithdrawalService) applyResult(withdrawal *model.MerMerchantWithdrawal, status, providerRef, reason string) (*model.MerMerchantWithdrawal, error) {
+	updates := make(map[string]interface{})
+	if providerRef != "" {
+		updates["provider_ref"] = providerRef
+	}
+
+	var to string
+	switch status {
+	case payout.StatusProcessing:
+		if providerRef != "" && withdrawal.ProviderRef != providerRef {
+			w := dao.MerMerchantWithdrawal
+			if _, err := w.WithContext(s.ctx).
+				Where(w.WithdrawalID.Eq(withdrawal.WithdrawalID), w.Status.Eq(WithdrawalApproved)).
+				Updates(map[string]interface{}{"provider_ref": providerRef, "update_at": time.Now()}); err != nil {
+				return nil, fmt.Errorf("更新提现记录失败: %w", err)
+			}
+		}
+		return s.Get(0, withdrawal.WithdrawalID)
+	case payout.StatusPaid:
+		to = WithdrawalPaid
+		updates["paid_at"] = time.Now()
+	case payout.StatusFailed:
+		to = WithdrawalFailed
+		updates["fail_reason"] = reason
+	case payout.StatusReversed:
+		to = WithdrawalReversed
+		updates["fail_reason"] = reason
+	default:
+		return nil, fmt.Errorf("无效的出款状态: %s", status)
+	}
+
+	if withdrawalReached(withdrawal.Status, to) {
+		return withdrawal, nil
+	}
+	err := s.transition(withdrawal, to, updates)
+	if errors.Is(err, ErrWithdrawalStateChanged) {
+		// 并发的重复通知：其他请求已经处理
+		current, getErr := s.Get(0, withdrawal.WithdrawalID)
+		if getErr == nil && withdrawalReached(current.Status, to) {
+			return current, nil
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(0, withdrawal.WithdrawalID)
+}
+
+// transition 在事务中变更提现状态并记账，只有状态仍为读取时的状态才会更新；
+// 审核和出款结果由平台或渠道触发，流水的操作人记为系统
+func (s *WithdrawalService) transition(withdrawal *model.MerMerchantWithdrawal, to string, updates map[string]interface{}) error {
+	if !canTransitWithdrawal(withdrawal.Status, to) {
+		return fmt.Errorf("提现状态为 %s，不能变更为 %s", withdrawal.Status, to)
+	}
+	updates["status"] = to
+	updates["update_at"] = time.Now()
+
+	return dao.Q.Transaction(func(tx *dao.Query) error {
+		w := tx.MerMerchantWithdrawal
+		info, err := w.WithContext(s.ctx).
+			Where(w.WithdrawalID.Eq(withdrawal.WithdrawalID), w.Status.Eq(withdrawal.Status)).
+			Updates(updates)
+		if err != nil {
+			return fmt.Errorf("更新提现状态失败: %w", err)
+		}
+		if info.RowsAffected == 0 {
+			return ErrWithdrawalStateChanged
+		}
+
+		if txn := withdrawalLedger(withdrawal, to, 0); txn != nil {
+			if _, err := NewLedgerService(s.ctx).PostTx(tx, txn); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// withdrawalLedger 提现状态变更对应的记账交易，审核通过不涉及资金变动
+func withdrawalLedger(withdrawal *model.MerMerchantWithdrawal, status string, createdBy int32) *LedgerTransaction {
+	var txn *LedgerTransaction
+	switch status {
+	case WithdrawalPending:
+		txn = NewLedgerTransfer(withdrawal.MerID, LedgerReasonWithdraw, LedgerAccountAvailable, LedgerAccountFrozen, withdrawal.Amount)
+	case WithdrawalRejected, WithdrawalFailed:
+		txn = NewLedgerTransfer(withdrawal.MerID, LedgerReasonWithdrawReturn, LedgerAccountFrozen, LedgerAccountAvailable, withdrawal.Amount)
+	case WithdrawalPaid:
+		txn = NewLedgerTransfer(withdrawal.MerID, LedgerReasonWithdrawPayout, LedgerAccountFrozen, LedgerAccountPlatform, withdrawal.Amount)
+	case WithdrawalReversed:
+		txn = NewLedgerTransfer(withdrawal.MerID, LedgerReasonWithdrawReversal, LedgerAccountPlatform, LedgerAccountAvailable, withdrawal.Amount)
+	default:
+		return nil
+	}
+	txn.RefType = withdrawalRefType
+	txn.RefID = strconv.Itoa(int(withdrawal.WithdrawalID))
+	txn.Remark = withdrawal.PayoutNo
+	txn.CreatedBy = createdBy
+	return txn
+}
+
+// canTransitWithdrawal 判断提现状态是否可以变更
+func canTransitWithdrawal(from, to string) bool {
+	for _, next := range withdrawalTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// withdrawalReached 当前状态是否已经是目标状态或目标状态之后的状态（用于识别重复通知）
+func withdrawalReached(current, target string) bool {
+	if current == target {
+		return true
+	}
+	return target == WithdrawalPaid && current == WithdrawalReversed
+}
@@ -0,0 +1,225 @@
+package service
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"merchant_api/internal/dao"
+	"merchant_api/internal/pkg/payout"
+	"merchant_api/pkg/config"
+	"merchant_api/pkg/database"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestCanTransitWithdrawal(t *testing.T) {
+	statuses := []string{WithdrawalPending, WithdrawalApproved, WithdrawalPaid, WithdrawalFailed, WithdrawalRejected, WithdrawalReversed}
+	allowed := map[[2]string]bool{
+		{WithdrawalPending, WithdrawalApproved}: true,
+		{WithdrawalPending, WithdrawalRejected}: true,
+		{WithdrawalApproved, WithdrawalPaid}:    true,
+		{WithdrawalApproved, WithdrawalFailed}:  true,
+		{WithdrawalPaid, WithdrawalReversed}:    true,
+	}
+	for _, from := range statuses {
+		for _, to := range statuses {
+			if got, want := canTransitWithdrawal(from, to), allowed[[2]string{from, to}]; got != want {
+				t.Errorf("canTransitWithdrawal(%s, %s) = %v, want %v", from, to, got, want)
+			}
+		}
+	}
+}
+
+func TestWithdrawalReached(t *testing.T) {
+	tests := []struct {
+		current, target string
+		want            bool
+	}{
+		{WithdrawalPaid, WithdrawalPaid, true},
+		{WithdrawalFailed, WithdrawalFailed, true},
+		{WithdrawalReversed, WithdrawalReversed, true},
+		{WithdrawalReversed, WithdrawalPaid, true}, // 到账通知晚于退回通知
+		{WithdrawalApproved, WithdrawalPaid, false},
+		{WithdrawalPaid, WithdrawalFailed, false},
+		{WithdrawalPaid, WithdrawalReversed, false},
+		{WithdrawalFailed, WithdrawalPaid, false},
+	}
+	for _, tt := range tests {
+		if got := withdrawalReached(tt.current, tt.target); got != tt.want {
+			t.Errorf("withdrawalReached(%s, %s) = %v, want %v", tt.current, tt.target, got, tt.want)
+		}
+	}
+}
+
+// withdrawalFake 模拟一条提现记录：查询返回最近一次 UPDATE 写入的状态，商户余额与流水一致
+type withdrawalFake struct {
+	*fakeDB
+	initial  string
+	provider string
+}
+
+func newWithdrawalFake(t *testing.T, status string) *withdrawalFake {
+	f := &withdrawalFake{initial: status, provider: payout.LocalProviderName}
+	f.fakeDB = newFakeDB(t, func(query string, args []driver.Value) ([]string, [][]driver.Value) {
+		switch {
+		case strings.Contains(query, "FROM `mer_merchant_withdrawal`"):
+			return []string{"withdrawal_id", "mer_id", "payout_no", "provider", "status", "amount"},
+				[][]driver.Value{{int64(9), int64(1), "P1", f.provider, f.status(), 100.0}}
+		case strings.Contains(query, "FROM `mer_merchant` "):
+			return []string{"mer_id", "mer_money"}, [][]driver.Value{{int64(1), 0.0}}
+		case strings.Contains(query, "FROM `mer_merchant_ledger`") && strings.Contains(query, "`ref_type`"):
+			return nil, nil // 没有已记账的流水
+		case strings.Contains(query, "FROM `mer_merchant_ledger`"):
+			if len(args) > 1 && args[1] == LedgerAccountFrozen {
+				return []string{"ledger_id", "balance"}, [][]driver.Value{{int64(1), 100.0}}
+			}
+			return nil, nil
+		}
+		return nil, nil
+	})
+	dao.SetDefault(database.GetDB())
+	return f
+}
+
+// status 当前状态：最近一次更新提现记录写入的状态
+func (f *withdrawalFake) status() string {
+	status := f.initial
+	for _, exec := range f.updates() {
+		if value, ok := updateColumn(exec, "status"); ok {
+			status = value.(string)
+		}
+	}
+	return status
+}
+
+func (f *withdrawalFake) updates() []fakeExec {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var result []fakeExec
+	for _, exec := range f.execs {
+		if strings.HasPrefix(exec.Query, "UPDATE `mer_merchant_withdrawal`") {
+			result = append(result, exec)
+		}
+	}
+	return result
+}
+
+// updateColumn 从 UPDATE 语句中取出某一列写入的值
+func updateColumn(exec fakeExec, column string) (driver.Value, bool) {
+	set := exec.Query[strings.Index(exec.Query, " SET ")+5:]
+	set = set[:strings.Index(set, " WHERE ")]
+	for i, part := range strings.Split(set, ",") {
+		if strings.HasPrefix(part, "`"+column+"`=") {
+			return exec.Args[i], true
+		}
+	}
+	return nil, false
+}
+
+// callback 发送一次签名正确的本地渠道回调
+func (f *withdrawalFake) callback(t *testing.T, provider *payout.LocalProvider, status string) error {
+	t.Helper()
+	body, _ := json.Marshal(map[string]string{"payout_no": "P1", "provider_ref": "local_P1", "status": status})
+	header := http.Header{}
+	header.Set(payout.LocalSignatureHeader, provider.Sign(body))
+	return NewWithdrawalService(context.Background()).HandleCallback(payout.LocalProviderName, header, body)
+}
+
+func TestWithdrawalCallbacks(t *testing.T) {
+	provider := payout.NewLocalProvider(config.PayoutLocalConfig{CallbackSecret: "test-secret"})
+	payout.Register(provider)
+
+	type step struct {
+		status     string // 回调的出款状态
+		wantErr    bool
+		wantStatus string // 回调后的提现状态
+		wantLedger string // 本次回调写入的流水原因，为空表示不记账
+	}
+	tests := []struct {
+		name    string
+		initial string
+		steps   []step
+	}{
+		{"到账及重复通知", WithdrawalApproved, []step{
+			{payout.StatusPaid, false, WithdrawalPaid, LedgerReasonWithdrawPayout},
+			{payout.StatusPaid, false, WithdrawalPaid, ""},
+		}},
+		{"失败及重复通知", WithdrawalApproved, []step{
+			{payout.StatusFailed, false, WithdrawalFailed, LedgerReasonWithdrawReturn},
+			{payout.StatusFailed, false, WithdrawalFailed, ""},
+			{payout.StatusPaid, true, WithdrawalFailed, ""},
+		}},
+		{"到账后退回，到账通知晚到", WithdrawalApproved, []step{
+			{payout.StatusPaid, false, WithdrawalPaid, LedgerReasonWithdrawPayout},
+			{payout.StatusReversed, false, WithdrawalReversed, LedgerReasonWithdrawReversal},
+			{payout.StatusPaid, false, WithdrawalReversed, ""},
+			{payout.StatusReversed, false, WithdrawalReversed, ""},
+		}},
+		{"到账后通知失败", WithdrawalPaid, []step{
+			{payout.StatusFailed, true, WithdrawalPaid, ""},
+		}},
+		{"未到账不能退回", WithdrawalApproved, []step{
+			{payout.StatusReversed, true, WithdrawalApproved, ""},
+		}},
+		{"未审核不接受出款结果", WithdrawalPending, []step{
+			{payout.StatusPaid, true, WithdrawalPending, ""},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := newWithdrawalFake(t, tt.initial)
+			for i, s := range tt.steps {
+				ledgers := len(fake.inserts("mer_merchant_ledger"))
+				err := fake.callback(t, provider, s.status)
+				if (err != nil) != s.wantErr {
+					t.Fatalf("step %d: HandleCallback(%s) error = %v, wantErr %v", i, s.status, err, s.wantErr)
+				}
+				if got := fake.status(); got != s.wantStatus {
+					t.Errorf("step %d: status = %s, want %s", i, got, s.wantStatus)
+				}
+
+				entries := fake.inserts("mer_merchant_ledger")[ledgers:]
+				if s.wantLedger == "" {
+					if len(entries) != 0 {
+						t.Errorf("step %d: got %d ledger inserts, want 0", i, len(entries))
+					}
+					continue
+				}
+				if len(entries) != 1 {
+					t.Fatalf("step %d: got %d ledger inserts, want 1", i, len(entries))
+				}
+				if reason, _ := insertColumn(entries[0], "reason"); reason != s.wantLedger {
+					t.Errorf("step %d: ledger reason = %v, want %s", i, reason, s.wantLedger)
+				}
+			}
+		})
+	}
+}
+
+func TestWithdrawalCallbackRejectsBadRequest(t *testing.T) {
+	provider := payout.NewLocalProvider(config.PayoutLocalConfig{CallbackSecret: "test-secret"})
+	payout.Register(provider)
+
+	t.Run("签名错误", func(t *testing.T) {
+		fake := newWithdrawalFake(t, WithdrawalApproved)
+		forged := payout.NewLocalProvider(config.PayoutLocalConfig{CallbackSecret: "other-secret"})
+		if err := fake.callback(t, forged, payout.StatusPaid); err == nil {
+			t.Error("HandleCallback() error = nil, want signature error")
+		}
+		if len(fake.updates()) != 0 {
+			t.Error("withdrawal updated by forged callback")
+		}
+	})
+
+	t.Run("出款渠道不匹配", func(t *testing.T) {
+		fake := newWithdrawalFake(t, WithdrawalApproved)
+		fake.provider = "other"
+		if err := fake.callback(t, provider, payout.StatusPaid); err == nil {
+			t.Error("HandleCallback() error = nil, want provider mismatch")
+		}
+		if len(fake.updates()) != 0 {
+			t.Error("withdrawal updated by callback from another provider")
+		}
+	})
+}
@@ -16,64 +16,154 @@ import (
 )
 
 var (
-	Q                      = new(Query)
-	MerMerchantAdmin       *merMerchantAdmin
-	MerMerchant            *merMerchant
-	MerMerchantCategory    *merMerchantCategory
-	MerStoreCategory       *merStoreCategory
-	MerStoreProduct        *merStoreProduct
-	MerStoreProductContent *merStoreProductContent
-	MerStoreProductSku     *merStoreProductSku
+	Q                          = new(Query)
+	MerAdminAuditLog           *merAdminAuditLog
+	MerMerchantAdmin           *merMerchantAdmin
+	MerMerchant                *merMerchant
+	MerMerchantAPIKey          *merMerchantAPIKey
+	MerMerchantAdminPwdHistory *merMerchantAdminPwdHistory
+	MerMerchantAdminTotp       *merMerchantAdminTotp
+	MerMerchantApplication     *merMerchantApplication
+	MerMerchantApplicationLog  *merMerchantApplicationLog
+	MerMerchantBusinessHour    *merMerchantBusinessHour
+	MerMerchantCategory        *merMerchantCategory
+	MerMerchantHoliday         *merMerchantHoliday
+	MerMerchantLedger          *merMerchantLedger
+	MerMerchantPayoutAccount   *merMerchantPayoutAccount
+	MerMerchantSecurity        *merMerchantSecurity
+	MerMerchantWithdrawal      *merMerchantWithdrawal
+	MerStoreCategory           *merStoreCategory
+	MerStoreProduct            *merStoreProduct
+	MerStoreProductContent     *merStoreProductContent
+	MerStoreProductSku         *merStoreProductSku
+	MerStoreProductSpec        *merStoreProductSpec
+	MerStoreProductSpecValue   *merStoreProductSpecValue
+	MerStoreStockMovement      *merStoreStockMovement
+	MerStoreStockReservation   *merStoreStockReservation
+	MerSystemRole              *merSystemRole
+	PlatOperator               *platOperator
 )
 
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	*Q = *Use(db, opts...)
+	MerAdminAuditLog = &Q.MerAdminAuditLog
 	MerMerchantAdmin = &Q.MerMerchantAdmin
 	MerMerchant = &Q.MerMerchant
+	MerMerchantAPIKey = &Q.MerMerchantAPIKey
+	MerMerchantAdminPwdHistory = &Q.MerMerchantAdminPwdHistory
+	MerMerchantAdminTotp = &Q.MerMerchantAdminTotp
+	MerMerchantApplication = &Q.MerMerchantApplication
+	MerMerchantApplicationLog = &Q.MerMerchantApplicationLog
+	MerMerchantBusinessHour = &Q.MerMerchantBusinessHour
 	MerMerchantCategory = &Q.MerMerchantCategory
+	MerMerchantHoliday = &Q.MerMerchantHoliday
+	MerMerchantLedger = &Q.MerMerchantLedger
+	MerMerchantPayoutAccount = &Q.MerMerchantPayoutAccount
+	MerMerchantSecurity = &Q.MerMerchantSecurity
+	MerMerchantWithdrawal = &Q.MerMerchantWithdrawal
 	MerStoreCategory = &Q.MerStoreCategory
 	MerStoreProduct = &Q.MerStoreProduct
 	MerStoreProductContent = &Q.MerStoreProductContent
 	MerStoreProductSku = &Q.MerStoreProductSku
+	MerStoreProductSpec = &Q.MerStoreProductSpec
+	MerStoreProductSpecValue = &Q.MerStoreProductSpecValue
+	MerStoreStockMovement = &Q.MerStoreStockMovement
+	MerStoreStockReservation = &Q.MerStoreStockReservation
+	MerSystemRole = &Q.MerSystemRole
+	PlatOperator = &Q.PlatOperator
 }
 
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
-		db:                     db,
-		MerMerchantAdmin:       newMerMerchantAdmin(db, opts...),
-		MerMerchant:            newMerMerchant(db, opts...),
-		MerMerchantCategory:    newMerMerchantCategory(db, opts...),
-		MerStoreCategory:       newMerStoreCategory(db, opts...),
-		MerStoreProduct:        newMerStoreProduct(db, opts...),
-		MerStoreProductContent: newMerStoreProductContent(db, opts...),
-		MerStoreProductSku:     newMerStoreProductSku(db, opts...),
+		db:                         db,
+		MerAdminAuditLog:           newMerAdminAuditLog(db, opts...),
+		MerMerchantAdmin:           newMerMerchantAdmin(db, opts...),
+		MerMerchant:                newMerMerchant(db, opts...),
+		MerMerchantAPIKey:          newMerMerchantAPIKey(db, opts...),
+		MerMerchantAdminPwdHistory: newMerMerchantAdminPwdHistory(db, opts...),
+		MerMerchantAdminTotp:       newMerMerchantAdminTotp(db, opts...),
+		MerMerchantApplication:     newMerMerchantApplication(db, opts...),
+		MerMerchantApplicationLog:  newMerMerchantApplicationLog(db, opts...),
+		MerMerchantBusinessHour:    newMerMerchantBusinessHour(db, opts...),
+		MerMerchantCategory:        newMerMerchantCategory(db, opts...),
+		MerMerchantHoliday:         newMerMerchantHoliday(db, opts...),
+		MerMerchantLedger:          newMerMerchantLedger(db, opts...),
+		MerMerchantPayoutAccount:   newMerMerchantPayoutAccount(db, opts...),
+		MerMerchantSecurity:        newMerMerchantSecurity(db, opts...),
+		MerMerchantWithdrawal:      newMerMerchantWithdrawal(db, opts...),
+		MerStoreCategory:           newMerStoreCategory(db, opts...),
+		MerStoreProduct:            newMerStoreProduct(db, opts...),
+		MerStoreProductContent:     newMerStoreProductContent(db, opts...),
+		MerStoreProductSku:         newMerStoreProductSku(db, opts...),
+		MerStoreProductSpec:        newMerStoreProductSpec(db, opts...),
+		MerStoreProductSpecValue:   newMerStoreProductSpecValue(db, opts...),
+		MerStoreStockMovement:      newMerStoreStockMovement(db, opts...),
+		MerStoreStockReservation:   newMerStoreStockReservation(db, opts...),
+		MerSystemRole:              newMerSystemRole(db, opts...),
+		PlatOperator:               newPlatOperator(db, opts...),
 	}
 }
 
 type Query struct {
 	db *gorm.DB
 
-	MerMerchantAdmin       merMerchantAdmin
-	MerMerchant            merMerchant
-	MerMerchantCategory    merMerchantCategory
-	MerStoreCategory       merStoreCategory
-	MerStoreProduct        merStoreProduct
-	MerStoreProductContent merStoreProductContent
-	MerStoreProductSku     merStoreProductSku
+	MerAdminAuditLog           merAdminAuditLog
+	MerMerchantAdmin           merMerchantAdmin
+	MerMerchant                merMerchant
+	MerMerchantAPIKey          merMerchantAPIKey
+	MerMerchantAdminPwdHistory merMerchantAdminPwdHistory
+	MerMerchantAdminTotp       merMerchantAdminTotp
+	MerMerchantApplication     merMerchantApplication
+	MerMerchantApplicationLog  merMerchantApplicationLog
+	MerMerchantBusinessHour    merMerchantBusinessHour
+	MerMerchantCategory        merMerchantCategory
+	MerMerchantHoliday         merMerchantHoliday
+	MerMerchantLedger          merMerchantLedger
+	MerMerchantPayoutAccount   merMerchantPayoutAccount
+	MerMerchantSecurity        merMerchantSecurity
+	MerMerchantWithdrawal      merMerchantWithdrawal
+	MerStoreCategory           merStoreCategory
+	MerStoreProduct            merStoreProduct
+	MerStoreProductContent     merStoreProductContent
+	MerStoreProductSku         merStoreProductSku
+	MerStoreProductSpec        merStoreProductSpec
+	MerStoreProductSpecValue   merStoreProductSpecValue
+	MerStoreStockMovement      merStoreStockMovement
+	MerStoreStockReservation   merStoreStockReservation
+	MerSystemRole              merSystemRole
+	PlatOperator               platOperator
 }
 
 func (q *Query) Available() bool { return q.db != nil }
 
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
-		db:                     db,
-		MerMerchantAdmin:       q.MerMerchantAdmin.clone(db),
-		MerMerchant:            q.MerMerchant.clone(db),
-		MerMerchantCategory:    q.MerMerchantCategory.clone(db),
-		MerStoreCategory:       q.MerStoreCategory.clone(db),
-		MerStoreProduct:        q.MerStoreProduct.clone(db),
-		MerStoreProductContent: q.MerStoreProductContent.clone(db),
-		MerStoreProductSku:     q.MerStoreProductSku.clone(db),
+		db:                         db,
+		MerAdminAuditLog:           q.MerAdminAuditLog.clone(db),
+		MerMerchantAdmin:           q.MerMerchantAdmin.clone(db),
+		MerMerchant:                q.MerMerchant.clone(db),
+		MerMerchantAPIKey:          q.MerMerchantAPIKey.clone(db),
+		MerMerchantAdminPwdHistory: q.MerMerchantAdminPwdHistory.clone(db),
+		MerMerchantAdminTotp:       q.MerMerchantAdminTotp.clone(db),
+		MerMerchantApplication:     q.MerMerchantApplication.clone(db),
+		MerMerchantApplicationLog:  q.MerMerchantApplicationLog.clone(db),
+		MerMerchantBusinessHour:    q.MerMerchantBusinessHour.clone(db),
+		MerMerchantCategory:        q.MerMerchantCategory.clone(db),
+		MerMerchantHoliday:         q.MerMerchantHoliday.clone(db),
+		MerMerchantLedger:          q.MerMerchantLedger.clone(db),
+		MerMerchantPayoutAccount:   q.MerMerchantPayoutAccount.clone(db),
+		MerMerchantSecurity:        q.MerMerchantSecurity.clone(db),
+		MerMerchantWithdrawal:      q.MerMerchantWithdrawal.clone(db),
+		MerStoreCategory:           q.MerStoreCategory.clone(db),
+		MerStoreProduct:            q.MerStoreProduct.clone(db),
+		MerStoreProductContent:     q.MerStoreProductContent.clone(db),
+		MerStoreProductSku:         q.MerStoreProductSku.clone(db),
+		MerStoreProductSpec:        q.MerStoreProductSpec.clone(db),
+		MerStoreProductSpecValue:   q.MerStoreProductSpecValue.clone(db),
+		MerStoreStockMovement:      q.MerStoreStockMovement.clone(db),
+		MerStoreStockReservation:   q.MerStoreStockReservation.clone(db),
+		MerSystemRole:              q.MerSystemRole.clone(db),
+		PlatOperator:               q.PlatOperator.clone(db),
 	}
 }
 
@@ -87,36 +177,90 @@ func (q *Query) WriteDB() *Query {
 
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
-		db:                     db,
-		MerMerchantAdmin:       q.MerMerchantAdmin.replaceDB(db),
-		MerMerchant:            q.MerMerchant.replaceDB(db),
-		MerMerchantCategory:    q.MerMerchantCategory.replaceDB(db),
-		MerStoreCategory:       q.MerStoreCategory.replaceDB(db),
-		MerStoreProduct:        q.MerStoreProduct.replaceDB(db),
-		MerStoreProductContent: q.MerStoreProductContent.replaceDB(db),
-		MerStoreProductSku:     q.MerStoreProductSku.replaceDB(db),
+		db:                         db,
+		MerAdminAuditLog:           q.MerAdminAuditLog.replaceDB(db),
+		MerMerchantAdmin:           q.MerMerchantAdmin.replaceDB(db),
+		MerMerchant:                q.MerMerchant.replaceDB(db),
+		MerMerchantAPIKey:          q.MerMerchantAPIKey.replaceDB(db),
+		MerMerchantAdminPwdHistory: q.MerMerchantAdminPwdHistory.replaceDB(db),
+		MerMerchantAdminTotp:       q.MerMerchantAdminTotp.replaceDB(db),
+		MerMerchantApplication:     q.MerMerchantApplication.replaceDB(db),
+		MerMerchantApplicationLog:  q.MerMerchantApplicationLog.replaceDB(db),
+		MerMerchantBusinessHour:    q.MerMerchantBusinessHour.replaceDB(db),
+		MerMerchantCategory:        q.MerMerchantCategory.replaceDB(db),
+		MerMerchantHoliday:         q.MerMerchantHoliday.replaceDB(db),
+		MerMerchantLedger:          q.MerMerchantLedger.replaceDB(db),
+		MerMerchantPayoutAccount:   q.MerMerchantPayoutAccount.replaceDB(db),
+		MerMerchantSecurity:        q.MerMerchantSecurity.replaceDB(db),
+		MerMerchantWithdrawal:      q.MerMerchantWithdrawal.replaceDB(db),
+		MerStoreCategory:           q.MerStoreCategory.replaceDB(db),
+		MerStoreProduct:            q.MerStoreProduct.replaceDB(db),
+		MerStoreProductContent:     q.MerStoreProductContent.replaceDB(db),
+		MerStoreProductSku:         q.MerStoreProductSku.replaceDB(db),
+		MerStoreProductSpec:        q.MerStoreProductSpec.replaceDB(db),
+		MerStoreProductSpecValue:   q.MerStoreProductSpecValue.replaceDB(db),
+		MerStoreStockMovement:      q.MerStoreStockMovement.replaceDB(db),
+		MerStoreStockReservation:   q.MerStoreStockReservation.replaceDB(db),
+		MerSystemRole:              q.MerSystemRole.replaceDB(db),
+		PlatOperator:               q.PlatOperator.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
-	MerMerchantAdmin       IMerMerchantAdminDo
-	MerMerchant            IMerMerchantDo
-	MerMerchantCategory    IMerMerchantCategoryDo
-	MerStoreCategory       IMerStoreCategoryDo
-	MerStoreProduct        IMerStoreProductDo
-	MerStoreProductContent IMerStoreProductContentDo
-	MerStoreProductSku     IMerStoreProductSkuDo
+	MerAdminAuditLog           IMerAdminAuditLogDo
+	MerMerchantAdmin           IMerMerchantAdminDo
+	MerMerchant                IMerMerchantDo
+	MerMerchantAPIKey          IMerMerchantAPIKeyDo
+	MerMerchantAdminPwdHistory IMerMerchantAdminPwdHistoryDo
+	MerMerchantAdminTotp       IMerMerchantAdminTotpDo
+	MerMerchantApplication     IMerMerchantApplicationDo
+	MerMerchantApplicationLog  IMerMerchantApplicationLogDo
+	MerMerchantBusinessHour    IMerMerchantBusinessHourDo
+	MerMerchantCategory        IMerMerchantCategoryDo
+	MerMerchantHoliday         IMerMerchantHolidayDo
+	MerMerchantLedger          IMerMerchantLedgerDo
+	MerMerchantPayoutAccount   IMerMerchantPayoutAccountDo
+	MerMerchantSecurity        IMerMerchantSecurityDo
+	MerMerchantWithdrawal      IMerMerchantWithdrawalDo
+	MerStoreCategory           IMerStoreCategoryDo
+	MerStoreProduct            IMerStoreProductDo
+	MerStoreProductContent     IMerStoreProductContentDo
+	MerStoreProductSku         IMerStoreProductSkuDo
+	MerStoreProductSpec        IMerStoreProductSpecDo
+	MerStoreProductSpecValue   IMerStoreProductSpecValueDo
+	MerStoreStockMovement      IMerStoreStockMovementDo
+	MerStoreStockReservation   IMerStoreStockReservationDo
+	MerSystemRole              IMerSystemRoleDo
+	PlatOperator               IPlatOperatorDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
-		MerMerchantAdmin:       q.MerMerchantAdmin.WithContext(ctx),
-		MerMerchant:            q.MerMerchant.WithContext(ctx),
-		MerMerchantCategory:    q.MerMerchantCategory.WithContext(ctx),
-		MerStoreCategory:       q.MerStoreCategory.WithContext(ctx),
-		MerStoreProduct:        q.MerStoreProduct.WithContext(ctx),
-		MerStoreProductContent: q.MerStoreProductContent.WithContext(ctx),
-		MerStoreProductSku:     q.MerStoreProductSku.WithContext(ctx),
+		MerAdminAuditLog:           q.MerAdminAuditLog.WithContext(ctx),
+		MerMerchantAdmin:           q.MerMerchantAdmin.WithContext(ctx),
+		MerMerchant:                q.MerMerchant.WithContext(ctx),
+		MerMerchantAPIKey:          q.MerMerchantAPIKey.WithContext(ctx),
+		MerMerchantAdminPwdHistory: q.MerMerchantAdminPwdHistory.WithContext(ctx),
+		MerMerchantAdminTotp:       q.MerMerchantAdminTotp.WithContext(ctx),
+		MerMerchantApplication:     q.MerMerchantApplication.WithContext(ctx),
+		MerMerchantApplicationLog:  q.MerMerchantApplicationLog.WithContext(ctx),
+		MerMerchantBusinessHour:    q.MerMerchantBusinessHour.WithContext(ctx),
+		MerMerchantCategory:        q.MerMerchantCategory.WithContext(ctx),
+		MerMerchantHoliday:         q.MerMerchantHoliday.WithContext(ctx),
+		MerMerchantLedger:          q.MerMerchantLedger.WithContext(ctx),
+		MerMerchantPayoutAccount:   q.MerMerchantPayoutAccount.WithContext(ctx),
+		MerMerchantSecurity:        q.MerMerchantSecurity.WithContext(ctx),
+		MerMerchantWithdrawal:      q.MerMerchantWithdrawal.WithContext(ctx),
+		MerStoreCategory:           q.MerStoreCategory.WithContext(ctx),
+		MerStoreProduct:            q.MerStoreProduct.WithContext(ctx),
+		MerStoreProductContent:     q.MerStoreProductContent.WithContext(ctx),
+		MerStoreProductSku:         q.MerStoreProductSku.WithContext(ctx),
+		MerStoreProductSpec:        q.MerStoreProductSpec.WithContext(ctx),
+		MerStoreProductSpecValue:   q.MerStoreProductSpecValue.WithContext(ctx),
+		MerStoreStockMovement:      q.MerStoreStockMovement.WithContext(ctx),
+		MerStoreStockReservation:   q.MerStoreStockReservation.WithContext(ctx),
+		MerSystemRole:              q.MerSystemRole.WithContext(ctx),
+		PlatOperator:               q.PlatOperator.WithContext(ctx),
 	}
 }
 
@@ -34,6 +34,7 @@ func newMerMerchantAdmin(db *gorm.DB, opts ...gen.DOOption) merMerchantAdmin {
 	_merMerchantAdmin.Pwd = field.NewString(tableName, "pwd")
 	_merMerchantAdmin.RealName = field.NewString(tableName, "real_name")
 	_merMerchantAdmin.Phone = field.NewString(tableName, "phone")
+	_merMerchantAdmin.PhoneHash = field.NewString(tableName, "phone_hash")
 	_merMerchantAdmin.LastIP = field.NewString(tableName, "last_ip")
 	_merMerchantAdmin.LastTime = field.NewTime(tableName, "last_time")
 	_merMerchantAdmin.Roles = field.NewString(tableName, "roles")
@@ -57,8 +58,9 @@ type merMerchantAdmin struct {
 	MerID           field.Int32  // 商户ID(属于哪一个商户)
 	Account         field.String // 商户管理员账号
 	Pwd             field.String // 商户管理员密码
-	RealName        field.String // 商户管理员姓名
-	Phone           field.String // 商户管理员手机号
+	RealName        field.String // 商户管理员姓名(加密)
+	Phone           field.String // 商户管理员手机号(加密)
+	PhoneHash       field.String // 商户管理员手机号盲索引
 	LastIP          field.String // 商户管理员最后一次登录IP地址
 	LastTime        field.Time   // 商户管理员最后一次登录时间
 	Roles           field.String
@@ -89,6 +91,7 @@ func (m *merMerchantAdmin) updateTableName(table string) *merMerchantAdmin {
 	m.Pwd = field.NewString(table, "pwd")
 	m.RealName = field.NewString(table, "real_name")
 	m.Phone = field.NewString(table, "phone")
+	m.PhoneHash = field.NewString(table, "phone_hash")
 	m.LastIP = field.NewString(table, "last_ip")
 	m.LastTime = field.NewTime(table, "last_time")
 	m.Roles = field.NewString(table, "roles")
@@ -113,13 +116,14 @@ func (m *merMerchantAdmin) GetFieldByName(fieldName string) (field.OrderExpr, bo
 }
 
 func (m *merMerchantAdmin) fillFieldMap() {
-	m.fieldMap = make(map[string]field.Expr, 14)
+	m.fieldMap = make(map[string]field.Expr, 15)
 	m.fieldMap["merchant_admin_id"] = m.MerchantAdminID
 	m.fieldMap["mer_id"] = m.MerID
 	m.fieldMap["account"] = m.Account
 	m.fieldMap["pwd"] = m.Pwd
 	m.fieldMap["real_name"] = m.RealName
 	m.fieldMap["phone"] = m.Phone
+	m.fieldMap["phone_hash"] = m.PhoneHash
 	m.fieldMap["last_ip"] = m.LastIP
 	m.fieldMap["last_time"] = m.LastTime
 	m.fieldMap["roles"] = m.Roles
@@ -33,25 +33,28 @@ func newMerMerchant(db *gorm.DB, opts ...gen.DOOption) merMerchant {
 	_merMerchant.MerName = field.NewString(tableName, "mer_name")
 	_merMerchant.RealName = field.NewString(tableName, "real_name")
 	_merMerchant.MerPhone = field.NewString(tableName, "mer_phone")
+	_merMerchant.MerPhoneHash = field.NewString(tableName, "mer_phone_hash")
 	_merMerchant.MerAddress = field.NewString(tableName, "mer_address")
 	_merMerchant.MerKeyword = field.NewString(tableName, "mer_keyword")
 	_merMerchant.MerLogo = field.NewString(tableName, "mer_logo")
 	_merMerchant.MerBanner = field.NewString(tableName, "mer_banner")
 	_merMerchant.OpeningTime = field.NewTime(tableName, "opening_time")
 	_merMerchant.ClosingTime = field.NewTime(tableName, "closing_time")
+	_merMerchant.Timezone = field.NewString(tableName, "timezone")
 	_merMerchant.Sales = field.NewInt32(tableName, "sales")
 	_merMerchant.Mark = field.NewString(tableName, "mark")
 	_merMerchant.Sort = field.NewInt32(tableName, "sort")
 	_merMerchant.Status = field.NewBool(tableName, "status")
+	_merMerchant.AuditStatus = field.NewInt32(tableName, "audit_status")
+	_merMerchant.AuditRemark = field.NewString(tableName, "audit_remark")
+	_merMerchant.AuditBy = field.NewInt32(tableName, "audit_by")
+	_merMerchant.AuditAt = field.NewTime(tableName, "audit_at")
 	_merMerchant.IsDel = field.NewInt32(tableName, "is_del")
 	_merMerchant.MerInfo = field.NewString(tableName, "mer_info")
 	_merMerchant.ServicePhone = field.NewString(tableName, "service_phone")
 	_merMerchant.CreateAt = field.NewTime(tableName, "create_at")
 	_merMerchant.UpdateAt = field.NewTime(tableName, "update_at")
 	_merMerchant.MerMoney = field.NewFloat64(tableName, "mer_money")
-	_merMerchant.BankName = field.NewString(tableName, "bank_name")
-	_merMerchant.BankCode = field.NewString(tableName, "bank_code")
-	_merMerchant.WalletAddress = field.NewString(tableName, "wallet_address")
 	_merMerchant.DeliveryWay = field.NewString(tableName, "delivery_way")
 
 	_merMerchant.fillFieldMap()
@@ -63,32 +66,35 @@ func newMerMerchant(db *gorm.DB, opts ...gen.DOOption) merMerchant {
 type merMerchant struct {
 	merMerchantDo
 
-	ALL           field.Asterisk
-	MerID         field.Int32  // 商户id
-	CategoryIds   field.String // 商户分类 id
-	MerName       field.String // 商户名称
-	RealName      field.String // 商户姓名
-	MerPhone      field.String // 商户手机号
-	MerAddress    field.String // 商户地址
-	MerKeyword    field.String // 商户关键字
-	MerLogo       field.String // 商户头像/logo
-	MerBanner     field.String // 商户banner图片
-	OpeningTime   field.Time   // 营业开始时间
-	ClosingTime   field.Time   // 营业结束时间
-	Sales         field.Int32  // 销量
-	Mark          field.String // 商户备注
-	Sort          field.Int32
-	Status        field.Bool   // 商户是否禁用0锁定,1正常
-	IsDel         field.Int32  // 0未删除1删除
-	MerInfo       field.String // 店铺简介
-	ServicePhone  field.String // 店铺电话
-	CreateAt      field.Time
-	UpdateAt      field.Time
-	MerMoney      field.Float64 // 商户余额
-	BankName      field.String  // 银行名称
-	BankCode      field.String  // 银行卡转账信息
-	WalletAddress field.String  // 钱包地址
-	DeliveryWay   field.String  // 配送方式
+	ALL          field.Asterisk
+	MerID        field.Int32  // 商户id
+	CategoryIds  field.String // 商户分类 id
+	MerName      field.String // 商户名称
+	RealName     field.String // 商户姓名(加密)
+	MerPhone     field.String // 商户手机号(加密)
+	MerPhoneHash field.String // 商户手机号盲索引
+	MerAddress   field.String // 商户地址
+	MerKeyword   field.String // 商户关键字
+	MerLogo      field.String // 商户头像/logo
+	MerBanner    field.String // 商户banner图片
+	OpeningTime  field.Time   // 营业开始时间
+	ClosingTime  field.Time   // 营业结束时间
+	Timezone     field.String // 商户时区(IANA)
+	Sales        field.Int32  // 销量
+	Mark         field.String // 商户备注
+	Sort         field.Int32
+	Status       field.Bool   // 商户是否禁用0锁定,1正常
+	AuditStatus  field.Int32  // 审核状态 0待审核 1已通过 2已驳回
+	AuditRemark  field.String // 审核意见
+	AuditBy      field.Int32  // 审核人ID(平台运营)
+	AuditAt      field.Time   // 审核时间
+	IsDel        field.Int32  // 0未删除1删除
+	MerInfo      field.String // 店铺简介
+	ServicePhone field.String // 店铺电话
+	CreateAt     field.Time
+	UpdateAt     field.Time
+	MerMoney     field.Float64 // 商户余额
+	DeliveryWay  field.String  // 配送方式
 
 	fieldMap map[string]field.Expr
 }
@@ -110,25 +116,28 @@ func (m *merMerchant) updateTableName(table string) *merMerchant {
 	m.MerName = field.NewString(table, "mer_name")
 	m.RealName = field.NewString(table, "real_name")
 	m.MerPhone = field.NewString(table, "mer_phone")
+	m.MerPhoneHash = field.NewString(table, "mer_phone_hash")
 	m.MerAddress = field.NewString(table, "mer_address")
 	m.MerKeyword = field.NewString(table, "mer_keyword")
 	m.MerLogo = field.NewString(table, "mer_logo")
 	m.MerBanner = field.NewString(table, "mer_banner")
 	m.OpeningTime = field.NewTime(table, "opening_time")
 	m.ClosingTime = field.NewTime(table, "closing_time")
+	m.Timezone = field.NewString(table, "timezone")
 	m.Sales = field.NewInt32(table, "sales")
 	m.Mark = field.NewString(table, "mark")
 	m.Sort = field.NewInt32(table, "sort")
 	m.Status = field.NewBool(table, "status")
+	m.AuditStatus = field.NewInt32(table, "audit_status")
+	m.AuditRemark = field.NewString(table, "audit_remark")
+	m.AuditBy = field.NewInt32(table, "audit_by")
+	m.AuditAt = field.NewTime(table, "audit_at")
 	m.IsDel = field.NewInt32(table, "is_del")
 	m.MerInfo = field.NewString(table, "mer_info")
 	m.ServicePhone = field.NewString(table, "service_phone")
 	m.CreateAt = field.NewTime(table, "create_at")
 	m.UpdateAt = field.NewTime(table, "update_at")
 	m.MerMoney = field.NewFloat64(table, "mer_money")
-	m.BankName = field.NewString(table, "bank_name")
-	m.BankCode = field.NewString(table, "bank_code")
-	m.WalletAddress = field.NewString(table, "wallet_address")
 	m.DeliveryWay = field.NewString(table, "delivery_way")
 
 	m.fillFieldMap()
@@ -146,31 +155,34 @@ func (m *merMerchant) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (m *merMerchant) fillFieldMap() {
-	m.fieldMap = make(map[string]field.Expr, 25)
+	m.fieldMap = make(map[string]field.Expr, 28)
 	m.fieldMap["mer_id"] = m.MerID
 	m.fieldMap["category_ids"] = m.CategoryIds
 	m.fieldMap["mer_name"] = m.MerName
 	m.fieldMap["real_name"] = m.RealName
 	m.fieldMap["mer_phone"] = m.MerPhone
+	m.fieldMap["mer_phone_hash"] = m.MerPhoneHash
 	m.fieldMap["mer_address"] = m.MerAddress
 	m.fieldMap["mer_keyword"] = m.MerKeyword
 	m.fieldMap["mer_logo"] = m.MerLogo
 	m.fieldMap["mer_banner"] = m.MerBanner
 	m.fieldMap["opening_time"] = m.OpeningTime
 	m.fieldMap["closing_time"] = m.ClosingTime
+	m.fieldMap["timezone"] = m.Timezone
 	m.fieldMap["sales"] = m.Sales
 	m.fieldMap["mark"] = m.Mark
 	m.fieldMap["sort"] = m.Sort
 	m.fieldMap["status"] = m.Status
+	m.fieldMap["audit_status"] = m.AuditStatus
+	m.fieldMap["audit_remark"] = m.AuditRemark
+	m.fieldMap["audit_by"] = m.AuditBy
+	m.fieldMap["audit_at"] = m.AuditAt
 	m.fieldMap["is_del"] = m.IsDel
 	m.fieldMap["mer_info"] = m.MerInfo
 	m.fieldMap["service_phone"] = m.ServicePhone
 	m.fieldMap["create_at"] = m.CreateAt
 	m.fieldMap["update_at"] = m.UpdateAt
 	m.fieldMap["mer_money"] = m.MerMoney
-	m.fieldMap["bank_name"] = m.BankName
-	m.fieldMap["bank_code"] = m.BankCode
-	m.fieldMap["wallet_address"] = m.WalletAddress
 	m.fieldMap["delivery_way"] = m.DeliveryWay
 }
 
@@ -31,9 +31,14 @@ func newMerStoreProductSku(db *gorm.DB, opts ...gen.DOOption) merStoreProductSku
 	_merStoreProductSku.ProductSkuID = field.NewInt32(tableName, "product_sku_id")
 	_merStoreProductSku.ProductID = field.NewInt32(tableName, "product_id")
 	_merStoreProductSku.AttrName = field.NewString(tableName, "attr_name")
+	_merStoreProductSku.SpecKey = field.NewString(tableName, "spec_key")
 	_merStoreProductSku.Price = field.NewFloat64(tableName, "price")
 	_merStoreProductSku.Cost = field.NewFloat64(tableName, "cost")
 	_merStoreProductSku.OtPrice = field.NewFloat64(tableName, "ot_price")
+	_merStoreProductSku.Image = field.NewString(tableName, "image")
+	_merStoreProductSku.Stock = field.NewInt32(tableName, "stock")
+	_merStoreProductSku.Reserved = field.NewInt32(tableName, "reserved")
+	_merStoreProductSku.LowStock = field.NewInt32(tableName, "low_stock")
 
 	_merStoreProductSku.fillFieldMap()
 
@@ -47,9 +52,14 @@ type merStoreProductSku struct {
 	ProductSkuID field.Int32
 	ProductID    field.Int32
 	AttrName     field.String  // 商品属性
+	SpecKey      field.String  // 规格值ID组合，按规格组顺序以逗号分隔，没有规格时为NULL
 	Price        field.Float64 // 最低价格
 	Cost         field.Float64 // 成本价
 	OtPrice      field.Float64 // 原价
+	Image        field.String  // 属性图片
+	Stock        field.Int32   // 库存
+	Reserved     field.Int32   // 已预占数量
+	LowStock     field.Int32   // 低库存预警值，0表示不预警
 
 	fieldMap map[string]field.Expr
 }
@@ -69,9 +79,14 @@ func (m *merStoreProductSku) updateTableName(table string) *merStoreProductSku {
 	m.ProductSkuID = field.NewInt32(table, "product_sku_id")
 	m.ProductID = field.NewInt32(table, "product_id")
 	m.AttrName = field.NewString(table, "attr_name")
+	m.SpecKey = field.NewString(table, "spec_key")
 	m.Price = field.NewFloat64(table, "price")
 	m.Cost = field.NewFloat64(table, "cost")
 	m.OtPrice = field.NewFloat64(table, "ot_price")
+	m.Image = field.NewString(table, "image")
+	m.Stock = field.NewInt32(table, "stock")
+	m.Reserved = field.NewInt32(table, "reserved")
+	m.LowStock = field.NewInt32(table, "low_stock")
 
 	m.fillFieldMap()
 
@@ -88,13 +103,18 @@ func (m *merStoreProductSku) GetFieldByName(fieldName string) (field.OrderExpr,
 }
 
 func (m *merStoreProductSku) fillFieldMap() {
-	m.fieldMap = make(map[string]field.Expr, 6)
+	m.fieldMap = make(map[string]field.Expr, 11)
 	m.fieldMap["product_sku_id"] = m.ProductSkuID
 	m.fieldMap["product_id"] = m.ProductID
 	m.fieldMap["attr_name"] = m.AttrName
+	m.fieldMap["spec_key"] = m.SpecKey
 	m.fieldMap["price"] = m.Price
 	m.fieldMap["cost"] = m.Cost
 	m.fieldMap["ot_price"] = m.OtPrice
+	m.fieldMap["image"] = m.Image
+	m.fieldMap["stock"] = m.Stock
+	m.fieldMap["reserved"] = m.Reserved
+	m.fieldMap["low_stock"] = m.LowStock
 }
 
 func (m merStoreProductSku) clone(db *gorm.DB) merStoreProductSku {
@@ -1,11 +1,14 @@
 package middleware
 
 import (
+	"bytes"
+	"errors"
+	"io"
 	"merchant_api/internal/admin/service"
 	"merchant_api/internal/pkg/jwt"
 	"merchant_api/internal/pkg/response"
 	"merchant_api/internal/pkg/utils"
-	"merchant_api/pkg/config"
+	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
@@ -31,7 +34,7 @@ func AuthMiddleware(requiredRole string) gin.HandlerFunc {
 		}
 
 		tokenString := parts[1]
-		claims, err := jwt.ParseToken(tokenString, config.GlobalConfig.JWT.Secret)
+		claims, err := jwt.ParseToken(tokenString)
 		if err != nil {
 			response.UnauthorizedWithKey(c, "error.auth.invalid_token")
 			c.Abort()
@@ -83,8 +86,7 @@ func AdminAuthMiddleware() gin.HandlerFunc {
 		authService := service.NewAdminAuthService(c.Request.Context())
 		claims, err := authService.VerifyToken(token, currentIP)
 		if err != nil {
-			response.Unauthorized(c, err.Error())
-			c.Abort()
+			authError(c, err)
 			return
 		}
 
@@ -93,7 +95,238 @@ func AdminAuthMiddleware() gin.HandlerFunc {
 		c.Set("mer_id", claims.MerID)
 		c.Set("username", claims.Username)
 		c.Set("role", claims.Role)
+		c.Set("session_id", claims.SessionID)
+
+		// 将操作人写入请求上下文，供服务层记录操作日志
+		actor := service.AuditActorFrom(c.Request.Context())
+		actor.MerID = int32(claims.MerID)
+		actor.AdminID = int32(claims.UserID)
+		actor.Account = claims.Username
+		actor.IP = currentIP
+		c.Request = c.Request.WithContext(service.WithAuditActor(c.Request.Context(), actor))
 
 		c.Next()
 	}
 }
+
+// PlatformAuthMiddleware 平台运营认证中间件，只接受运营令牌（RolePlatform），
+// 设置 operator_id 上下文，不设置 mer_id
+func PlatformAuthMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authHeader := c.GetHeader("Authorization")
+		if authHeader == "" {
+			response.UnauthorizedWithKey(c, "error.auth.no_credentials")
+			c.Abort()
+			return
+		}
+
+		parts := strings.SplitN(authHeader, " ", 2)
+		if len(parts) != 2 || parts[0] != "Bearer" {
+			response.UnauthorizedWithKey(c, "error.auth.invalid_format")
+			c.Abort()
+			return
+		}
+
+		currentIP := utils.GetClientIP(c)
+		claims, err := service.NewPlatformAuthService(c.Request.Context()).VerifyToken(parts[1], currentIP)
+		if err != nil {
+			authError(c, err)
+			return
+		}
+
+		c.Set("operator_id", claims.UserID)
+		c.Set("username", claims.Username)
+		c.Set("role", claims.Role)
+
+		actor := service.AuditActorFrom(c.Request.Context())
+		actor.Account = service.PlatformAuditAccount(claims.Username)
+		actor.IP = currentIP
+		c.Request = c.Request.WithContext(service.WithAuditActor(c.Request.Context(), actor))
+
+		c.Next()
+	}
+}
+
+// AuditContext 将请求 IP 和路由写入请求上下文，未登录的接口（登录、重置密码等）也能记录操作日志
+func AuditContext() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		actor := service.AuditActor{
+			IP:    utils.GetClientIP(c),
+			Route: c.Request.Method + " " + c.FullPath(),
+		}
+		c.Request = c.Request.WithContext(service.WithAuditActor(c.Request.Context(), actor))
+
+		c.Next()
+	}
+}
+
+// apiKeyMaxBodySize API 密钥请求的请求体上限，计算签名需要读入整个请求体；略大于商品导入文件的上限
+const apiKeyMaxBodySize = 12 << 20
+
+// APIKeyAuthMiddleware API 密钥认证中间件（HMAC 请求签名），可替代 AdminAuthMiddleware，
+// 设置相同的 mer_id 上下文，权限为密钥的授权范围，不设置 admin_id
+func APIKeyAuthMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		accessKey := c.GetHeader("X-Api-Key")
+		if accessKey == "" {
+			response.UnauthorizedWithKey(c, "error.auth.no_credentials")
+			c.Abort()
+			return
+		}
+
+		// 读取请求体用于计算签名，读取后放回供后续处理
+		var body []byte
+		if c.Request.Body != nil {
+			var err error
+			body, err = io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, apiKeyMaxBodySize))
+			if err != nil {
+				var maxErr *http.MaxBytesError
+				if errors.As(err, &maxErr) {
+					response.ErrorWithKey(c, http.StatusRequestEntityTooLarge, "error.request_too_large", map[string]interface{}{
+						"Size": apiKeyMaxBodySize >> 20,
+					})
+					c.Abort()
+					return
+				}
+				response.BadRequestWithKey(c, "error.invalid_params", map[string]interface{}{
+					"Error": err.Error(),
+				})
+				c.Abort()
+				return
+			}
+			c.Request.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		currentIP := utils.GetClientIP(c)
+
+		apiKeyService := service.NewAPIKeyService(c.Request.Context())
+		key, err := apiKeyService.Authenticate(&service.APIKeySignedRequest{
+			AccessKey: accessKey,
+			Timestamp: c.GetHeader("X-Timestamp"),
+			Nonce:     c.GetHeader("X-Nonce"),
+			Signature: c.GetHeader("X-Signature"),
+			Method:    c.Request.Method,
+			URI:       c.Request.URL.RequestURI(),
+			Body:      body,
+			IP:        currentIP,
+		})
+		if err != nil {
+			authError(c, err)
+			return
+		}
+
+		// 将商户信息存入上下文，RequirePermission 直接使用授权范围校验
+		c.Set("mer_id", uint(key.MerID))
+		c.Set("username", key.AccessKey)
+		c.Set("role", "api_key")
+		c.Set("api_key_id", key.APIKeyID)
+		c.Set("permissions", service.APIKeyScopeList(key))
+
+		actor := service.AuditActorFrom(c.Request.Context())
+		actor.MerID = key.MerID
+		actor.Account = key.AccessKey
+		actor.IP = currentIP
+		c.Request = c.Request.WithContext(service.WithAuditActor(c.Request.Context(), actor))
+
+		c.Next()
+	}
+}
+
+// AdminOrAPIKeyAuthMiddleware 请求带 X-Api-Key 时使用 API 密钥认证，否则使用管理员令牌认证
+func AdminOrAPIKeyAuthMiddleware() gin.HandlerFunc {
+	adminAuth := AdminAuthMiddleware()
+	apiKeyAuth := APIKeyAuthMiddleware()
+	return func(c *gin.Context) {
+		if c.GetHeader("X-Api-Key") != "" {
+			apiKeyAuth(c)
+			return
+		}
+		adminAuth(c)
+	}
+}
+
+// authError 认证失败响应，商户停用返回国际化消息
+func authError(c *gin.Context, err error) {
+	if errors.Is(err, service.ErrMerchantSuspended) {
+		response.ForbiddenWithKey(c, "error.auth.merchant_suspended")
+	} else {
+		response.Unauthorized(c, err.Error())
+	}
+	c.Abort()
+}
+
+// RequirePermission 权限校验中间件（需在 AdminAuthMiddleware 之后使用）
+func RequirePermission(code string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		perms, err := getPermissions(c)
+		if err != nil {
+			response.InternalServerErrorWithKey(c, "error.internal")
+			c.Abort()
+			return
+		}
+
+		if !service.HasPermission(perms, code) {
+			response.ForbiddenWithKey(c, "error.auth.insufficient_permissions")
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// RequireStepUp 敏感操作需要当前会话在有效期内通过二次验证（需在 AdminAuthMiddleware 之后使用）
+func RequireStepUp() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		adminID, _ := c.Get("admin_id")
+		adminIDUint, _ := adminID.(uint)
+		sessionID := c.GetString("session_id")
+
+		err := service.NewStepUpService(c.Request.Context()).Check(int32(adminIDUint), sessionID)
+		if err != nil {
+			if errors.Is(err, service.ErrStepUpRequired) {
+				response.ForbiddenWithKey(c, "error.auth.step_up_required")
+			} else {
+				response.InternalServerErrorWithKey(c, "error.internal")
+			}
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// HasPermission 判断当前请求的管理员是否拥有指定权限
+func HasPermission(c *gin.Context, code string) bool {
+	perms, err := getPermissions(c)
+	if err != nil {
+		return false
+	}
+	return service.HasPermission(perms, code)
+}
+
+// getPermissions 获取当前管理员的权限，同一请求内只加载一次
+func getPermissions(c *gin.Context) ([]string, error) {
+	if value, exists := c.Get("permissions"); exists {
+		if perms, ok := value.([]string); ok {
+			return perms, nil
+		}
+	}
+
+	adminID, ok := c.Get("admin_id")
+	if !ok {
+		return nil, errors.New("管理员ID不存在")
+	}
+	adminIDUint, ok := adminID.(uint)
+	if !ok {
+		return nil, errors.New("管理员ID类型错误")
+	}
+
+	perms, err := service.NewPermissionService(c.Request.Context()).GetAdminPermissions(int32(adminIDUint))
+	if err != nil {
+		return nil, err
+	}
+	c.Set("permissions", perms)
+	return perms, nil
+}
@@ -3,6 +3,7 @@ package middleware
 import (
 	pkgi18n "merchant_api/internal/pkg/i18n"
 	"merchant_api/internal/pkg/response"
+	"merchant_api/internal/pkg/utils"
 	"merchant_api/pkg/logger"
 	"net/http"
 	"strings"
@@ -31,7 +32,7 @@ func Logger() gin.HandlerFunc {
 			zap.String("path", path),
 			zap.Int("status", statusCode),
 			zap.Duration("duration", duration),
-			zap.String("ip", c.ClientIP()),
+			zap.String("ip", utils.GetClientIP(c)),
 		)
 	}
 }
@@ -16,9 +16,10 @@ type MerMerchantAdmin struct {
 	MerID           int32      `gorm:"column:mer_id;type:int unsigned;not null;comment:商户ID(属于哪一个商户)" json:"mer_id"`                                            // 商户ID(属于哪一个商户)
 	Account         string     `gorm:"column:account;type:varchar(32);not null;index:account,priority:1;comment:商户管理员账号" json:"account"`                        // 商户管理员账号
 	Pwd             string     `gorm:"column:pwd;type:char(64);not null;comment:商户管理员密码" json:"pwd"`                                                            // 商户管理员密码
-	RealName        string     `gorm:"column:real_name;type:varchar(16);not null;comment:商户管理员姓名" json:"real_name"`                                             // 商户管理员姓名
-	Phone           *string    `gorm:"column:phone;type:varchar(13);index:phone,priority:1;comment:商户管理员手机号" json:"phone"`                                      // 商户管理员手机号
-	LastIP          *string    `gorm:"column:last_ip;type:varchar(16);comment:商户管理员最后一次登录IP地址" json:"last_ip"`                                                  // 商户管理员最后一次登录IP地址
+	RealName        string     `gorm:"column:real_name;type:varchar(255);not null;serializer:encrypted;comment:商户管理员姓名(加密)" json:"real_name"`                   // 商户管理员姓名(加密)
+	Phone           *string    `gorm:"column:phone;type:varchar(255);serializer:encrypted;comment:商户管理员手机号(加密)" json:"phone"`                                   // 商户管理员手机号(加密)
+	PhoneHash       *string    `gorm:"column:phone_hash;type:char(64);index:phone_hash,priority:1;comment:商户管理员手机号盲索引" json:"-"`                                // 商户管理员手机号盲索引
+	LastIP          *string    `gorm:"column:last_ip;type:varchar(45);comment:商户管理员最后一次登录IP地址" json:"last_ip"`                                                  // 商户管理员最后一次登录IP地址
 	LastTime        *time.Time `gorm:"column:last_time;type:timestamp;default:CURRENT_TIMESTAMP;comment:商户管理员最后一次登录时间" json:"last_time"`                        // 商户管理员最后一次登录时间
 	Roles           *string    `gorm:"column:roles;type:varchar(128)" json:"roles"`
 	LoginCount      int32      `gorm:"column:login_count;type:int unsigned;not null;comment:商户管理员登录次数" json:"login_count"`                    // 商户管理员登录次数
@@ -9,12 +9,16 @@ const TableNameMerStoreProductSku = "mer_store_product_sku"
 // MerStoreProductSku mapped from table <mer_store_product_sku>
 type MerStoreProductSku struct {
 	ProductSkuID int32    `gorm:"column:product_sku_id;type:int;primaryKey;autoIncrement:true" json:"product_sku_id"`
-	ProductID    int32    `gorm:"column:product_id;type:int unsigned;not null" json:"product_id"`
-	AttrName     *string  `gorm:"column:attr_name;type:varchar(255);comment:商品属性" json:"attr_name"`                // 商品属性
-	Price        *float64 `gorm:"column:price;type:decimal(10,2) unsigned;default:0.00;comment:最低价格" json:"price"` // 最低价格
-	Cost         *float64 `gorm:"column:cost;type:decimal(10,2);default:0.00;comment:成本价" json:"cost"`             // 成本价
-	OtPrice      *float64 `gorm:"column:ot_price;type:decimal(10,2);default:0.00;comment:原价" json:"ot_price"`      // 原价
-	Image        *string  `gorm:"column:image;type:varchar(255);comment:属性图片" json:"image"`                        // 属性图片
+	ProductID    int32    `gorm:"column:product_id;type:int unsigned;not null;uniqueIndex:product_id_spec_key,priority:1" json:"product_id"`
+	AttrName     *string  `gorm:"column:attr_name;type:varchar(255);comment:商品属性" json:"attr_name"`                                                                   // 商品属性
+	SpecKey      *string  `gorm:"column:spec_key;type:varchar(64);uniqueIndex:product_id_spec_key,priority:2;comment:规格值ID组合，按规格组顺序以逗号分隔，没有规格时为NULL" json:"spec_key"` // 规格值ID组合，按规格组顺序以逗号分隔，没有规格时为NULL
+	Price        *float64 `gorm:"column:price;type:decimal(10,2) unsigned;default:0.00;comment:最低价格" json:"price"`                                                    // 最低价格
+	Cost         *float64 `gorm:"column:cost;type:decimal(10,2);default:0.00;comment:成本价" json:"cost"`                                                                // 成本价
+	OtPrice      *float64 `gorm:"column:ot_price;type:decimal(10,2);default:0.00;comment:原价" json:"ot_price"`                                                         // 原价
+	Image        *string  `gorm:"column:image;type:varchar(255);comment:属性图片" json:"image"`                                                                           // 属性图片
+	Stock        *int32   `gorm:"column:stock;type:int unsigned;comment:库存，NULL表示未录入库存，不限制销售" json:"stock"`
+	Reserved     int32    `gorm:"column:reserved;type:int unsigned;not null;comment:已预占数量" json:"reserved"`
+	LowStock     int32    `gorm:"column:low_stock;type:int unsigned;not null;comment:低库存预警值，0表示不预警" json:"low_stock"`
 }
 
 // TableName MerStoreProductSku's table name
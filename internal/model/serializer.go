@@ -0,0 +1,4 @@
+package model
+
+// 注册模型使用的 GORM 序列化器（serializer:encrypted）
+import _ "merchant_api/internal/pkg/fieldcrypt"
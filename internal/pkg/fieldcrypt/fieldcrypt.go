@@ -0,0 +1,297 @@
+// Package fieldcrypt 敏感字段加密（AES-256-GCM，信封加密）。
+//
+// 数据密钥（DEK）使用主密钥（KEK）加密后保存在配置中，启动时解密到内存；
+// 密文格式为 enc:v<版本>:<base64(nonce || 密文)>，按版本号选择数据密钥，支持轮换。
+// 没有前缀的值视为尚未加密的旧数据，原样返回，由 cmd/reencrypt 批量加密。
+package fieldcrypt
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"merchant_api/pkg/config"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	prefix  = "enc:v"
+	keySize = 32 // AES-256
+)
+
+var (
+	mu            sync.RWMutex
+	masterKey     cipher.AEAD
+	dataKeys      map[int]cipher.AEAD
+	rawDataKeys   map[int][]byte // 用于更换主密钥时重新加密数据密钥
+	activeVersion int
+	blindIndexKey []byte
+)
+
+// ErrNotInitialized 未加载加密密钥
+var ErrNotInitialized = errors.New("字段加密密钥未初始化")
+
+// Init 加载主密钥和数据密钥
+func Init(cfg config.CryptoConfig) error {
+	kek, err := loadMasterKey(cfg)
+	if err != nil {
+		return err
+	}
+	master, err := newAEAD(kek)
+	if err != nil {
+		return err
+	}
+
+	keys := make(map[int]cipher.AEAD, len(cfg.DataKeys))
+	rawKeys := make(map[int][]byte, len(cfg.DataKeys))
+	for _, item := range cfg.DataKeys {
+		if item.Version <= 0 {
+			return fmt.Errorf("数据密钥版本号必须大于 0: %d", item.Version)
+		}
+		if _, ok := keys[item.Version]; ok {
+			return fmt.Errorf("数据密钥版本重复: %d", item.Version)
+		}
+		dek, err := unwrap(master, item.Key)
+		if err != nil {
+			return fmt.Errorf("解密数据密钥 v%d 失败: %w", item.Version, err)
+		}
+		if keys[item.Version], err = newAEAD(dek); err != nil {
+			return err
+		}
+		rawKeys[item.Version] = dek
+	}
+	if _, ok := keys[cfg.ActiveVersion]; !ok {
+		return fmt.Errorf("当前数据密钥版本 v%d 未配置", cfg.ActiveVersion)
+	}
+
+	indexKey, err := unwrap(master, cfg.BlindIndexKey)
+	if err != nil {
+		return fmt.Errorf("解密盲索引密钥失败: %w", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	masterKey = master
+	dataKeys = keys
+	rawDataKeys = rawKeys
+	activeVersion = cfg.ActiveVersion
+	blindIndexKey = indexKey
+	return nil
+}
+
+// Encrypt 使用当前版本的数据密钥加密，空字符串不加密
+func Encrypt(plain string) (string, error) {
+	if plain == "" {
+		return "", nil
+	}
+
+	mu.RLock()
+	version := activeVersion
+	aead := dataKeys[version]
+	mu.RUnlock()
+	if aead == nil {
+		return "", ErrNotInitialized
+	}
+
+	sealed, err := seal(aead, []byte(plain))
+	if err != nil {
+		return "", err
+	}
+	return prefix + strconv.Itoa(version) + ":" + sealed, nil
+}
+
+// Decrypt 解密，没有加密前缀的值原样返回
+func Decrypt(value string) (string, error) {
+	version, payload, ok := parse(value)
+	if !ok {
+		return value, nil
+	}
+
+	mu.RLock()
+	aead, initialized := dataKeys[version], dataKeys != nil
+	mu.RUnlock()
+	if !initialized {
+		return "", ErrNotInitialized
+	}
+	if aead == nil {
+		return "", fmt.Errorf("数据密钥 v%d 未配置", version)
+	}
+
+	plain, err := open(aead, payload)
+	if err != nil {
+		return "", err
+	}
+	return string(plain), nil
+}
+
+// IsCurrent 值是否已使用当前版本的数据密钥加密（空值视为已加密）
+func IsCurrent(value string) bool {
+	if value == "" {
+		return true
+	}
+	version, _, ok := parse(value)
+	mu.RLock()
+	defer mu.RUnlock()
+	return ok && version == activeVersion
+}
+
+// BlindIndex 计算盲索引 hex(HMAC-SHA256(盲索引密钥, value))，用于按加密字段精确查询；空值返回空字符串
+func BlindIndex(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	mu.RLock()
+	key := blindIndexKey
+	mu.RUnlock()
+	if key == nil {
+		return "", ErrNotInitialized
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// GenerateKey 生成新的随机密钥并用主密钥加密，用于配置 data_keys 和 blind_index_key
+func GenerateKey() (string, error) {
+	mu.RLock()
+	master := masterKey
+	mu.RUnlock()
+	if master == nil {
+		return "", ErrNotInitialized
+	}
+
+	key := make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		return "", fmt.Errorf("生成密钥失败: %w", err)
+	}
+	return seal(master, key)
+}
+
+// Rewrap 使用新的主密钥重新加密所有数据密钥和盲索引密钥（更换主密钥时使用），返回新的配置值
+func Rewrap(newMasterKey string) (map[int]string, string, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(newMasterKey))
+	if err != nil {
+		return nil, "", fmt.Errorf("主密钥格式错误: %w", err)
+	}
+	master, err := newAEAD(key)
+	if err != nil {
+		return nil, "", err
+	}
+
+	mu.RLock()
+	defer mu.RUnlock()
+	if rawDataKeys == nil {
+		return nil, "", ErrNotInitialized
+	}
+
+	wrapped := make(map[int]string, len(rawDataKeys))
+	for version, dek := range rawDataKeys {
+		if wrapped[version], err = seal(master, dek); err != nil {
+			return nil, "", err
+		}
+	}
+	index, err := seal(master, blindIndexKey)
+	if err != nil {
+		return nil, "", err
+	}
+	return wrapped, index, nil
+}
+
+// loadMasterKey 读取主密钥，优先使用 master_key_file
+func loadMasterKey(cfg config.CryptoConfig) ([]byte, error) {
+	encoded := cfg.MasterKey
+	if cfg.MasterKeyFile != "" {
+		data, err := os.ReadFile(cfg.MasterKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("读取主密钥文件失败: %w", err)
+		}
+		encoded = string(data)
+	}
+	if strings.TrimSpace(encoded) == "" {
+		return nil, errors.New("未配置主密钥")
+	}
+
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("主密钥格式错误: %w", err)
+	}
+	return key, nil
+}
+
+// unwrap 使用主密钥解密数据密钥
+func unwrap(master cipher.AEAD, wrapped string) ([]byte, error) {
+	if wrapped == "" {
+		return nil, errors.New("密钥为空")
+	}
+	key, err := open(master, wrapped)
+	if err != nil {
+		return nil, err
+	}
+	if len(key) != keySize {
+		return nil, fmt.Errorf("密钥长度必须为 %d 字节", keySize)
+	}
+	return key, nil
+}
+
+// parse 解析密文前缀，返回数据密钥版本和 base64 内容
+func parse(value string) (int, string, bool) {
+	if !strings.HasPrefix(value, prefix) {
+		return 0, "", false
+	}
+	rest := value[len(prefix):]
+	idx := strings.IndexByte(rest, ':')
+	if idx <= 0 {
+		return 0, "", false
+	}
+	version, err := strconv.Atoi(rest[:idx])
+	if err != nil {
+		return 0, "", false
+	}
+	return version, rest[idx+1:], true
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != keySize {
+		return nil, fmt.Errorf("密钥长度必须为 %d 字节", keySize)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("初始化加密失败: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal 加密并返回 base64(nonce || 密文)
+func seal(aead cipher.AEAD, plain []byte) (string, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("生成随机数失败: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(aead.Seal(nonce, nonce, plain, nil)), nil
+}
+
+// open 解密 base64(nonce || 密文)
+func open(aead cipher.AEAD, encoded string) ([]byte, error) {
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("密文格式错误: %w", err)
+	}
+	if len(data) < aead.NonceSize()+aead.Overhead() {
+		return nil, errors.New("密文长度错误")
+	}
+	nonce, ciphertext := data[:aead.NonceSize()], data[aead.NonceSize():]
+	plain, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, errors.New("解密失败，密钥错误或数据已损坏")
+	}
+	return plain, nil
+}
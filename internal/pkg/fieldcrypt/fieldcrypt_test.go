@@ -0,0 +1,190 @@
+package fieldcrypt
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"merchant_api/pkg/config"
+)
+
+// testKeys 测试用主密钥及使用主密钥加密的数据密钥
+type testKeys struct {
+	master     string
+	dataKeys   map[int]string
+	blindIndex string
+}
+
+func newTestKeys(t *testing.T, versions ...int) *testKeys {
+	t.Helper()
+	raw := make([]byte, keySize)
+	rand.Read(raw)
+	master, err := newAEAD(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wrap := func() string {
+		key := make([]byte, keySize)
+		rand.Read(key)
+		wrapped, err := seal(master, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return wrapped
+	}
+	keys := &testKeys{master: base64.StdEncoding.EncodeToString(raw), dataKeys: make(map[int]string), blindIndex: wrap()}
+	for _, version := range versions {
+		keys.dataKeys[version] = wrap()
+	}
+	return keys
+}
+
+// init 使用指定的数据密钥版本初始化，active 为当前版本
+func (k *testKeys) init(t *testing.T, active int, versions ...int) {
+	t.Helper()
+	cfg := config.CryptoConfig{MasterKey: k.master, ActiveVersion: active, BlindIndexKey: k.blindIndex}
+	for _, version := range versions {
+		cfg.DataKeys = append(cfg.DataKeys, config.CryptoKeyConfig{Version: version, Key: k.dataKeys[version]})
+	}
+	if err := Init(cfg); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+}
+
+func mustEncrypt(t *testing.T, plain string) string {
+	t.Helper()
+	value, err := Encrypt(plain)
+	if err != nil {
+		t.Fatalf("Encrypt(%q) error = %v", plain, err)
+	}
+	return value
+}
+
+func TestEncryptDecryptAcrossVersions(t *testing.T) {
+	keys := newTestKeys(t, 1, 2)
+
+	keys.init(t, 1, 1)
+	v1 := mustEncrypt(t, "13800138000")
+	if !strings.HasPrefix(v1, "enc:v1:") {
+		t.Fatalf("Encrypt() = %s, want enc:v1: prefix", v1)
+	}
+	tampered := v1[:len(v1)-2] + "AA"
+	if tampered == v1 {
+		tampered = v1[:len(v1)-2] + "BB"
+	}
+
+	// 轮换：新增 v2 并设为当前版本，v1 的密文仍可解密
+	keys.init(t, 2, 1, 2)
+	v2 := mustEncrypt(t, "13800138000")
+	if !strings.HasPrefix(v2, "enc:v2:") {
+		t.Fatalf("Encrypt() = %s, want enc:v2: prefix", v2)
+	}
+
+	tests := []struct {
+		name        string
+		value       string
+		want        string
+		wantErr     bool
+		wantCurrent bool
+	}{
+		{"旧版本密文", v1, "13800138000", false, false},
+		{"当前版本密文", v2, "13800138000", false, true},
+		{"未加密的旧数据原样返回", "13800138000", "13800138000", false, false},
+		{"空值", "", "", false, true},
+		{"密文被修改", tampered, "", true, false},
+		{"未配置的版本", "enc:v3:" + v2[len("enc:v2:"):], "", true, false},
+		{"不是 base64", "enc:v2:not base64", "", true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Decrypt(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Decrypt() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Decrypt() = %q, want %q", got, tt.want)
+			}
+			if current := IsCurrent(tt.value); current != tt.wantCurrent {
+				t.Errorf("IsCurrent() = %v, want %v", current, tt.wantCurrent)
+			}
+		})
+	}
+
+	// 删除旧版本后，v1 的密文不能再解密
+	keys.init(t, 2, 2)
+	if _, err := Decrypt(v1); err == nil {
+		t.Error("Decrypt() with removed v1 error = nil, want error")
+	}
+	if got, err := Decrypt(v2); err != nil || got != "13800138000" {
+		t.Errorf("Decrypt() = %q, %v, want 13800138000", got, err)
+	}
+}
+
+func TestEncryptUsesRandomNonce(t *testing.T) {
+	newTestKeys(t, 1).init(t, 1, 1)
+	if a, b := mustEncrypt(t, "same"), mustEncrypt(t, "same"); a == b {
+		t.Errorf("Encrypt() returned identical ciphertexts %s", a)
+	}
+	if value := mustEncrypt(t, ""); value != "" {
+		t.Errorf("Encrypt(\"\") = %q, want empty", value)
+	}
+}
+
+func TestRewrap(t *testing.T) {
+	keys := newTestKeys(t, 1, 2)
+	keys.init(t, 2, 1, 2)
+	encrypted := mustEncrypt(t, "6222020200112233")
+	index, _ := BlindIndex("6222020200112233")
+
+	raw := make([]byte, keySize)
+	rand.Read(raw)
+	newMaster := base64.StdEncoding.EncodeToString(raw)
+	dataKeys, blindIndexKey, err := Rewrap(newMaster)
+	if err != nil {
+		t.Fatalf("Rewrap() error = %v", err)
+	}
+
+	// 旧主密钥不能解密新配置
+	rewrapped := &testKeys{master: keys.master, dataKeys: dataKeys, blindIndex: blindIndexKey}
+	cfg := config.CryptoConfig{MasterKey: rewrapped.master, ActiveVersion: 2, BlindIndexKey: blindIndexKey,
+		DataKeys: []config.CryptoKeyConfig{{Version: 2, Key: dataKeys[2]}}}
+	if err := Init(cfg); err == nil {
+		t.Error("Init() with old master key error = nil, want error")
+	}
+
+	rewrapped.master = newMaster
+	rewrapped.init(t, 2, 1, 2)
+	if got, err := Decrypt(encrypted); err != nil || got != "6222020200112233" {
+		t.Errorf("Decrypt() after rewrap = %q, %v", got, err)
+	}
+	if got, _ := BlindIndex("6222020200112233"); got != index {
+		t.Errorf("BlindIndex() after rewrap = %s, want %s", got, index)
+	}
+}
+
+func TestInitInvalidConfig(t *testing.T) {
+	keys := newTestKeys(t, 1)
+	tests := []struct {
+		name string
+		cfg  config.CryptoConfig
+	}{
+		{"没有主密钥", config.CryptoConfig{ActiveVersion: 1, BlindIndexKey: keys.blindIndex,
+			DataKeys: []config.CryptoKeyConfig{{Version: 1, Key: keys.dataKeys[1]}}}},
+		{"当前版本未配置", config.CryptoConfig{MasterKey: keys.master, ActiveVersion: 2, BlindIndexKey: keys.blindIndex,
+			DataKeys: []config.CryptoKeyConfig{{Version: 1, Key: keys.dataKeys[1]}}}},
+		{"版本重复", config.CryptoConfig{MasterKey: keys.master, ActiveVersion: 1, BlindIndexKey: keys.blindIndex,
+			DataKeys: []config.CryptoKeyConfig{{Version: 1, Key: keys.dataKeys[1]}, {Version: 1, Key: keys.dataKeys[1]}}}},
+		{"版本号为 0", config.CryptoConfig{MasterKey: keys.master, ActiveVersion: 0, BlindIndexKey: keys.blindIndex,
+			DataKeys: []config.CryptoKeyConfig{{Version: 0, Key: keys.dataKeys[1]}}}},
+		{"没有盲索引密钥", config.CryptoConfig{MasterKey: keys.master, ActiveVersion: 1,
+			DataKeys: []config.CryptoKeyConfig{{Version: 1, Key: keys.dataKeys[1]}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Init(tt.cfg); err == nil {
+				t.Error("Init() error = nil, want error")
+			}
+		})
+	}
+}
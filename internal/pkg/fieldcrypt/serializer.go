@@ -0,0 +1,70 @@
+package fieldcrypt
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"gorm.io/gorm/schema"
+)
+
+// SerializerName 模型字段标签 serializer:encrypted
+const SerializerName = "encrypted"
+
+func init() {
+	schema.RegisterSerializer(SerializerName, Serializer{})
+}
+
+// Serializer GORM 序列化器：写入时加密，读取时解密，支持 string、*string 及以 string 为底层类型的字段。
+// 注意：Where 条件和 map 形式的 Updates 不经过序列化器，
+// 查询请使用盲索引字段，map 更新需先调用 Encrypt
+type Serializer struct{}
+
+// Scan 读取时解密
+func (Serializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue interface{}) error {
+	fieldValue := reflect.New(field.FieldType).Elem()
+	if dbValue != nil {
+		var raw string
+		switch v := dbValue.(type) {
+		case []byte:
+			raw = string(v)
+		case string:
+			raw = v
+		default:
+			return fmt.Errorf("加密字段 %s 类型错误: %T", field.Name, dbValue)
+		}
+
+		plain, err := Decrypt(raw)
+		if err != nil {
+			return fmt.Errorf("解密字段 %s 失败: %w", field.Name, err)
+		}
+		if fieldValue.Kind() == reflect.Ptr {
+			ptr := reflect.New(field.FieldType.Elem())
+			ptr.Elem().SetString(plain)
+			fieldValue.Set(ptr)
+		} else {
+			fieldValue.SetString(plain)
+		}
+	}
+
+	field.ReflectValueOf(ctx, dst).Set(fieldValue)
+	return nil
+}
+
+// Value 写入时加密，nil 指针写入 NULL
+func (Serializer) Value(ctx context.Context, field *schema.Field, dst reflect.Value, fieldValue interface{}) (interface{}, error) {
+	if fieldValue == nil {
+		return nil, nil
+	}
+	rv := reflect.ValueOf(fieldValue)
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil, nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.String {
+		return nil, fmt.Errorf("加密字段 %s 必须是字符串类型", field.Name)
+	}
+	return Encrypt(rv.String())
+}
@@ -2,44 +2,127 @@ package jwt
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// 令牌角色，不同角色的令牌只能访问对应的接口
+const (
+	RoleAdmin    = "admin"    // 商户管理员
+	RolePlatform = "platform" // 平台运营
+)
+
 // Claims JWT 声明
 type Claims struct {
-	UserID   uint   `json:"user_id"`
-	MerID    uint   `json:"mer_id"`
-	Username string `json:"username"`
-	Role     string `json:"role"` // admin/user
+	UserID    uint   `json:"user_id"`
+	MerID     uint   `json:"mer_id"`
+	Username  string `json:"username"`
+	Role      string `json:"role"`          // RoleAdmin / RolePlatform
+	SessionID string `json:"sid,omitempty"` // 登录会话ID（刷新令牌族）
 	jwt.RegisteredClaims
 }
 
-// GenerateToken 生成 JWT Token
-func GenerateToken(userID, merID uint, username, role, secret string, expire int) (string, error) {
+// signingMethod 算法对应的签名方法
+func signingMethod(alg string) (jwt.SigningMethod, error) {
+	switch alg {
+	case AlgRS256:
+		return jwt.SigningMethodRS256, nil
+	case AlgEdDSA:
+		return jwt.SigningMethodEdDSA, nil
+	case AlgHS256:
+		return jwt.SigningMethodHS256, nil
+	}
+	return nil, fmt.Errorf("不支持的算法: %s", alg)
+}
+
+// GenerateToken 使用默认密钥集合中当前的签名密钥生成 JWT Token，header 中带 kid
+func GenerateToken(userID, merID uint, username, role, sessionID string, expire int) (string, error) {
+	ks := Default()
+	if ks == nil {
+		return "", errors.New("JWT 密钥未初始化")
+	}
+	return ks.Sign(userID, merID, username, role, sessionID, expire)
+}
+
+// ParseToken 使用默认密钥集合解析并验证 JWT Token
+func ParseToken(tokenString string) (*Claims, error) {
+	ks := Default()
+	if ks == nil {
+		return nil, errors.New("JWT 密钥未初始化")
+	}
+	return ks.Parse(tokenString)
+}
+
+// Sign 生成 JWT Token
+func (ks *KeySet) Sign(userID, merID uint, username, role, sessionID string, expire int) (string, error) {
+	now := time.Now()
+	key, err := ks.SigningKey(now)
+	if err != nil {
+		return "", err
+	}
+	method, err := signingMethod(key.Algorithm)
+	if err != nil {
+		return "", err
+	}
+
 	claims := Claims{
-		UserID:   userID,
-		MerID:    merID,
-		Username: username,
-		Role:     role,
+		UserID:    userID,
+		MerID:     merID,
+		Username:  username,
+		Role:      role,
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Duration(expire) * time.Second)),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			Issuer:    "merchant_api",
+			ExpiresAt: jwt.NewNumericDate(now.Add(time.Duration(expire) * time.Second)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			Issuer:    ks.issuer,
 		},
 	}
+	if ks.audience != "" {
+		claims.Audience = jwt.ClaimStrings{ks.audience}
+	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(secret))
+	token := jwt.NewWithClaims(method, claims)
+	if key.ID != legacyKeyID {
+		token.Header["kid"] = key.ID
+	}
+	return token.SignedString(key.Signer)
 }
 
-// ParseToken 解析 JWT Token
-func ParseToken(tokenString, secret string) (*Claims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		return []byte(secret), nil
-	})
+// Parse 解析并验证 JWT Token：
+// 按 kid 选择密钥，header 中的算法必须与密钥的算法一致（拒绝 none 和算法混淆），
+// 并校验签发者、受众和过期时间
+func (ks *KeySet) Parse(tokenString string) (*Claims, error) {
+	now := time.Now()
+
+	var key *Key
+	keyFunc := func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		var err error
+		key, err = ks.VerificationKey(kid, now)
+		if err != nil {
+			return nil, err
+		}
+		if token.Method.Alg() != key.Algorithm {
+			return nil, fmt.Errorf("算法不匹配: %s", token.Method.Alg())
+		}
+		return key.PublicKey, nil
+	}
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{AlgRS256, AlgEdDSA, AlgHS256}),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+	}
+	if ks.issuer != "" {
+		opts = append(opts, jwt.WithIssuer(ks.issuer))
+	}
+	if ks.audience != "" {
+		opts = append(opts, jwt.WithAudience(ks.audience))
+	}
 
+	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, keyFunc, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,204 @@
+package jwt
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"merchant_api/pkg/config"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	testIssuer   = "merchant_api"
+	testAudience = "mer_admin"
+)
+
+// writePEM 把密钥写入临时目录下的 PEM 文件
+func writePEM(t *testing.T, name, typ string, der []byte) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestKeySetParse(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaDER, _ := x509.MarshalPKCS8PrivateKey(rsaKey)
+	rsaPubDER, _ := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	rsaPubPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: rsaPubDER})
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edDER, _ := x509.MarshalPKCS8PrivateKey(edKey)
+
+	ks, err := NewKeySet(config.JWTConfig{
+		Expire:   3600,
+		Issuer:   testIssuer,
+		Audience: testAudience,
+		Keys: []config.JWTKeyConfig{
+			{KID: "rsa-1", Algorithm: AlgRS256, PrivateKeyFile: writePEM(t, "rsa.pem", "PRIVATE KEY", rsaDER)},
+			{KID: "ed-1", Algorithm: AlgEdDSA, PrivateKeyFile: writePEM(t, "ed.pem", "PRIVATE KEY", edDER)},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewKeySet() error = %v", err)
+	}
+
+	now := time.Now()
+	claims := func(mutate func(c *Claims)) *Claims {
+		c := &Claims{
+			UserID: 1,
+			MerID:  2,
+			Role:   RoleAdmin,
+			RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    testIssuer,
+				Audience:  jwt.ClaimStrings{testAudience},
+				IssuedAt:  jwt.NewNumericDate(now),
+				ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour)),
+			},
+		}
+		if mutate != nil {
+			mutate(c)
+		}
+		return c
+	}
+	sign := func(method jwt.SigningMethod, kid string, c *Claims, key interface{}) string {
+		token := jwt.NewWithClaims(method, c)
+		if kid != "" {
+			token.Header["kid"] = kid
+		}
+		s, err := token.SignedString(key)
+		if err != nil {
+			t.Fatalf("sign: %v", err)
+		}
+		return s
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{"RS256", sign(jwt.SigningMethodRS256, "rsa-1", claims(nil), rsaKey), false},
+		{"EdDSA", sign(jwt.SigningMethodEdDSA, "ed-1", claims(nil), edKey), false},
+		{"none 算法", sign(jwt.SigningMethodNone, "rsa-1", claims(nil), jwt.UnsafeAllowNoneSignatureType), true},
+		{"用 RSA 公钥作为 HS256 密钥", sign(jwt.SigningMethodHS256, "rsa-1", claims(nil), rsaPubPEM), true},
+		{"kid 与算法不一致", sign(jwt.SigningMethodEdDSA, "rsa-1", claims(nil), edKey), true},
+		{"签名密钥与 kid 不一致", sign(jwt.SigningMethodEdDSA, "ed-1", claims(nil), ed25519.NewKeyFromSeed(make([]byte, 32))), true},
+		{"未知 kid", sign(jwt.SigningMethodRS256, "rsa-2", claims(nil), rsaKey), true},
+		{"没有 kid", sign(jwt.SigningMethodRS256, "", claims(nil), rsaKey), true},
+		{"签发者错误", sign(jwt.SigningMethodRS256, "rsa-1", claims(func(c *Claims) { c.Issuer = "other" }), rsaKey), true},
+		{"受众错误", sign(jwt.SigningMethodRS256, "rsa-1", claims(func(c *Claims) { c.Audience = jwt.ClaimStrings{"other"} }), rsaKey), true},
+		{"已过期", sign(jwt.SigningMethodRS256, "rsa-1", claims(func(c *Claims) { c.ExpiresAt = jwt.NewNumericDate(now.Add(-time.Minute)) }), rsaKey), true},
+		{"没有过期时间", sign(jwt.SigningMethodRS256, "rsa-1", claims(func(c *Claims) { c.ExpiresAt = nil }), rsaKey), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ks.Parse(tt.token)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Parse() = %+v, want error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if got.UserID != 1 || got.MerID != 2 || got.Role != RoleAdmin {
+				t.Errorf("Parse() claims = %+v", got)
+			}
+		})
+	}
+}
+
+func TestLegacyKeySetParse(t *testing.T) {
+	ks, err := NewKeySet(config.JWTConfig{Secret: "legacy-secret", Expire: 3600})
+	if err != nil {
+		t.Fatalf("NewKeySet() error = %v", err)
+	}
+	signed, err := ks.Sign(1, 2, "boss", RoleAdmin, "", 3600)
+	if err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+
+	now := time.Now()
+	valid := &Claims{RegisteredClaims: jwt.RegisteredClaims{
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour)),
+	}}
+	none, _ := jwt.NewWithClaims(jwt.SigningMethodNone, valid).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	wrongSecret, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, valid).SignedString([]byte("other-secret"))
+	hs512, _ := jwt.NewWithClaims(jwt.SigningMethodHS512, valid).SignedString([]byte("legacy-secret"))
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{"HS256", signed, false},
+		{"none 算法", none, true},
+		{"密钥错误", wrongSecret, true},
+		{"HS512", hs512, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ks.Parse(tt.token)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRetiredKeyGrace(t *testing.T) {
+	now := time.Now()
+	cfg := config.JWTConfig{
+		Expire: 1800,
+		Keys: []config.JWTKeyConfig{{
+			KID:       "old",
+			Algorithm: AlgHS256,
+			Secret:    "old-secret",
+			RetireAt:  now.Add(-2 * time.Hour).Format(time.RFC3339),
+		}},
+	}
+
+	tests := []struct {
+		name      string
+		lifetimes []int
+		at        time.Time
+		wantErr   bool
+	}{
+		{"访问令牌有效期内", nil, now.Add(-100 * time.Minute), false},
+		{"超过访问令牌有效期", nil, now, true},
+		{"运营令牌有效期内", []int{43200}, now, false},
+		{"超过运营令牌有效期", []int{43200}, now.Add(11 * time.Hour), true},
+		{"较短的有效期不缩短宽限期", []int{60}, now.Add(-100 * time.Minute), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ks, err := NewKeySet(cfg, tt.lifetimes...)
+			if err != nil {
+				t.Fatalf("NewKeySet() error = %v", err)
+			}
+			_, err = ks.VerificationKey("old", tt.at)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("VerificationKey() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,271 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"merchant_api/pkg/config"
+	"os"
+	"sort"
+	"time"
+)
+
+// 支持的签名算法
+const (
+	AlgRS256 = "RS256"
+	AlgEdDSA = "EdDSA"
+	AlgHS256 = "HS256" // 对称密钥，只用于兼容旧配置，不会出现在 JWKS 中
+)
+
+// legacyKeyID 未配置 keys 时，使用 jwt.secret 的 HS256 密钥ID
+const legacyKeyID = "default"
+
+// Key 签名密钥
+type Key struct {
+	ID         string
+	Algorithm  string
+	Signer     interface{}      // 签名用私钥：*rsa.PrivateKey / ed25519.PrivateKey / []byte，只用于验证的密钥为 nil
+	PublicKey  crypto.PublicKey // 验证用公钥：*rsa.PublicKey / ed25519.PublicKey / []byte
+	ActiveFrom time.Time        // 开始用于签名的时间
+	RetireAt   time.Time        // 停止签名的时间，零值表示不停止
+}
+
+// canSign 指定时间是否可以用于签名
+func (k *Key) canSign(now time.Time) bool {
+	return k.Signer != nil && !now.Before(k.ActiveFrom) && (k.RetireAt.IsZero() || now.Before(k.RetireAt))
+}
+
+// canVerify 指定时间是否可以用于验证：停止签名后，在令牌最长有效期内仍可验证已签发的令牌
+func (k *Key) canVerify(now time.Time, grace time.Duration) bool {
+	return k.RetireAt.IsZero() || now.Before(k.RetireAt.Add(grace))
+}
+
+// KeySet 按 kid 管理的密钥集合，支持多个密钥同时有效，用于无感轮换
+type KeySet struct {
+	keys     map[string]*Key
+	issuer   string
+	audience string
+	grace    time.Duration // 停止签名后仍可验证的时间（用该密钥集合签发的令牌的最长有效期）
+}
+
+var defaultKeySet *KeySet
+
+// Init 根据配置初始化默认密钥集合，lifetimes 见 NewKeySet
+func Init(cfg config.JWTConfig, lifetimes ...int) error {
+	ks, err := NewKeySet(cfg, lifetimes...)
+	if err != nil {
+		return err
+	}
+	defaultKeySet = ks
+	return nil
+}
+
+// Default 返回默认密钥集合
+func Default() *KeySet {
+	return defaultKeySet
+}
+
+// NewKeySet 根据配置加载密钥；未配置 keys 时使用 jwt.secret 作为 HS256 密钥（兼容旧配置）。
+// lifetimes 为同样用该密钥集合签发、但有效期不是 jwt.expire 的令牌的有效期（秒），例如平台运营令牌，
+// 密钥停止签名后按其中最长的有效期继续验证，避免轮换时提前失效
+func NewKeySet(cfg config.JWTConfig, lifetimes ...int) (*KeySet, error) {
+	maxLifetime := cfg.Expire
+	for _, lifetime := range lifetimes {
+		if lifetime > maxLifetime {
+			maxLifetime = lifetime
+		}
+	}
+	ks := &KeySet{
+		keys:     make(map[string]*Key),
+		issuer:   cfg.Issuer,
+		audience: cfg.Audience,
+		grace:    time.Duration(maxLifetime) * time.Second,
+	}
+
+	if len(cfg.Keys) == 0 {
+		if cfg.Secret == "" {
+			return nil, errors.New("未配置 JWT 密钥")
+		}
+		secret := []byte(cfg.Secret)
+		ks.keys[legacyKeyID] = &Key{ID: legacyKeyID, Algorithm: AlgHS256, Signer: secret, PublicKey: secret}
+		return ks, nil
+	}
+
+	for _, kc := range cfg.Keys {
+		key, err := loadKey(kc)
+		if err != nil {
+			return nil, fmt.Errorf("加载 JWT 密钥 %s 失败: %w", kc.KID, err)
+		}
+		if _, exists := ks.keys[key.ID]; exists {
+			return nil, fmt.Errorf("JWT 密钥ID重复: %s", key.ID)
+		}
+		ks.keys[key.ID] = key
+	}
+	return ks, nil
+}
+
+// SigningKey 返回当前用于签名的密钥：可签名的密钥中开始时间最晚的一个
+func (ks *KeySet) SigningKey(now time.Time) (*Key, error) {
+	var current *Key
+	for _, key := range ks.keys {
+		if !key.canSign(now) {
+			continue
+		}
+		if current == nil || key.ActiveFrom.After(current.ActiveFrom) {
+			current = key
+		}
+	}
+	if current == nil {
+		return nil, errors.New("没有可用的 JWT 签名密钥")
+	}
+	return current, nil
+}
+
+// VerificationKey 按 kid 查找验证密钥
+func (ks *KeySet) VerificationKey(kid string, now time.Time) (*Key, error) {
+	if kid == "" {
+		kid = legacyKeyID
+	}
+	key, ok := ks.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("未知的密钥ID: %s", kid)
+	}
+	if !key.canVerify(now, ks.grace) {
+		return nil, fmt.Errorf("密钥已停用: %s", kid)
+	}
+	return key, nil
+}
+
+// publicKeys 返回可公开的验证密钥（非对称密钥，包括尚未开始签名的密钥，方便验证方提前缓存），按 kid 排序
+func (ks *KeySet) publicKeys(now time.Time) []*Key {
+	keys := make([]*Key, 0, len(ks.keys))
+	for _, key := range ks.keys {
+		if key.Algorithm == AlgHS256 || !key.canVerify(now, ks.grace) {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].ID < keys[j].ID })
+	return keys
+}
+
+// loadKey 加载单个密钥配置
+func loadKey(kc config.JWTKeyConfig) (*Key, error) {
+	if kc.KID == "" {
+		return nil, errors.New("kid 不能为空")
+	}
+	key := &Key{ID: kc.KID, Algorithm: kc.Algorithm}
+
+	var err error
+	if kc.ActiveFrom != "" {
+		if key.ActiveFrom, err = time.Parse(time.RFC3339, kc.ActiveFrom); err != nil {
+			return nil, fmt.Errorf("active_from 格式错误: %w", err)
+		}
+	}
+	if kc.RetireAt != "" {
+		if key.RetireAt, err = time.Parse(time.RFC3339, kc.RetireAt); err != nil {
+			return nil, fmt.Errorf("retire_at 格式错误: %w", err)
+		}
+	}
+
+	switch kc.Algorithm {
+	case AlgHS256:
+		if kc.Secret == "" {
+			return nil, errors.New("HS256 密钥需要配置 secret")
+		}
+		key.Signer = []byte(kc.Secret)
+		key.PublicKey = []byte(kc.Secret)
+		return key, nil
+	case AlgRS256, AlgEdDSA:
+	default:
+		return nil, fmt.Errorf("不支持的算法: %s", kc.Algorithm)
+	}
+
+	switch {
+	case kc.PrivateKeyFile != "":
+		priv, err := readPrivateKey(kc.PrivateKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		switch k := priv.(type) {
+		case *rsa.PrivateKey:
+			if kc.Algorithm != AlgRS256 {
+				return nil, errors.New("RSA 私钥只能用于 RS256")
+			}
+			key.Signer, key.PublicKey = k, &k.PublicKey
+		case ed25519.PrivateKey:
+			if kc.Algorithm != AlgEdDSA {
+				return nil, errors.New("Ed25519 私钥只能用于 EdDSA")
+			}
+			key.Signer, key.PublicKey = k, k.Public()
+		default:
+			return nil, errors.New("不支持的私钥类型")
+		}
+	case kc.PublicKeyFile != "":
+		// 只配置公钥时只用于验证（私钥已销毁的旧密钥）
+		pub, err := readPublicKey(kc.PublicKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		switch pub.(type) {
+		case *rsa.PublicKey:
+			if kc.Algorithm != AlgRS256 {
+				return nil, errors.New("RSA 公钥只能用于 RS256")
+			}
+		case ed25519.PublicKey:
+			if kc.Algorithm != AlgEdDSA {
+				return nil, errors.New("Ed25519 公钥只能用于 EdDSA")
+			}
+		default:
+			return nil, errors.New("不支持的公钥类型")
+		}
+		key.PublicKey = pub
+	default:
+		return nil, errors.New("需要配置 private_key_file 或 public_key_file")
+	}
+	return key, nil
+}
+
+// readPrivateKey 读取 PEM 私钥（PKCS#8，RSA 也支持 PKCS#1）
+func readPrivateKey(path string) (interface{}, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	return nil, errors.New("私钥格式错误")
+}
+
+// readPublicKey 读取 PEM 公钥（PKIX）
+func readPublicKey(path string) (crypto.PublicKey, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("公钥格式错误: %w", err)
+	}
+	return key, nil
+}
+
+func readPEM(path string) (*pem.Block, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("读取密钥文件失败: %w", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("密钥文件不是 PEM 格式")
+	}
+	return block, nil
+}
@@ -0,0 +1,35 @@
+package mask
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// String 敏感字符串（银行账号、钱包地址等），数据库中保存原文（或配合 serializer:encrypted 加密保存），序列化为 JSON 时只保留首尾各 4 位。
+// 所有接口响应和操作日志都会自动脱敏；需要原文时显式转换为 string，
+// 不要通过 JSON 缓存或传递包含该类型的结构体
+type String string
+
+// visible 首尾保留的字符数
+const visible = 4
+
+// MarshalJSON 输出脱敏后的值
+func (s String) MarshalJSON() ([]byte, error) {
+	return json.Marshal(Mask(string(s)))
+}
+
+// Mask 脱敏：长度大于 8 时保留首尾各 4 位，否则只保留最后 2 位
+func Mask(value string) string {
+	runes := []rune(value)
+	n := len(runes)
+	switch {
+	case n == 0:
+		return ""
+	case n > 2*visible:
+		return string(runes[:visible]) + "****" + string(runes[n-visible:])
+	case n > 2:
+		return strings.Repeat("*", n-2) + string(runes[n-2:])
+	default:
+		return strings.Repeat("*", n)
+	}
+}
@@ -0,0 +1,88 @@
+package payout
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"merchant_api/pkg/config"
+	"net/http"
+)
+
+// LocalProviderName 本地模拟渠道名称
+const LocalProviderName = "local"
+
+// LocalSignatureHeader 本地模拟渠道回调签名请求头
+const LocalSignatureHeader = "X-Payout-Signature"
+
+// LocalProvider 本地模拟出款渠道，不实际转账，用于开发和测试：
+// 提交后按配置直接返回结果，或返回处理中，再通过签名回调通知结果
+type LocalProvider struct {
+	secret []byte
+	result string
+}
+
+// localCallback 本地模拟渠道的回调内容
+type localCallback struct {
+	PayoutNo    string `json:"payout_no"`
+	ProviderRef string `json:"provider_ref"`
+	Status      string `json:"status"`
+	Reason      string `json:"reason"`
+}
+
+func NewLocalProvider(cfg config.PayoutLocalConfig) *LocalProvider {
+	return &LocalProvider{secret: []byte(cfg.CallbackSecret), result: cfg.Result}
+}
+
+func (p *LocalProvider) Name() string {
+	return LocalProviderName
+}
+
+// Submit 模拟提交出款，渠道流水号由出款单号生成，重复提交返回相同结果
+func (p *LocalProvider) Submit(ctx context.Context, req *Request) (*Result, error) {
+	result := &Result{ProviderRef: "local_" + req.PayoutNo, Status: StatusProcessing}
+	switch p.result {
+	case StatusPaid:
+		result.Status = StatusPaid
+	case StatusFailed:
+		result.Status = StatusFailed
+		result.Reason = "模拟出款失败"
+	}
+	return result, nil
+}
+
+// ParseCallback 校验 X-Payout-Signature（hex(HMAC-SHA256(callback_secret, body))）并解析回调
+func (p *LocalProvider) ParseCallback(header http.Header, body []byte) (*Callback, error) {
+	if len(p.secret) == 0 {
+		return nil, errors.New("未配置回调签名密钥")
+	}
+	if !hmac.Equal([]byte(p.Sign(body)), []byte(header.Get(LocalSignatureHeader))) {
+		return nil, errors.New("回调签名错误")
+	}
+
+	var data localCallback
+	if err := json.Unmarshal(body, &data); err != nil {
+		return nil, fmt.Errorf("回调内容格式错误: %w", err)
+	}
+	switch data.Status {
+	case StatusPaid, StatusFailed, StatusReversed:
+	default:
+		return nil, fmt.Errorf("无效的出款状态: %s", data.Status)
+	}
+	return &Callback{
+		PayoutNo:    data.PayoutNo,
+		ProviderRef: data.ProviderRef,
+		Status:      data.Status,
+		Reason:      data.Reason,
+	}, nil
+}
+
+// Sign 计算回调签名，测试时用于模拟渠道回调
+func (p *LocalProvider) Sign(body []byte) string {
+	mac := hmac.New(sha256.New, p.secret)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,111 @@
+package payout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"merchant_api/pkg/config"
+	"net/http"
+)
+
+// 出款状态
+const (
+	StatusProcessing = "processing" // 处理中，等待回调
+	StatusPaid       = "paid"       // 已到账
+	StatusFailed     = "failed"     // 出款失败
+	StatusReversed   = "reversed"   // 到账后被退回
+)
+
+// 收款方式
+const (
+	MethodBank   = "bank"   // 银行卡
+	MethodWallet = "wallet" // 加密货币钱包
+)
+
+// 钱包所在的链
+const (
+	ChainEthereum = "ethereum"
+	ChainBSC      = "bsc"
+	ChainPolygon  = "polygon"
+	ChainTron     = "tron"
+)
+
+// Request 出款请求
+type Request struct {
+	PayoutNo      string // 出款单号，渠道必须按该单号保证幂等（重复提交不会重复出款）
+	Amount        float64
+	Method        string
+	HolderName    string // 开户名
+	BankName      string
+	BankCode      string // SWIFT/BIC
+	AccountNo     string // 银行账号或 IBAN
+	Chain         string // 链，见 payout.Chain*
+	WalletAddress string
+}
+
+// Result 提交出款的结果
+type Result struct {
+	ProviderRef string // 渠道流水号
+	Status      string // processing / paid / failed
+	Reason      string // 失败原因
+}
+
+// Callback 渠道的出款结果通知
+type Callback struct {
+	PayoutNo    string
+	ProviderRef string
+	Status      string // paid / failed / reversed
+	Reason      string
+}
+
+// Provider 出款渠道
+type Provider interface {
+	// Name 渠道名称，与回调地址中的渠道名一致
+	Name() string
+	// Submit 提交出款，返回错误表示结果未知（可以用同一单号重试）
+	Submit(ctx context.Context, req *Request) (*Result, error)
+	// ParseCallback 校验回调签名并解析回调内容
+	ParseCallback(header http.Header, body []byte) (*Callback, error)
+}
+
+var (
+	providers       = make(map[string]Provider)
+	defaultProvider string
+)
+
+// Register 注册出款渠道
+func Register(p Provider) {
+	providers[p.Name()] = p
+}
+
+// Init 根据配置注册内置渠道并设置默认渠道
+func Init(cfg config.PayoutConfig) error {
+	Register(NewLocalProvider(cfg.Local))
+
+	name := cfg.Provider
+	if name == "" {
+		name = LocalProviderName
+	}
+	if _, ok := providers[name]; !ok {
+		return fmt.Errorf("未知的出款渠道: %s", name)
+	}
+	defaultProvider = name
+	return nil
+}
+
+// Default 返回默认出款渠道
+func Default() (Provider, error) {
+	if defaultProvider == "" {
+		return nil, errors.New("出款渠道未初始化")
+	}
+	return providers[defaultProvider], nil
+}
+
+// Get 按名称获取出款渠道
+func Get(name string) (Provider, error) {
+	p, ok := providers[name]
+	if !ok {
+		return nil, fmt.Errorf("未知的出款渠道: %s", name)
+	}
+	return p, nil
+}
@@ -0,0 +1,70 @@
+package totp
+
+import (
+	"testing"
+	"time"
+)
+
+// rfc6238Secret RFC 6238 附录 B 的 SHA1 测试密钥 "12345678901234567890"（Base32）
+const rfc6238Secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+// RFC 6238 附录 B 的 SHA1 测试向量，验证码取 8 位结果的后 6 位
+var rfc6238Vectors = []struct {
+	unix int64
+	code string
+}{
+	{59, "287082"},
+	{1111111109, "081804"},
+	{1111111111, "050471"},
+	{1234567890, "005924"},
+	{2000000000, "279037"},
+	{20000000000, "353130"},
+}
+
+func TestCodeAtRFC6238(t *testing.T) {
+	for _, v := range rfc6238Vectors {
+		got, err := CodeAt(rfc6238Secret, Counter(time.Unix(v.unix, 0)))
+		if err != nil {
+			t.Fatalf("CodeAt(%d) error = %v", v.unix, err)
+		}
+		if got != v.code {
+			t.Errorf("CodeAt(%d) = %s, want %s", v.unix, got, v.code)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	at := func(unix int64) time.Time { return time.Unix(unix, 0) }
+	tests := []struct {
+		name        string
+		secret      string
+		code        string
+		t           time.Time
+		skew        int
+		wantCounter int64
+		wantOK      bool
+	}{
+		{"RFC 6238 59", rfc6238Secret, "287082", at(59), 0, 1, true},
+		{"RFC 6238 1111111109", rfc6238Secret, "081804", at(1111111109), 0, 37037036, true},
+		{"RFC 6238 1111111111", rfc6238Secret, "050471", at(1111111111), 0, 37037037, true},
+		{"RFC 6238 1234567890", rfc6238Secret, "005924", at(1234567890), 1, 41152263, true},
+		{"RFC 6238 2000000000", rfc6238Secret, "279037", at(2000000000), 1, 66666666, true},
+		{"RFC 6238 20000000000", rfc6238Secret, "353130", at(20000000000), 1, 666666666, true},
+		{"小写密钥及首尾空格", " gezdgnbvgy3tqojqgezdgnbvgy3tqojq ", " 287082 ", at(59), 0, 1, true},
+		{"上一个时间步在误差内", rfc6238Secret, "081804", at(1111111111), 1, 37037036, true},
+		{"上一个时间步不允许误差", rfc6238Secret, "081804", at(1111111111), 0, 0, false},
+		{"超出误差", rfc6238Secret, "287082", at(59 + 2*Period), 1, 0, false},
+		{"验证码错误", rfc6238Secret, "287083", at(59), 1, 0, false},
+		{"位数不对", rfc6238Secret, "94287082", at(59), 1, 0, false},
+		{"空验证码", rfc6238Secret, "", at(59), 1, 0, false},
+		{"密钥格式错误", "not base32!", "287082", at(59), 1, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			counter, ok := Validate(tt.secret, tt.code, tt.t, tt.skew)
+			if ok != tt.wantOK || counter != tt.wantCounter {
+				t.Errorf("Validate() = %d, %v, want %d, %v", counter, ok, tt.wantCounter, tt.wantOK)
+			}
+		})
+	}
+}
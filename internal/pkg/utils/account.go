@@ -0,0 +1,157 @@
+package utils
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+var (
+	swiftPattern      = regexp.MustCompile(`^[A-Z]{6}[A-Z0-9]{2}([A-Z0-9]{3})?$`)
+	ibanPattern       = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[A-Z0-9]{11,30}$`)
+	cardNumberPattern = regexp.MustCompile(`^[0-9]{12,19}$`)
+	evmAddressPattern = regexp.MustCompile(`^0x[0-9a-fA-F]{40}$`)
+)
+
+// ibanLengths 各国 IBAN 长度（ISO 13616），未列出的国家只校验格式和校验位
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AT": 20, "BE": 16, "BG": 22, "BH": 22, "CH": 21, "CY": 28,
+	"CZ": 24, "DE": 22, "DK": 18, "EE": 20, "ES": 24, "FI": 18, "FR": 27, "GB": 22,
+	"GR": 27, "HR": 21, "HU": 28, "IE": 22, "IL": 23, "IS": 26, "IT": 27, "LI": 21,
+	"LT": 20, "LU": 20, "LV": 21, "MC": 27, "MT": 31, "NL": 18, "NO": 15, "PL": 28,
+	"PT": 25, "QA": 29, "RO": 24, "SA": 24, "SE": 24, "SI": 19, "SK": 24, "TR": 26,
+}
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// NormalizeAccountNo 去除账号中的空格和连字符并转为大写
+func NormalizeAccountNo(accountNo string) string {
+	accountNo = strings.ReplaceAll(accountNo, " ", "")
+	accountNo = strings.ReplaceAll(accountNo, "-", "")
+	return strings.ToUpper(accountNo)
+}
+
+// IsIBAN 判断账号是否为 IBAN 格式（以国家代码开头），需先调用 NormalizeAccountNo
+func IsIBAN(accountNo string) bool {
+	return len(accountNo) >= 2 && accountNo[0] >= 'A' && accountNo[0] <= 'Z' && accountNo[1] >= 'A' && accountNo[1] <= 'Z'
+}
+
+// IsValidIBAN 校验 IBAN 的长度和 mod-97 校验位
+func IsValidIBAN(iban string) bool {
+	if !ibanPattern.MatchString(iban) {
+		return false
+	}
+	if length, ok := ibanLengths[iban[:2]]; ok && len(iban) != length {
+		return false
+	}
+
+	// 前 4 位移到末尾，字母转换为 10-35 后按 97 取余应为 1
+	rearranged := iban[4:] + iban[:4]
+	remainder := 0
+	for _, ch := range rearranged {
+		value := int(ch - '0')
+		if ch >= 'A' && ch <= 'Z' {
+			value = int(ch-'A') + 10
+			remainder = (remainder*100 + value) % 97
+			continue
+		}
+		remainder = (remainder*10 + value) % 97
+	}
+	return remainder == 1
+}
+
+// IsValidCardNumber 校验银行卡号（12-19 位数字，Luhn 校验位）
+func IsValidCardNumber(cardNo string) bool {
+	if !cardNumberPattern.MatchString(cardNo) {
+		return false
+	}
+	sum := 0
+	double := false
+	for i := len(cardNo) - 1; i >= 0; i-- {
+		digit := int(cardNo[i] - '0')
+		if double {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// IsValidSWIFT 校验 SWIFT/BIC 代码（8 或 11 位）
+func IsValidSWIFT(code string) bool {
+	return swiftPattern.MatchString(code)
+}
+
+// EVMChecksumAddress 校验 EVM 地址并返回 EIP-55 校验格式；
+// 全小写或全大写的地址不带校验信息，直接转换，大小写混合的地址必须符合 EIP-55
+func EVMChecksumAddress(address string) (string, bool) {
+	if !evmAddressPattern.MatchString(address) {
+		return "", false
+	}
+
+	body := address[2:]
+	lower := strings.ToLower(body)
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write([]byte(lower))
+	digest := hex.EncodeToString(hash.Sum(nil))
+
+	checksummed := []byte(lower)
+	for i, ch := range checksummed {
+		if ch >= 'a' && ch <= 'f' && digest[i] >= '8' {
+			checksummed[i] = ch - 'a' + 'A'
+		}
+	}
+
+	result := "0x" + string(checksummed)
+	if body != lower && body != strings.ToUpper(body) && address != result {
+		return "", false
+	}
+	return result, true
+}
+
+// IsValidTronAddress 校验 TRON 地址（Base58Check，21 字节且以 0x41 开头）
+func IsValidTronAddress(address string) bool {
+	if len(address) != 34 || address[0] != 'T' {
+		return false
+	}
+
+	decoded, ok := decodeBase58(address)
+	if !ok || len(decoded) != 25 || decoded[0] != 0x41 {
+		return false
+	}
+
+	first := sha256.Sum256(decoded[:21])
+	second := sha256.Sum256(first[:])
+	return bytes.Equal(second[:4], decoded[21:])
+}
+
+// decodeBase58 Base58 解码（比特币字母表）
+func decodeBase58(s string) ([]byte, bool) {
+	value := new(big.Int)
+	radix := big.NewInt(58)
+	for _, ch := range s {
+		index := strings.IndexRune(base58Alphabet, ch)
+		if index < 0 {
+			return nil, false
+		}
+		value.Mul(value, radix)
+		value.Add(value, big.NewInt(int64(index)))
+	}
+
+	decoded := value.Bytes()
+	// 前导 '1' 表示前导 0 字节
+	zeros := 0
+	for zeros < len(s) && s[zeros] == '1' {
+		zeros++
+	}
+	return append(make([]byte, zeros), decoded...), true
+}
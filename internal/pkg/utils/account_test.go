@@ -0,0 +1,143 @@
+package utils
+
+import "testing"
+
+func TestIsValidIBAN(t *testing.T) {
+	tests := []struct {
+		iban string
+		want bool
+	}{
+		{"GB82WEST12345698765432", true},
+		{"DE89370400440532013000", true},
+		{"NL91ABNA0417164300", true},
+		{"MT84MALT011000012345MTLCAST001S", true},
+		{"GB82WEST12345698765431", false}, // 校验位错误
+		{"DE8937040044053201300", false},  // 长度与国家不符
+		{"gb82west12345698765432", false}, // 需先转为大写
+		{"GB82-WEST-1234", false},
+		{"1234", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.iban, func(t *testing.T) {
+			if got := IsValidIBAN(tt.iban); got != tt.want {
+				t.Errorf("IsValidIBAN(%q) = %v, want %v", tt.iban, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeAccountNo(t *testing.T) {
+	tests := []struct {
+		accountNo string
+		want      string
+		wantIBAN  bool
+	}{
+		{"gb82 west 1234 5698 7654 32", "GB82WEST12345698765432", true},
+		{"4111-1111-1111-1111", "4111111111111111", false},
+		{" 6222 0202 0011 2233 ", "6222020200112233", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.accountNo, func(t *testing.T) {
+			got := NormalizeAccountNo(tt.accountNo)
+			if got != tt.want {
+				t.Errorf("NormalizeAccountNo(%q) = %q, want %q", tt.accountNo, got, tt.want)
+			}
+			if IsIBAN(got) != tt.wantIBAN {
+				t.Errorf("IsIBAN(%q) = %v, want %v", got, !tt.wantIBAN, tt.wantIBAN)
+			}
+		})
+	}
+}
+
+func TestIsValidCardNumber(t *testing.T) {
+	tests := []struct {
+		cardNo string
+		want   bool
+	}{
+		{"4111111111111111", true},
+		{"378282246310005", true},
+		{"6011111111111117", true},
+		{"4111111111111112", false}, // Luhn 校验位错误
+		{"79927398713", false},      // 少于 12 位
+		{"41111111111111111111", false},
+		{"4111 1111 1111 1111", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.cardNo, func(t *testing.T) {
+			if got := IsValidCardNumber(tt.cardNo); got != tt.want {
+				t.Errorf("IsValidCardNumber(%q) = %v, want %v", tt.cardNo, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsValidSWIFT(t *testing.T) {
+	tests := []struct {
+		code string
+		want bool
+	}{
+		{"DEUTDEFF", true},
+		{"DEUTDEFF500", true},
+		{"BKCHCNBJ", true},
+		{"DEUTDEF", false},
+		{"DEUTDEFF5", false},
+		{"deutdeff", false},
+		{"1EUTDEFF", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			if got := IsValidSWIFT(tt.code); got != tt.want {
+				t.Errorf("IsValidSWIFT(%q) = %v, want %v", tt.code, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEVMChecksumAddress(t *testing.T) {
+	const checksummed = "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+	tests := []struct {
+		name    string
+		address string
+		want    string
+		wantOK  bool
+	}{
+		{"EIP-55 格式", checksummed, checksummed, true},
+		{"全小写", "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", checksummed, true},
+		{"全大写", "0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED", checksummed, true},
+		{"大小写混合但校验错误", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD", "", false},
+		{"长度错误", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeA", "", false},
+		{"没有 0x 前缀", "5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", "", false},
+		{"非十六进制字符", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeg", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := EVMChecksumAddress(tt.address)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("EVMChecksumAddress(%q) = %q, %v, want %q, %v", tt.address, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestIsValidTronAddress(t *testing.T) {
+	tests := []struct {
+		address string
+		want    bool
+	}{
+		{"TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", true},
+		{"T9yD14Nj9j7xAB4dbGeiX9h8unkKHxuWwb", true},
+		{"TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6u", false}, // 校验和错误
+		{"TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6", false},  // 长度错误
+		{"TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj60", false}, // 0 不在 Base58 字母表中
+		{"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			if got := IsValidTronAddress(tt.address); got != tt.want {
+				t.Errorf("IsValidTronAddress(%q) = %v, want %v", tt.address, got, tt.want)
+			}
+		})
+	}
+}
@@ -1,38 +1,104 @@
 package utils
 
 import (
+	"fmt"
 	"net"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 )
 
+// trustedProxies 可信代理网段，只有来自可信代理的请求才读取 X-Forwarded-For / X-Real-IP
+var trustedProxies []*net.IPNet
+
+// SetTrustedProxies 设置可信代理，支持单个 IP 或 CIDR 网段
+func SetTrustedProxies(proxies []string) error {
+	nets := make([]*net.IPNet, 0, len(proxies))
+	for _, proxy := range proxies {
+		proxy = strings.TrimSpace(proxy)
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return fmt.Errorf("无效的可信代理地址: %s", proxy)
+			}
+			bits := 128
+			if ip.To4() != nil {
+				ip = ip.To4()
+				bits = 32
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return fmt.Errorf("无效的可信代理网段: %s", proxy)
+		}
+		nets = append(nets, ipNet)
+	}
+	trustedProxies = nets
+	return nil
+}
+
+// isTrustedProxy 判断 IP 是否属于可信代理
+func isTrustedProxy(ip net.IP) bool {
+	for _, ipNet := range trustedProxies {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
 // GetClientIP 获取客户端真实 IP 地址
+// 直连地址不是可信代理时直接使用直连地址，不信任可伪造的请求头；
+// 否则从 X-Forwarded-For 右侧开始跳过可信代理，取第一个不可信的地址
 func GetClientIP(c *gin.Context) string {
-	// 优先从 X-Forwarded-For 获取
-	xForwardedFor := c.GetHeader("X-Forwarded-For")
-	if xForwardedFor != "" {
-		// X-Forwarded-For 可能包含多个 IP，取第一个
+	remoteIP, _, err := net.SplitHostPort(c.Request.RemoteAddr)
+	if err != nil {
+		remoteIP = c.Request.RemoteAddr
+	}
+	remote := net.ParseIP(remoteIP)
+	if remote == nil || !isTrustedProxy(remote) {
+		return remoteIP
+	}
+
+	// X-Forwarded-For: client, proxy1, proxy2
+	if xForwardedFor := c.GetHeader("X-Forwarded-For"); xForwardedFor != "" {
 		ips := strings.Split(xForwardedFor, ",")
-		if len(ips) > 0 {
-			ip := strings.TrimSpace(ips[0])
-			if ip != "" {
-				return ip
+		for i := len(ips) - 1; i >= 0; i-- {
+			ip := net.ParseIP(strings.TrimSpace(ips[i]))
+			if ip == nil {
+				break
+			}
+			if i == 0 || !isTrustedProxy(ip) {
+				return ip.String()
 			}
 		}
 	}
 
 	// 从 X-Real-IP 获取
-	xRealIP := c.GetHeader("X-Real-IP")
-	if xRealIP != "" {
-		return xRealIP
+	if ip := net.ParseIP(strings.TrimSpace(c.GetHeader("X-Real-IP"))); ip != nil {
+		return ip.String()
 	}
 
-	// 从 RemoteAddr 获取
-	ip, _, err := net.SplitHostPort(c.Request.RemoteAddr)
-	if err != nil {
-		return c.Request.RemoteAddr
+	return remoteIP
+}
+
+// SameSubnet 判断两个 IP 是否在同一网段：IPv4 比较 /24，IPv6 比较 /64
+func SameSubnet(a, b string) bool {
+	ipA, ipB := net.ParseIP(a), net.ParseIP(b)
+	if ipA == nil || ipB == nil {
+		return false
+	}
+
+	if v4A, v4B := ipA.To4(), ipB.To4(); v4A != nil || v4B != nil {
+		if v4A == nil || v4B == nil {
+			return false
+		}
+		mask := net.CIDRMask(24, 32)
+		return v4A.Mask(mask).Equal(v4B.Mask(mask))
 	}
 
-	return ip
+	mask := net.CIDRMask(64, 128)
+	return ipA.Mask(mask).Equal(ipB.Mask(mask))
 }
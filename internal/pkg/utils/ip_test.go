@@ -0,0 +1,85 @@
+package utils
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestGetClientIP(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	if err := SetTrustedProxies([]string{"10.0.0.0/8", "192.168.1.10", "fd00::/8"}); err != nil {
+		t.Fatalf("SetTrustedProxies() error = %v", err)
+	}
+	t.Cleanup(func() { trustedProxies = nil })
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		xff        string
+		realIP     string
+		want       string
+	}{
+		{"直连不读取请求头", "203.0.113.5:1234", "1.2.3.4", "5.6.7.8", "203.0.113.5"},
+		{"可信代理转发", "10.0.0.2:1234", "198.51.100.7", "", "198.51.100.7"},
+		{"跳过多层可信代理", "10.0.0.2:1234", "198.51.100.7, 192.168.1.10, 10.1.2.3", "", "198.51.100.7"},
+		{"不信任伪造的最左侧地址", "10.0.0.2:1234", "1.2.3.4, 198.51.100.7, 10.1.2.3", "", "198.51.100.7"},
+		{"全部是可信代理时取最左侧", "10.0.0.2:1234", "10.0.0.9, 10.0.0.8", "", "10.0.0.9"},
+		{"单个 IP 的可信代理不包含同网段", "192.168.1.11:1234", "198.51.100.7", "", "192.168.1.11"},
+		{"无效的转发地址", "10.0.0.2:1234", "198.51.100.7, unknown", "", "10.0.0.2"},
+		{"使用 X-Real-IP", "10.0.0.2:1234", "", "198.51.100.8", "198.51.100.8"},
+		{"没有转发请求头", "10.0.0.2:1234", "", "", "10.0.0.2"},
+		{"IPv6 可信代理", "[fd00::1]:1234", "2001:db8::7", "", "2001:db8::7"},
+		{"IPv6 直连", "[2001:db8::1]:1234", "198.51.100.7", "", "2001:db8::1"},
+		{"没有端口", "203.0.113.5", "1.2.3.4", "", "203.0.113.5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest("GET", "/", nil)
+			c.Request.RemoteAddr = tt.remoteAddr
+			if tt.xff != "" {
+				c.Request.Header.Set("X-Forwarded-For", tt.xff)
+			}
+			if tt.realIP != "" {
+				c.Request.Header.Set("X-Real-IP", tt.realIP)
+			}
+			if got := GetClientIP(c); got != tt.want {
+				t.Errorf("GetClientIP() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetTrustedProxiesInvalid(t *testing.T) {
+	t.Cleanup(func() { trustedProxies = nil })
+	for _, proxy := range []string{"10.0.0.256", "10.0.0.0/33", "proxy.local"} {
+		if err := SetTrustedProxies([]string{proxy}); err == nil {
+			t.Errorf("SetTrustedProxies(%q) error = nil, want error", proxy)
+		}
+	}
+}
+
+func TestSameSubnet(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"192.168.1.10", "192.168.1.200", true},
+		{"192.168.1.10", "192.168.2.10", false},
+		{"::ffff:192.168.1.10", "192.168.1.20", true},
+		{"2001:db8:1:2::1", "2001:db8:1:2:ffff::1", true},
+		{"2001:db8:1:2::1", "2001:db8:1:3::1", false},
+		{"192.168.1.10", "2001:db8::1", false},
+		{"192.168.1.10", "", false},
+		{"invalid", "invalid", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+"_"+tt.b, func(t *testing.T) {
+			if got := SameSubnet(tt.a, tt.b); got != tt.want {
+				t.Errorf("SameSubnet(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,10 @@
+package utils
+
+import "regexp"
+
+var phonePattern = regexp.MustCompile(`^\+?[0-9]{5,12}$`)
+
+// IsValidPhone 校验手机号/电话号码格式（可带国际区号 +，最长 13 位）
+func IsValidPhone(phone string) bool {
+	return phonePattern.MatchString(phone)
+}
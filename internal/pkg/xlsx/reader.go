@@ -0,0 +1,323 @@
+// Package xlsx 读写 Excel 工作簿（.xlsx）的最小实现，只处理第一个工作表的文本及数字单元格，
+// 不支持公式计算、日期格式及样式，用于商品导入导出。
+package xlsx
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrNoSheet 工作簿中没有工作表
+	ErrNoSheet = errors.New("工作簿中没有工作表")
+	// ErrTooManyRows 有数据的行超过 Limits.MaxRows
+	ErrTooManyRows = errors.New("工作表行数超过上限")
+	// ErrTooManyColumns 有数据的列超过 Limits.MaxColumns
+	ErrTooManyColumns = errors.New("工作表列数超过上限")
+	// ErrPartTooLarge 工作表或共享字符串表解压后超过 maxPartSize
+	ErrPartTooLarge = errors.New("工作表解压后过大")
+)
+
+// maxColumns 单元格列号上限（XFD），超过视为文件损坏
+const maxColumns = 16384
+
+// maxPartSize 工作簿中单个 XML 文件解压后的大小上限，防止压缩炸弹
+const maxPartSize = 64 << 20
+
+// Limits 读取上限。行列号取自文件本身，中间的空行、空单元格会被补齐，
+// 不加限制时构造的行号或列号就能耗尽内存
+type Limits struct {
+	MaxRows    int // 行数上限（含表头），0 表示不限
+	MaxColumns int // 列数上限，0 表示不限（最多到 XFD 列）
+}
+
+// ReadRows 读取工作簿第一个工作表的所有行，每行按列号展开，空单元格为空字符串，
+// 行尾的空单元格及末尾的空行被去掉
+func ReadRows(r io.ReaderAt, size int64, limits Limits) ([][]string, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("不是有效的 xlsx 文件: %w", err)
+	}
+	files := make(map[string]*zip.File, len(zr.File))
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+
+	sheetPath, err := firstSheetPath(files)
+	if err != nil {
+		return nil, err
+	}
+	sheet := files[sheetPath]
+	if sheet == nil {
+		return nil, ErrNoSheet
+	}
+
+	var shared []string
+	if f := files["xl/sharedStrings.xml"]; f != nil {
+		if shared, err = readSharedStrings(f); err != nil {
+			return nil, err
+		}
+	}
+	return readSheet(sheet, shared, limits)
+}
+
+// firstSheetPath 按 workbook.xml 及其关系文件找到第一个工作表的路径
+func firstSheetPath(files map[string]*zip.File) (string, error) {
+	var workbook struct {
+		Sheets []struct {
+			ID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+		} `xml:"sheets>sheet"`
+	}
+	var rels struct {
+		Relationships []struct {
+			ID     string `xml:"Id,attr"`
+			Target string `xml:"Target,attr"`
+		} `xml:"Relationship"`
+	}
+	if err := decodeFile(files["xl/workbook.xml"], &workbook); err != nil {
+		return "", err
+	}
+	if len(workbook.Sheets) == 0 {
+		return "", ErrNoSheet
+	}
+	if err := decodeFile(files["xl/_rels/workbook.xml.rels"], &rels); err != nil {
+		return "", err
+	}
+	for _, rel := range rels.Relationships {
+		if rel.ID != workbook.Sheets[0].ID {
+			continue
+		}
+		if strings.HasPrefix(rel.Target, "/") {
+			return strings.TrimPrefix(rel.Target, "/"), nil
+		}
+		return path.Join("xl", rel.Target), nil
+	}
+	return "", ErrNoSheet
+}
+
+// openPart 打开工作簿中的 XML 文件，解压后的大小不能超过 maxPartSize
+func openPart(f *zip.File) (io.ReadCloser, error) {
+	if f.UncompressedSize64 > maxPartSize {
+		return nil, fmt.Errorf("%s: %w", f.Name, ErrPartTooLarge)
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("读取 %s 失败: %w", f.Name, err)
+	}
+	// 文件头中的大小可以伪造，读取时再限制一次
+	return struct {
+		io.Reader
+		io.Closer
+	}{io.LimitReader(rc, maxPartSize), rc}, nil
+}
+
+func decodeFile(f *zip.File, v interface{}) error {
+	if f == nil {
+		return errors.New("不是有效的 xlsx 文件: 缺少工作簿信息")
+	}
+	rc, err := openPart(f)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	if err := xml.NewDecoder(rc).Decode(v); err != nil {
+		return fmt.Errorf("解析 %s 失败: %w", f.Name, err)
+	}
+	return nil
+}
+
+// readSharedStrings 读取共享字符串表，富文本按顺序拼接各段文字
+func readSharedStrings(f *zip.File) ([]string, error) {
+	var sst struct {
+		Items []struct {
+			T    *string `xml:"t"`
+			Runs []struct {
+				T string `xml:"t"`
+			} `xml:"r"`
+		} `xml:"si"`
+	}
+	if err := decodeFile(f, &sst); err != nil {
+		return nil, err
+	}
+	shared := make([]string, 0, len(sst.Items))
+	for _, item := range sst.Items {
+		if item.T != nil {
+			shared = append(shared, *item.T)
+			continue
+		}
+		var b strings.Builder
+		for _, run := range item.Runs {
+			b.WriteString(run.T)
+		}
+		shared = append(shared, b.String())
+	}
+	return shared, nil
+}
+
+// cell 工作表单元格
+type cell struct {
+	Ref    string `xml:"r,attr"`
+	Type   string `xml:"t,attr"`
+	Value  string `xml:"v"`
+	Inline struct {
+		T    string `xml:"t"`
+		Runs []struct {
+			T string `xml:"t"`
+		} `xml:"r"`
+	} `xml:"is"`
+}
+
+// readSheet 逐行解析工作表，不把整个 XML 读入内存
+func readSheet(f *zip.File, shared []string, limits Limits) ([][]string, error) {
+	rc, err := openPart(f)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	var rows [][]string
+	last := 0 // 上一个 <row> 的行号
+	dec := xml.NewDecoder(rc)
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("解析工作表失败: %w", err)
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok || start.Name.Local != "row" {
+			continue
+		}
+
+		// 行号缺省时顺延上一行
+		rowNum := last + 1
+		for _, attr := range start.Attr {
+			if attr.Name.Local == "r" {
+				if n, err := strconv.Atoi(attr.Value); err == nil && n > last {
+					rowNum = n
+				}
+			}
+		}
+		last = rowNum
+
+		row, err := readRow(dec, shared, limits.MaxColumns)
+		if err != nil {
+			return nil, err
+		}
+		// 只有样式没有数据的行不展开，由后面有数据的行补齐
+		if len(row) == 0 {
+			continue
+		}
+		if limits.MaxRows > 0 && rowNum > limits.MaxRows {
+			return nil, ErrTooManyRows
+		}
+		for len(rows) < rowNum-1 {
+			rows = append(rows, nil)
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// readRow 读取 <row> 中的单元格，直到 </row>
+func readRow(dec *xml.Decoder, shared []string, maxCols int) ([]string, error) {
+	var row []string
+	next := 0 // 引用缺省时单元格的列号
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, fmt.Errorf("解析工作表失败: %w", err)
+		}
+		switch t := tok.(type) {
+		case xml.EndElement:
+			if t.Name.Local == "row" {
+				return row, nil
+			}
+		case xml.StartElement:
+			if t.Name.Local != "c" {
+				continue
+			}
+			var c cell
+			if err := dec.DecodeElement(&c, &t); err != nil {
+				return nil, fmt.Errorf("解析单元格失败: %w", err)
+			}
+			col := next
+			if c.Ref != "" {
+				if col, err = columnIndex(c.Ref); err != nil {
+					return nil, err
+				}
+			}
+			next = col + 1
+			value, err := cellValue(&c, shared)
+			if err != nil {
+				return nil, err
+			}
+			// 空单元格不展开，由后面有数据的单元格补齐
+			if value == "" {
+				continue
+			}
+			if maxCols > 0 && col >= maxCols {
+				return nil, ErrTooManyColumns
+			}
+			for len(row) <= col {
+				row = append(row, "")
+			}
+			row[col] = value
+		}
+	}
+}
+
+func cellValue(c *cell, shared []string) (string, error) {
+	switch c.Type {
+	case "s":
+		i, err := strconv.Atoi(strings.TrimSpace(c.Value))
+		if err != nil || i < 0 || i >= len(shared) {
+			return "", fmt.Errorf("单元格 %s 的共享字符串无效", c.Ref)
+		}
+		return shared[i], nil
+	case "inlineStr":
+		if len(c.Inline.Runs) == 0 {
+			return c.Inline.T, nil
+		}
+		var b strings.Builder
+		for _, run := range c.Inline.Runs {
+			b.WriteString(run.T)
+		}
+		return b.String(), nil
+	case "b":
+		if c.Value == "1" {
+			return "TRUE", nil
+		}
+		return "FALSE", nil
+	}
+	// 数字、公式缓存的结果（str）及错误值原样返回
+	return c.Value, nil
+}
+
+// columnIndex 从单元格引用（例如 AB12）计算从 0 开始的列号
+func columnIndex(ref string) (int, error) {
+	col := 0
+	i := 0
+	for ; i < len(ref); i++ {
+		ch := ref[i]
+		if ch >= 'a' && ch <= 'z' {
+			ch -= 'a' - 'A'
+		}
+		if ch < 'A' || ch > 'Z' {
+			break
+		}
+		col = col*26 + int(ch-'A'+1)
+	}
+	if i == 0 || col > maxColumns {
+		return 0, fmt.Errorf("单元格引用 %s 无效", ref)
+	}
+	return col - 1, nil
+}
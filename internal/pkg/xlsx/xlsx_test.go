@@ -0,0 +1,240 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// buildWorkbook 用给定的 sheetData 内容构造工作簿，模拟其他软件或恶意构造的文件
+func buildWorkbook(t *testing.T, sheetData func(w io.Writer)) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	parts := []struct{ name, body string }{
+		{"xl/workbook.xml", `<workbook xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="s" sheetId="1" r:id="rId1"/></sheets></workbook>`},
+		{"xl/_rels/workbook.xml.rels", workbookRelsXML},
+	}
+	for _, part := range parts {
+		f, _ := zw.Create(part.name)
+		io.WriteString(f, part.body)
+	}
+	f, _ := zw.Create("xl/worksheets/sheet1.xml")
+	io.WriteString(f, sheetHeaderXML)
+	sheetData(f)
+	io.WriteString(f, sheetFooterXML)
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestReadRowsLimits(t *testing.T) {
+	limits := Limits{MaxRows: 3, MaxColumns: 4}
+	tests := []struct {
+		name      string
+		sheetData string
+		want      [][]string
+		wantErr   error
+	}{
+		{
+			name:      "刚好在上限内",
+			sheetData: `<row r="1"><c r="A1" t="inlineStr"><is><t>a</t></is></c><c r="D1"><v>4</v></c></row><row r="3"><c r="B3"><v>1</v></c></row>`,
+			want:      [][]string{{"a", "", "", "4"}, nil, {"", "1"}},
+		},
+		{
+			name:      "行号超过上限",
+			sheetData: `<row r="1"><c r="A1"><v>1</v></c></row><row r="2000000000"><c r="A2000000000"><v>1</v></c></row>`,
+			wantErr:   ErrTooManyRows,
+		},
+		{
+			name:      "没有数据的行不计入",
+			sheetData: `<row r="1"><c r="A1"><v>1</v></c></row><row r="2000000000" ht="20"><c r="A2000000000" s="1"/></row>`,
+			want:      [][]string{{"1"}},
+		},
+		{
+			name:      "列号超过上限",
+			sheetData: `<row r="1"><c r="XFD1"><v>1</v></c></row>`,
+			wantErr:   ErrTooManyColumns,
+		},
+		{
+			name:      "没有数据的单元格不计入",
+			sheetData: `<row r="1"><c r="A1"><v>1</v></c><c r="XFD1" s="1"/></row>`,
+			want:      [][]string{{"1"}},
+		},
+		{
+			name:      "缺省引用顺延上一个单元格",
+			sheetData: `<row><c r="B1"><v>1</v></c><c><v>2</v></c></row><row><c><v>3</v></c></row>`,
+			want:      [][]string{{"", "1", "2"}, {"3"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := buildWorkbook(t, func(w io.Writer) { io.WriteString(w, tt.sheetData) })
+			got, err := ReadRows(bytes.NewReader(data), int64(len(data)), limits)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ReadRows() error = %v, want %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReadRows() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadRowsPartTooLarge(t *testing.T) {
+	data := buildWorkbook(t, func(w io.Writer) {
+		chunk := bytes.Repeat([]byte(" "), 1<<20)
+		for i := 0; i <= maxPartSize>>20; i++ {
+			w.Write(chunk)
+		}
+	})
+	if _, err := ReadRows(bytes.NewReader(data), int64(len(data)), Limits{}); !errors.Is(err, ErrPartTooLarge) {
+		t.Errorf("ReadRows() error = %v, want %v", err, ErrPartTooLarge)
+	}
+}
+
+func TestWriterReaderRoundTrip(t *testing.T) {
+	wide := make([]Cell, 30)
+	wideWant := make([]string, 30)
+	for i := range wide {
+		wide[i] = Cell{Value: columnName(i)}
+		wideWant[i] = columnName(i)
+	}
+
+	tests := []struct {
+		name string
+		rows [][]Cell
+		want [][]string
+	}{
+		{
+			name: "文本和数字",
+			rows: [][]Cell{
+				{{Value: "条码"}, {Value: "价格"}},
+				{{Value: "00123"}, {Value: "59.9", Number: true}},
+			},
+			want: [][]string{{"条码", "价格"}, {"00123", "59.9"}},
+		},
+		{
+			name: "特殊字符和空白",
+			rows: [][]Cell{{{Value: `<b>"A&B"</b>`}, {Value: "  前后空格  "}, {Value: "第一行\n第二行"}}},
+			want: [][]string{{`<b>"A&B"</b>`, "  前后空格  ", "第一行\n第二行"}},
+		},
+		{
+			name: "中间的空单元格补齐，行尾的空单元格去掉",
+			rows: [][]Cell{{{Value: "a"}, {}, {Value: "c"}, {}, {}}},
+			want: [][]string{{"a", "", "c"}},
+		},
+		{
+			name: "空行",
+			rows: [][]Cell{{{Value: "a"}}, {}, {{Value: "c"}}},
+			want: [][]string{{"a"}, nil, {"c"}},
+		},
+		{
+			name: "超过 26 列",
+			rows: [][]Cell{wide},
+			want: [][]string{wideWant},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			w, err := NewWriter(&buf, "products & <stock>")
+			if err != nil {
+				t.Fatalf("NewWriter() error = %v", err)
+			}
+			for _, row := range tt.rows {
+				if err := w.WriteRow(row); err != nil {
+					t.Fatalf("WriteRow() error = %v", err)
+				}
+				if err := w.Flush(); err != nil {
+					t.Fatalf("Flush() error = %v", err)
+				}
+			}
+			if err := w.Close(); err != nil {
+				t.Fatalf("Close() error = %v", err)
+			}
+
+			got, err := ReadRows(bytes.NewReader(buf.Bytes()), int64(buf.Len()), Limits{})
+			if err != nil {
+				t.Fatalf("ReadRows() error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("ReadRows() = %q, want %q", got, tt.want)
+			}
+			for i := range got {
+				if len(got[i]) == 0 && len(tt.want[i]) == 0 {
+					continue
+				}
+				if !reflect.DeepEqual(got[i], tt.want[i]) {
+					t.Errorf("row %d = %q, want %q", i+1, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestReadRowsInvalid(t *testing.T) {
+	var empty bytes.Buffer
+	w, _ := NewWriter(&empty, "empty")
+	w.Close()
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"不是 zip 文件", []byte("store_name,price\n")},
+		{"截断的文件", empty.Bytes()[:empty.Len()/2]},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ReadRows(bytes.NewReader(tt.data), int64(len(tt.data)), Limits{}); err == nil {
+				t.Error("ReadRows() error = nil, want error")
+			}
+		})
+	}
+
+	rows, err := ReadRows(bytes.NewReader(empty.Bytes()), int64(empty.Len()), Limits{})
+	if err != nil || len(rows) != 0 {
+		t.Errorf("ReadRows() of empty sheet = %q, %v, want no rows", rows, err)
+	}
+}
+
+func TestColumnNameIndex(t *testing.T) {
+	tests := []struct {
+		index int
+		name  string
+	}{
+		{0, "A"},
+		{25, "Z"},
+		{26, "AA"},
+		{51, "AZ"},
+		{52, "BA"},
+		{701, "ZZ"},
+		{702, "AAA"},
+		{16383, "XFD"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := columnName(tt.index); got != tt.name {
+				t.Errorf("columnName(%d) = %s, want %s", tt.index, got, tt.name)
+			}
+			if got, err := columnIndex(tt.name + "12"); err != nil || got != tt.index {
+				t.Errorf("columnIndex(%s12) = %d, %v, want %d", tt.name, got, err, tt.index)
+			}
+			if got, err := columnIndex(strings.ToLower(tt.name) + "1"); err != nil || got != tt.index {
+				t.Errorf("columnIndex(%s1) = %d, %v, want %d", strings.ToLower(tt.name), got, err, tt.index)
+			}
+		})
+	}
+
+	for _, ref := range []string{"12", "", "XFE1"} {
+		if _, err := columnIndex(ref); err == nil {
+			t.Errorf("columnIndex(%q) error = nil, want error", ref)
+		}
+	}
+}
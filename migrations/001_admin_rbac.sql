@@ -0,0 +1,16 @@
+-- 商户角色权限
+-- mer_merchant_admin.roles 存储逗号分隔的角色ID，level=0 的商户主账号拥有全部权限
+
+CREATE TABLE IF NOT EXISTS mer_system_role (
+    role_id INT UNSIGNED AUTO_INCREMENT PRIMARY KEY COMMENT '角色id',
+    mer_id INT UNSIGNED NOT NULL COMMENT '商户id',
+    role_name VARCHAR(32) NOT NULL COMMENT '角色名称',
+    rules TEXT NOT NULL COMMENT '权限码，多个用逗号分隔',
+    status TINYINT UNSIGNED NOT NULL DEFAULT 1 COMMENT '状态 1启用 0禁用',
+    create_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '添加时间',
+    INDEX mer_id (mer_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='商户角色表';
+
+-- 示例：收银员角色，只能查看商品和分类、调整上下架/售完状态，不能删除商品或改价
+-- INSERT INTO mer_system_role (mer_id, role_name, rules)
+-- VALUES (1, '收银员', 'category:read,product:read,product:write');
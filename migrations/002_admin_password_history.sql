@@ -0,0 +1,10 @@
+-- 商户管理员历史密码
+-- 修改/重置密码时不能与最近 password.history_count 次使用过的密码相同
+
+CREATE TABLE IF NOT EXISTS mer_merchant_admin_pwd_history (
+    id INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    merchant_admin_id SMALLINT UNSIGNED NOT NULL COMMENT '商户管理员ID',
+    pwd CHAR(64) NOT NULL COMMENT '密码哈希',
+    create_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '设置时间',
+    INDEX merchant_admin_id (merchant_admin_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='商户管理员历史密码表';
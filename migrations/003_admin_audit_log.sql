@@ -0,0 +1,18 @@
+-- 管理员操作日志
+-- 登录锁定等安全事件记录到账号所属商户下，admin_id 为 0 表示未登录或账号不存在
+
+CREATE TABLE IF NOT EXISTS mer_admin_audit_log (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    mer_id INT UNSIGNED NOT NULL DEFAULT 0 COMMENT '商户ID',
+    admin_id SMALLINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '操作管理员ID，未登录时为0',
+    account VARCHAR(32) NOT NULL DEFAULT '' COMMENT '操作账号',
+    ip VARCHAR(45) NOT NULL DEFAULT '' COMMENT '操作IP',
+    action VARCHAR(32) NOT NULL COMMENT '操作类型',
+    route VARCHAR(128) NOT NULL DEFAULT '' COMMENT '请求路由',
+    entity_type VARCHAR(32) NOT NULL DEFAULT '' COMMENT '操作对象类型',
+    entity_id BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '操作对象ID',
+    `before` JSON NULL COMMENT '修改前',
+    `after` JSON NULL COMMENT '修改后',
+    create_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '操作时间',
+    INDEX mer_id_create_at (mer_id, create_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='管理员操作日志表';
@@ -0,0 +1,19 @@
+-- 商户管理员两步验证（TOTP）
+-- enabled = 0 表示已生成密钥但尚未用验证码确认绑定
+
+CREATE TABLE IF NOT EXISTS mer_merchant_admin_totp (
+    merchant_admin_id SMALLINT UNSIGNED NOT NULL PRIMARY KEY COMMENT '商户管理员ID',
+    secret VARCHAR(64) NOT NULL COMMENT 'TOTP密钥',
+    enabled TINYINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '是否已启用 1已启用 0待验证',
+    recovery_codes TEXT NULL COMMENT '恢复码哈希(JSON数组)',
+    last_counter BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '最后使用的时间步，防止验证码重放',
+    create_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '绑定时间',
+    enable_at DATETIME NULL COMMENT '启用时间'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='商户管理员两步验证表';
+
+-- 商户安全设置，没有记录时使用默认值
+CREATE TABLE IF NOT EXISTS mer_merchant_security (
+    mer_id INT UNSIGNED NOT NULL PRIMARY KEY COMMENT '商户ID',
+    require_two_factor TINYINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '是否要求所有管理员启用两步验证',
+    update_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '更新时间'
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='商户安全设置表';
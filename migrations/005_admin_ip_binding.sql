@@ -0,0 +1,9 @@
+-- 令牌 IP 绑定策略
+-- strict: 必须与登录 IP 相同（默认）；subnet: 同一网段（IPv4 /24，IPv6 /64）；disabled: 不校验
+
+ALTER TABLE mer_merchant_security
+    ADD COLUMN ip_bind_policy VARCHAR(16) NOT NULL DEFAULT 'strict' COMMENT '令牌IP绑定策略 strict同一IP subnet同一网段 disabled不校验' AFTER require_two_factor;
+
+-- 支持 IPv6 地址
+ALTER TABLE mer_merchant_admin
+    MODIFY COLUMN last_ip VARCHAR(45) NULL COMMENT '商户管理员最后一次登录IP地址';
@@ -0,0 +1,6 @@
+-- 操作日志查询与清理
+-- 按操作对象查询（某个商品/分类的修改记录）；按时间清理过期日志
+
+ALTER TABLE mer_admin_audit_log
+    ADD INDEX mer_id_entity (mer_id, entity_type, entity_id),
+    ADD INDEX create_at (create_at);
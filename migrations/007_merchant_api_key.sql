@@ -0,0 +1,19 @@
+-- 商户 API 密钥（ERP/POS 等系统对接）
+-- 只保存密钥的 SHA-256，明文只在创建时返回一次；scopes 为逗号分隔的权限码
+
+CREATE TABLE IF NOT EXISTS mer_merchant_api_key (
+    api_key_id INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    mer_id INT UNSIGNED NOT NULL COMMENT '商户ID',
+    name VARCHAR(64) NOT NULL DEFAULT '' COMMENT '名称',
+    access_key VARCHAR(32) NOT NULL COMMENT '访问密钥ID',
+    secret_hash CHAR(64) NOT NULL COMMENT '密钥SHA-256',
+    scopes VARCHAR(1024) NOT NULL DEFAULT '' COMMENT '授权范围(逗号分隔的权限码)',
+    expire_at DATETIME NULL COMMENT '过期时间，为空表示不过期',
+    revoke_at DATETIME NULL COMMENT '吊销时间',
+    last_used_at DATETIME NULL COMMENT '最后使用时间',
+    last_used_ip VARCHAR(45) NOT NULL DEFAULT '' COMMENT '最后使用IP',
+    created_by SMALLINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '创建人管理员ID',
+    create_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    UNIQUE INDEX access_key (access_key),
+    INDEX mer_id (mer_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='商户API密钥表';
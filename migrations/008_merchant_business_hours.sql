@@ -0,0 +1,35 @@
+-- 商户营业时间
+-- 每周营业时段：一天可以有多个时段，close_time 早于或等于 open_time 表示跨午夜（延续到次日）
+-- 节假日：按日期覆盖当天的每周营业时段，hours 为空表示全天休息
+-- 时间均为商户时区（mer_merchant.timezone）的本地时间，节假日日期以 YYYY-MM-DD 字符串保存，不受数据库时区影响
+
+ALTER TABLE mer_merchant
+    ADD COLUMN timezone VARCHAR(64) NOT NULL DEFAULT 'Asia/Shanghai' COMMENT '商户时区(IANA)' AFTER closing_time;
+
+CREATE TABLE IF NOT EXISTS mer_merchant_business_hour (
+    business_hour_id INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    mer_id INT UNSIGNED NOT NULL COMMENT '商户ID',
+    weekday TINYINT UNSIGNED NOT NULL COMMENT '星期 0周日 1-6周一至周六',
+    open_time CHAR(5) NOT NULL COMMENT '开始时间 HH:MM',
+    close_time CHAR(5) NOT NULL COMMENT '结束时间 HH:MM，早于或等于开始时间表示跨午夜',
+    INDEX mer_id_weekday (mer_id, weekday)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='商户每周营业时段表';
+
+CREATE TABLE IF NOT EXISTS mer_merchant_holiday (
+    holiday_id INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    mer_id INT UNSIGNED NOT NULL COMMENT '商户ID',
+    holiday_date CHAR(10) NOT NULL COMMENT '日期 YYYY-MM-DD(商户时区)',
+    name VARCHAR(32) NOT NULL DEFAULT '' COMMENT '名称',
+    hours VARCHAR(255) NOT NULL DEFAULT '' COMMENT '当天营业时段，例如 10:00-14:00,17:00-21:00，为空表示全天休息',
+    update_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '更新时间',
+    UNIQUE INDEX mer_id_date (mer_id, holiday_date)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='商户节假日营业时间表';
+
+-- 迁移旧的单一营业时段（opening_time/closing_time）为每天相同的营业时段，旧字段不再使用
+INSERT INTO mer_merchant_business_hour (mer_id, weekday, open_time, close_time)
+SELECT m.mer_id, w.weekday, DATE_FORMAT(m.opening_time, '%H:%i'), DATE_FORMAT(m.closing_time, '%H:%i')
+FROM mer_merchant m
+CROSS JOIN (SELECT 0 AS weekday UNION ALL SELECT 1 UNION ALL SELECT 2 UNION ALL SELECT 3
+            UNION ALL SELECT 4 UNION ALL SELECT 5 UNION ALL SELECT 6) w
+WHERE m.opening_time IS NOT NULL AND m.closing_time IS NOT NULL AND m.opening_time <> m.closing_time
+  AND NOT EXISTS (SELECT 1 FROM mer_merchant_business_hour h WHERE h.mer_id = m.mer_id);
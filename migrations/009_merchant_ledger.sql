@@ -0,0 +1,36 @@
+-- 商户资金流水（复式记账，只追加不修改）
+-- 每笔交易（txn_no）至少两条分录，借方合计等于贷方合计；
+-- 商户账户（available 可用余额、frozen 冻结金额）贷方增加、借方减少，platform 为平台往来账户（入账、出款、调账的对方科目）
+-- balance 为该账户记账后的余额，mer_merchant.mer_money 与 available 账户余额保持一致
+
+CREATE TABLE IF NOT EXISTS mer_merchant_ledger (
+    ledger_id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    txn_no CHAR(32) NOT NULL COMMENT '交易号，同一交易的分录相同',
+    mer_id INT UNSIGNED NOT NULL COMMENT '商户ID',
+    account VARCHAR(16) NOT NULL COMMENT '账户 available可用余额 frozen冻结金额 platform平台往来',
+    direction VARCHAR(8) NOT NULL COMMENT '方向 credit贷 debit借',
+    amount DECIMAL(12,2) UNSIGNED NOT NULL COMMENT '金额',
+    balance DECIMAL(14,2) NOT NULL COMMENT '记账后账户余额',
+    reason VARCHAR(32) NOT NULL COMMENT '原因',
+    ref_type VARCHAR(32) NOT NULL DEFAULT '' COMMENT '关联单据类型',
+    ref_id VARCHAR(64) NOT NULL DEFAULT '' COMMENT '关联单据ID',
+    remark VARCHAR(255) NOT NULL DEFAULT '' COMMENT '备注',
+    created_by INT UNSIGNED NOT NULL DEFAULT 0 COMMENT '操作人管理员ID，0为系统',
+    create_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '记账时间',
+    INDEX mer_id_account (mer_id, account, ledger_id),
+    INDEX mer_id_ref (mer_id, ref_type, ref_id),
+    INDEX mer_id_create_at (mer_id, create_at),
+    INDEX txn_no (txn_no)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='商户资金流水表';
+
+-- 现有余额作为期初余额入账
+INSERT INTO mer_merchant_ledger (txn_no, mer_id, account, direction, amount, balance, reason, remark)
+SELECT REPLACE(UUID(), '-', ''), mer_id, 'available', IF(mer_money >= 0, 'credit', 'debit'), ABS(mer_money), mer_money, 'opening', '期初余额'
+FROM mer_merchant
+WHERE mer_money <> 0 AND NOT EXISTS (SELECT 1 FROM mer_merchant_ledger l WHERE l.mer_id = mer_merchant.mer_id);
+
+INSERT INTO mer_merchant_ledger (txn_no, mer_id, account, direction, amount, balance, reason, remark)
+SELECT l.txn_no, l.mer_id, 'platform', IF(l.direction = 'credit', 'debit', 'credit'), l.amount, -l.balance, 'opening', '期初余额'
+FROM mer_merchant_ledger l
+WHERE l.reason = 'opening' AND l.account = 'available'
+  AND NOT EXISTS (SELECT 1 FROM mer_merchant_ledger p WHERE p.txn_no = l.txn_no AND p.account = 'platform');
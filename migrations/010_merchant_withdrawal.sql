@@ -0,0 +1,30 @@
+-- 商户提现
+-- 状态：pending 待审核 -> approved 已审核（已提交出款渠道）-> paid 已到账 / failed 出款失败；
+--       pending -> rejected 已驳回；paid -> reversed 到账后被退回
+-- 申请时可用余额转入冻结金额，到账时冻结金额转出，驳回、失败及退回时资金回到可用余额（见 mer_merchant_ledger）
+
+CREATE TABLE IF NOT EXISTS mer_merchant_withdrawal (
+    withdrawal_id INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    payout_no CHAR(32) NOT NULL COMMENT '出款单号，出款渠道按该单号幂等',
+    mer_id INT UNSIGNED NOT NULL COMMENT '商户ID',
+    amount DECIMAL(12,2) UNSIGNED NOT NULL COMMENT '提现金额',
+    method VARCHAR(16) NOT NULL COMMENT '收款方式 bank银行卡 wallet钱包',
+    bank_name VARCHAR(255) NOT NULL DEFAULT '' COMMENT '银行名称(申请时快照)',
+    bank_code VARCHAR(255) NOT NULL DEFAULT '' COMMENT '银行卡转账信息(申请时快照)',
+    wallet_address VARCHAR(255) NOT NULL DEFAULT '' COMMENT '钱包地址(申请时快照)',
+    status VARCHAR(16) NOT NULL DEFAULT 'pending' COMMENT '状态 pending待审核 approved已审核 paid已到账 failed失败 rejected已驳回 reversed已退回',
+    provider VARCHAR(32) NOT NULL DEFAULT '' COMMENT '出款渠道',
+    provider_ref VARCHAR(64) NOT NULL DEFAULT '' COMMENT '渠道流水号',
+    fail_reason VARCHAR(255) NOT NULL DEFAULT '' COMMENT '失败或退回原因',
+    remark VARCHAR(255) NOT NULL DEFAULT '' COMMENT '申请备注',
+    review_remark VARCHAR(255) NOT NULL DEFAULT '' COMMENT '审核意见',
+    requested_by INT UNSIGNED NOT NULL DEFAULT 0 COMMENT '申请管理员ID',
+    reviewed_by INT UNSIGNED NOT NULL DEFAULT 0 COMMENT '审核人ID(平台运营)',
+    review_at DATETIME NULL COMMENT '审核时间',
+    paid_at DATETIME NULL COMMENT '到账时间',
+    create_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '申请时间',
+    update_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '更新时间',
+    UNIQUE INDEX payout_no (payout_no),
+    INDEX mer_id_status (mer_id, status),
+    INDEX status (status)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='商户提现表';
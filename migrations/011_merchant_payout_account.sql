@@ -0,0 +1,51 @@
+-- 商户收款账户
+-- 替代 mer_merchant 中自由填写的 bank_name / bank_code / wallet_address：
+-- 银行账户保存开户名、银行、SWIFT/BIC 及账号（IBAN 校验 mod-97，银行卡号校验 Luhn），
+-- 钱包保存链及地址（EVM 链校验 EIP-55，TRON 校验 Base58Check）。
+-- 账号和地址在接口响应及操作日志中脱敏，新增、修改、删除需要二次验证
+
+CREATE TABLE IF NOT EXISTS mer_merchant_payout_account (
+    account_id INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    mer_id INT UNSIGNED NOT NULL COMMENT '商户ID',
+    account_type VARCHAR(16) NOT NULL COMMENT '账户类型 bank银行账户 wallet钱包',
+    holder_name VARCHAR(64) NOT NULL DEFAULT '' COMMENT '开户名',
+    bank_name VARCHAR(64) NOT NULL DEFAULT '' COMMENT '银行名称',
+    bank_code VARCHAR(16) NOT NULL DEFAULT '' COMMENT 'SWIFT/BIC',
+    account_no VARCHAR(64) NOT NULL DEFAULT '' COMMENT '银行账号或IBAN',
+    chain VARCHAR(16) NOT NULL DEFAULT '' COMMENT '链 ethereum bsc polygon tron',
+    wallet_address VARCHAR(64) NOT NULL DEFAULT '' COMMENT '钱包地址',
+    created_by INT UNSIGNED NOT NULL DEFAULT 0 COMMENT '创建管理员ID',
+    create_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    update_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX mer_id_type (mer_id, account_type)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='商户收款账户表';
+
+-- 迁移原收款信息（原数据未经校验，迁移后请商户核对）
+INSERT INTO mer_merchant_payout_account (mer_id, account_type, holder_name, bank_name, account_no)
+SELECT mer_id, 'bank', real_name, IFNULL(bank_name, ''), LEFT(REPLACE(bank_code, ' ', ''), 64)
+FROM mer_merchant
+WHERE IFNULL(bank_code, '') <> '';
+
+INSERT INTO mer_merchant_payout_account (mer_id, account_type, chain, wallet_address)
+SELECT mer_id, 'wallet', IF(wallet_address LIKE 'T%', 'tron', 'ethereum'), LEFT(TRIM(wallet_address), 64)
+FROM mer_merchant
+WHERE IFNULL(wallet_address, '') <> '';
+
+ALTER TABLE mer_merchant
+    DROP COLUMN bank_name,
+    DROP COLUMN bank_code,
+    DROP COLUMN wallet_address;
+
+-- 提现快照改为结构化字段，bank_code 改为保存 SWIFT/BIC
+ALTER TABLE mer_merchant_withdrawal
+    ADD COLUMN account_id INT UNSIGNED NOT NULL DEFAULT 0 COMMENT '收款账户ID' AFTER method,
+    ADD COLUMN holder_name VARCHAR(64) NOT NULL DEFAULT '' COMMENT '开户名(申请时快照)' AFTER account_id,
+    ADD COLUMN account_no VARCHAR(64) NOT NULL DEFAULT '' COMMENT '银行账号或IBAN(申请时快照)' AFTER bank_code,
+    ADD COLUMN chain VARCHAR(16) NOT NULL DEFAULT '' COMMENT '链(申请时快照)' AFTER account_no;
+
+UPDATE mer_merchant_withdrawal SET account_no = LEFT(bank_code, 64), bank_code = '' WHERE method = 'bank';
+
+ALTER TABLE mer_merchant_withdrawal
+    MODIFY COLUMN bank_name VARCHAR(64) NOT NULL DEFAULT '' COMMENT '银行名称(申请时快照)',
+    MODIFY COLUMN bank_code VARCHAR(16) NOT NULL DEFAULT '' COMMENT 'SWIFT/BIC(申请时快照)',
+    MODIFY COLUMN wallet_address VARCHAR(64) NOT NULL DEFAULT '' COMMENT '钱包地址(申请时快照)';
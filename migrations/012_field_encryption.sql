@@ -0,0 +1,27 @@
+-- 敏感字段加密
+-- 姓名、手机号、收款账号等字段改为保存密文（enc:v<版本>:<base64>，见 internal/pkg/fieldcrypt），加长字段长度；
+-- 手机号增加盲索引字段 HMAC-SHA256(盲索引密钥, 手机号)，用于登录和唯一性校验。
+-- 执行后立即运行 go run ./cmd/reencrypt 加密已有数据并计算盲索引，完成前已有管理员不能使用手机号登录
+
+ALTER TABLE mer_merchant
+    MODIFY COLUMN real_name VARCHAR(255) NOT NULL COMMENT '商户姓名(加密)',
+    MODIFY COLUMN mer_phone VARCHAR(255) NOT NULL COMMENT '商户手机号(加密)',
+    ADD COLUMN mer_phone_hash CHAR(64) NOT NULL DEFAULT '' COMMENT '商户手机号盲索引' AFTER mer_phone,
+    ADD INDEX mer_phone_hash (mer_phone_hash);
+
+ALTER TABLE mer_merchant_admin
+    MODIFY COLUMN real_name VARCHAR(255) NOT NULL COMMENT '商户管理员姓名(加密)',
+    MODIFY COLUMN phone VARCHAR(255) NULL COMMENT '商户管理员手机号(加密)',
+    ADD COLUMN phone_hash CHAR(64) NULL COMMENT '商户管理员手机号盲索引' AFTER phone,
+    DROP INDEX phone,
+    ADD INDEX phone_hash (phone_hash);
+
+ALTER TABLE mer_merchant_payout_account
+    MODIFY COLUMN holder_name VARCHAR(512) NOT NULL DEFAULT '' COMMENT '开户名(加密)',
+    MODIFY COLUMN account_no VARCHAR(255) NOT NULL DEFAULT '' COMMENT '银行账号或IBAN(加密)',
+    MODIFY COLUMN wallet_address VARCHAR(255) NOT NULL DEFAULT '' COMMENT '钱包地址(加密)';
+
+ALTER TABLE mer_merchant_withdrawal
+    MODIFY COLUMN holder_name VARCHAR(512) NOT NULL DEFAULT '' COMMENT '开户名(申请时快照，加密)',
+    MODIFY COLUMN account_no VARCHAR(255) NOT NULL DEFAULT '' COMMENT '银行账号或IBAN(申请时快照，加密)',
+    MODIFY COLUMN wallet_address VARCHAR(255) NOT NULL DEFAULT '' COMMENT '钱包地址(申请时快照，加密)';
@@ -0,0 +1,25 @@
+-- 平台运营
+-- 平台运营账号与商户管理员相互独立，在 /mer_admin/platform 下登录和操作：
+-- 创建及审核商户、分配商户分类、锁定/解锁商户、创建商户主账号（level 0）、维护商户分类、审核提现。
+-- 第一个运营账号使用 go run ./cmd/operator -account <账号> -name <姓名> 创建
+
+CREATE TABLE IF NOT EXISTS plat_operator (
+    operator_id INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    account VARCHAR(20) NOT NULL COMMENT '登录账号',
+    pwd CHAR(64) NOT NULL COMMENT '密码',
+    real_name VARCHAR(16) NOT NULL DEFAULT '' COMMENT '姓名',
+    status TINYINT UNSIGNED NOT NULL DEFAULT 1 COMMENT '状态 1正常 0禁用',
+    last_ip VARCHAR(45) NULL COMMENT '最后登录IP',
+    last_time DATETIME NULL COMMENT '最后登录时间',
+    created_by INT UNSIGNED NOT NULL DEFAULT 0 COMMENT '创建人ID，命令行创建为0',
+    create_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    update_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE INDEX account (account)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='平台运营账号表';
+
+-- 商户审核状态：平台创建的商户审核通过后才能登录，已有商户视为审核通过
+ALTER TABLE mer_merchant
+    ADD COLUMN audit_status TINYINT UNSIGNED NOT NULL DEFAULT 1 COMMENT '审核状态 0待审核 1已通过 2已驳回' AFTER status,
+    ADD COLUMN audit_remark VARCHAR(255) NOT NULL DEFAULT '' COMMENT '审核意见' AFTER audit_status,
+    ADD COLUMN audit_by INT UNSIGNED NOT NULL DEFAULT 0 COMMENT '审核人ID(平台运营)' AFTER audit_remark,
+    ADD COLUMN audit_at DATETIME NULL COMMENT '审核时间' AFTER audit_by;
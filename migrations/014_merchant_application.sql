@@ -0,0 +1,48 @@
+-- 商户入驻申请
+-- 申请人无需登录，创建申请时返回一次访问令牌（数据库只保存 SHA-256），之后通过 X-Application-Token 查看和修改申请。
+-- 状态：draft 草稿 -> submitted 已提交 -> reviewing 审核中 -> approved 已通过 / rejected 已驳回；
+--       rejected 修改后可以重新提交（rejected -> submitted）
+-- 审核通过时在同一事务中创建商户（审核状态为已通过）及商户主账号（level 0）
+
+CREATE TABLE IF NOT EXISTS mer_merchant_application (
+    application_id INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    application_no CHAR(32) NOT NULL COMMENT '申请单号',
+    token_hash CHAR(64) NOT NULL COMMENT '申请人访问令牌 SHA-256',
+    status VARCHAR(16) NOT NULL DEFAULT 'draft' COMMENT '状态 draft草稿 submitted已提交 reviewing审核中 rejected已驳回 approved已通过',
+    mer_name VARCHAR(32) NOT NULL DEFAULT '' COMMENT '商户名称',
+    real_name VARCHAR(255) NOT NULL DEFAULT '' COMMENT '联系人姓名(加密)',
+    mer_phone VARCHAR(255) NOT NULL DEFAULT '' COMMENT '联系人手机号(加密)',
+    mer_phone_hash CHAR(64) NOT NULL DEFAULT '' COMMENT '联系人手机号盲索引',
+    category_ids JSON NOT NULL COMMENT '申请的商户分类ID',
+    mer_address VARCHAR(64) NOT NULL DEFAULT '' COMMENT '商户地址',
+    mer_info VARCHAR(256) NOT NULL DEFAULT '' COMMENT '店铺简介',
+    service_phone VARCHAR(13) NOT NULL DEFAULT '' COMMENT '店铺电话',
+    timezone VARCHAR(64) NOT NULL DEFAULT 'Asia/Shanghai' COMMENT '商户时区(IANA)',
+    documents JSON NOT NULL COMMENT '资质文件 [{"type":"business_license","path":"..."}]',
+    account VARCHAR(32) NOT NULL DEFAULT '' COMMENT '主账号登录名',
+    pwd CHAR(64) NOT NULL DEFAULT '' COMMENT '主账号密码',
+    review_remark VARCHAR(255) NOT NULL DEFAULT '' COMMENT '最近一次审核意见',
+    reviewed_by INT UNSIGNED NOT NULL DEFAULT 0 COMMENT '审核人ID(平台运营)',
+    mer_id INT UNSIGNED NOT NULL DEFAULT 0 COMMENT '审核通过后创建的商户ID',
+    submitted_at DATETIME NULL COMMENT '最近一次提交时间',
+    reviewed_at DATETIME NULL COMMENT '审核完成时间',
+    create_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    update_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE INDEX application_no (application_no),
+    UNIQUE INDEX token_hash (token_hash),
+    INDEX status (status),
+    INDEX mer_phone_hash (mer_phone_hash)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='商户入驻申请表';
+
+-- 申请的状态变更及审核意见，只追加
+CREATE TABLE IF NOT EXISTS mer_merchant_application_log (
+    log_id INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    application_id INT UNSIGNED NOT NULL COMMENT '申请ID',
+    from_status VARCHAR(16) NOT NULL DEFAULT '' COMMENT '变更前状态，创建时为空',
+    to_status VARCHAR(16) NOT NULL COMMENT '变更后状态，只添加审核意见时与变更前相同',
+    operator_type VARCHAR(16) NOT NULL COMMENT '操作人类型 applicant申请人 operator平台运营',
+    operator_id INT UNSIGNED NOT NULL DEFAULT 0 COMMENT '平台运营ID，申请人为0',
+    remark VARCHAR(255) NOT NULL DEFAULT '' COMMENT '审核意见',
+    create_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX application_id (application_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='商户入驻申请记录表';
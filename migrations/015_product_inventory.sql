@@ -0,0 +1,46 @@
+-- SKU 库存
+-- stock 为实际库存，reserved 为订单已预占、尚未扣减的数量，可售数量 = stock - reserved。
+-- stock 为 NULL 表示未录入库存，不限制销售；首次盘点调整后开始按库存控制。
+-- 下单时预占（reserve），支付后扣减（commit），取消或超时后释放（release）；SKU 行加锁后再计算，并发下不会超卖。
+-- 所有库存变动写入 mer_store_stock_movement；商品所有 SKU 都已录入库存且可售数量为 0 时自动标记售完，补货后恢复销售中。
+-- 已有 SKU 的库存为 NULL，商品的销售状态保持不变。
+
+ALTER TABLE mer_store_product_sku
+    ADD COLUMN stock INT UNSIGNED NULL DEFAULT NULL COMMENT '库存，NULL表示未录入库存，不限制销售' AFTER image,
+    ADD COLUMN reserved INT UNSIGNED NOT NULL DEFAULT 0 COMMENT '已预占数量' AFTER stock,
+    ADD COLUMN low_stock INT UNSIGNED NOT NULL DEFAULT 0 COMMENT '低库存预警值，0表示不预警' AFTER reserved;
+
+CREATE TABLE IF NOT EXISTS mer_store_stock_movement (
+    movement_id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    mer_id INT UNSIGNED NOT NULL COMMENT '商户ID',
+    product_id INT UNSIGNED NOT NULL COMMENT '商品ID',
+    product_sku_id INT NOT NULL COMMENT 'SKU ID',
+    type VARCHAR(16) NOT NULL COMMENT '类型 initial初始库存 adjust盘点调整 reserve预占 commit扣减 release释放',
+    stock_change INT NOT NULL DEFAULT 0 COMMENT '库存变动数量',
+    reserved_change INT NOT NULL DEFAULT 0 COMMENT '预占变动数量',
+    stock_after INT UNSIGNED NULL COMMENT '变动后库存，未录入库存时为NULL',
+    reserved_after INT UNSIGNED NOT NULL COMMENT '变动后预占数量',
+    order_no VARCHAR(64) NOT NULL DEFAULT '' COMMENT '关联订单号',
+    remark VARCHAR(255) NOT NULL DEFAULT '' COMMENT '备注',
+    created_by INT UNSIGNED NOT NULL DEFAULT 0 COMMENT '操作管理员ID，API 密钥及系统为0',
+    create_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX mer_id_create_at (mer_id, create_at),
+    INDEX product_sku_id (product_sku_id),
+    INDEX order_no (mer_id, order_no)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='SKU库存变动记录表';
+
+-- 订单预占，同一订单同一 SKU 只有一条，commit/release 按订单幂等
+CREATE TABLE IF NOT EXISTS mer_store_stock_reservation (
+    reservation_id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    mer_id INT UNSIGNED NOT NULL COMMENT '商户ID',
+    order_no VARCHAR(64) NOT NULL COMMENT '订单号',
+    product_id INT UNSIGNED NOT NULL COMMENT '商品ID',
+    product_sku_id INT NOT NULL COMMENT 'SKU ID',
+    quantity INT UNSIGNED NOT NULL COMMENT '预占数量',
+    status VARCHAR(16) NOT NULL DEFAULT 'reserved' COMMENT '状态 reserved已预占 committed已扣减 released已释放',
+    expire_at DATETIME NOT NULL COMMENT '预占过期时间，过期未扣减自动释放',
+    create_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    update_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE INDEX mer_id_order_sku (mer_id, order_no, product_sku_id),
+    INDEX status_expire_at (status, expire_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='SKU库存预占表';
@@ -0,0 +1,26 @@
+-- 商品规格
+-- 每个商品最多 3 个规格组（例如 颜色、尺码），每组若干规格值（红/蓝、S/M/L）；SKU 为各组规格值的笛卡尔积，每种组合恰好一个 SKU。
+-- SKU 的 spec_key 为按规格组顺序排列的规格值ID（例如 12,34），修改规格值名称不改变 spec_key，SKU 的库存、预占等数据保持不变。
+-- 没有规格的商品（包括迁移前的商品）spec_key 为 NULL，attr_name 仍为自由填写的属性。
+
+CREATE TABLE IF NOT EXISTS mer_store_product_spec (
+    spec_id INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    product_id INT UNSIGNED NOT NULL COMMENT '商品ID',
+    spec_name VARCHAR(32) NOT NULL COMMENT '规格名称',
+    sort INT NOT NULL DEFAULT 0 COMMENT '顺序，决定 spec_key 中规格值的顺序',
+    INDEX product_id (product_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='商品规格组表';
+
+CREATE TABLE IF NOT EXISTS mer_store_product_spec_value (
+    value_id INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    spec_id INT UNSIGNED NOT NULL COMMENT '规格组ID',
+    product_id INT UNSIGNED NOT NULL COMMENT '商品ID',
+    value_name VARCHAR(32) NOT NULL COMMENT '规格值',
+    sort INT NOT NULL DEFAULT 0 COMMENT '顺序',
+    INDEX product_id (product_id),
+    INDEX value_name (value_name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='商品规格值表';
+
+ALTER TABLE mer_store_product_sku
+    ADD COLUMN spec_key VARCHAR(64) NULL COMMENT '规格值ID组合，按规格组顺序以逗号分隔，没有规格时为NULL' AFTER attr_name,
+    ADD UNIQUE INDEX product_id_spec_key (product_id, spec_key);
@@ -0,0 +1,9 @@
+-- API 密钥改为加密保存签名密钥
+-- 之前只保存 SHA-256(secret) 并直接用它签名，读到数据表即可伪造请求；改为用字段加密（见 012_field_encryption.sql）保存 secret，签名直接使用 secret。
+-- 已有密钥只保存了 SHA-256，无法还原，迁移时全部吊销，需要在后台重新创建并更新对接系统的配置
+
+UPDATE mer_merchant_api_key SET revoke_at = NOW() WHERE revoke_at IS NULL;
+
+ALTER TABLE mer_merchant_api_key
+    ADD COLUMN secret VARCHAR(255) NOT NULL DEFAULT '' COMMENT '签名密钥(加密)' AFTER access_key,
+    DROP COLUMN secret_hash;
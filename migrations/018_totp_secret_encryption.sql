@@ -0,0 +1,6 @@
+-- 两步验证密钥加密保存
+-- TOTP 密钥改为使用字段加密（见 012_field_encryption.sql）保存，加长字段长度。
+-- 执行后立即运行 go run ./cmd/reencrypt 加密已有密钥，未加密的旧数据在此之前仍可正常验证
+
+ALTER TABLE mer_merchant_admin_totp
+    MODIFY COLUMN secret VARCHAR(255) NOT NULL COMMENT 'TOTP密钥(加密)';
@@ -7,16 +7,25 @@ import (
 )
 
 type Config struct {
-	Server   ServerConfig   `mapstructure:"server"`
-	Database DatabaseConfig `mapstructure:"database"`
-	Redis    RedisConfig    `mapstructure:"redis"`
-	JWT      JWTConfig      `mapstructure:"jwt"`
-	Logger   LoggerConfig   `mapstructure:"logger"`
+	Server        ServerConfig        `mapstructure:"server"`
+	Database      DatabaseConfig      `mapstructure:"database"`
+	Redis         RedisConfig         `mapstructure:"redis"`
+	JWT           JWTConfig           `mapstructure:"jwt"`
+	Logger        LoggerConfig        `mapstructure:"logger"`
+	Password      PasswordConfig      `mapstructure:"password"`
+	LoginSecurity LoginSecurityConfig `mapstructure:"login_security"`
+	TwoFactor     TwoFactorConfig     `mapstructure:"two_factor"`
+	Audit         AuditConfig         `mapstructure:"audit"`
+	Payout        PayoutConfig        `mapstructure:"payout"`
+	Crypto        CryptoConfig        `mapstructure:"crypto"`
+	Platform      PlatformConfig      `mapstructure:"platform"`
+	Inventory     InventoryConfig     `mapstructure:"inventory"`
 }
 
 type ServerConfig struct {
-	Admin AdminServerConfig `mapstructure:"admin"`
-	App   AppServerConfig   `mapstructure:"app"`
+	Admin          AdminServerConfig `mapstructure:"admin"`
+	App            AppServerConfig   `mapstructure:"app"`
+	TrustedProxies []string          `mapstructure:"trusted_proxies"` // 可信代理 IP/网段，只有来自这些地址的 X-Forwarded-For 才被信任
 }
 
 type AdminServerConfig struct {
@@ -56,9 +65,85 @@ type RedisConfig struct {
 }
 
 type JWTConfig struct {
-	Secret string `mapstructure:"secret"`
-	Expire int    `mapstructure:"expire"`
-	Issuer string `mapstructure:"issuer"`
+	Secret        string         `mapstructure:"secret"`         // 未配置 keys 时使用的 HS256 密钥（兼容旧配置）
+	Expire        int            `mapstructure:"expire"`         // 访问令牌有效期（秒）
+	RefreshExpire int            `mapstructure:"refresh_expire"` // 刷新令牌有效期（秒）
+	Issuer        string         `mapstructure:"issuer"`
+	Audience      string         `mapstructure:"audience"`
+	Keys          []JWTKeyConfig `mapstructure:"keys"` // 签名密钥，按 kid 区分，支持轮换
+}
+
+type JWTKeyConfig struct {
+	KID            string `mapstructure:"kid"`
+	Algorithm      string `mapstructure:"algorithm"`        // RS256 / EdDSA / HS256
+	PrivateKeyFile string `mapstructure:"private_key_file"` // PEM 私钥，RS256 / EdDSA
+	PublicKeyFile  string `mapstructure:"public_key_file"`  // PEM 公钥，只配置公钥时只用于验证
+	Secret         string `mapstructure:"secret"`           // HS256 密钥
+	ActiveFrom     string `mapstructure:"active_from"`      // 开始签名时间（RFC3339），为空表示立即
+	RetireAt       string `mapstructure:"retire_at"`        // 停止签名时间（RFC3339），之后在令牌最长有效期内仍可验证
+}
+
+type PasswordConfig struct {
+	MinLength       int  `mapstructure:"min_length"`        // 最小长度
+	RequireUpper    bool `mapstructure:"require_upper"`     // 必须包含大写字母
+	RequireLower    bool `mapstructure:"require_lower"`     // 必须包含小写字母
+	RequireDigit    bool `mapstructure:"require_digit"`     // 必须包含数字
+	RequireSymbol   bool `mapstructure:"require_symbol"`    // 必须包含特殊字符
+	HistoryCount    int  `mapstructure:"history_count"`     // 不能与最近 N 次密码相同，0 表示不限制
+	ResetCodeExpire int  `mapstructure:"reset_code_expire"` // 重置码有效期（秒）
+}
+
+type LoginSecurityConfig struct {
+	Window             int `mapstructure:"window"`               // 登录失败次数统计窗口（秒）
+	CaptchaThreshold   int `mapstructure:"captcha_threshold"`    // 窗口内失败达到该次数后需要验证码
+	AccountMaxFailures int `mapstructure:"account_max_failures"` // 同一账号窗口内失败达到该次数后锁定账号
+	IPMaxFailures      int `mapstructure:"ip_max_failures"`      // 同一 IP 窗口内失败达到该次数后锁定 IP
+	LockDuration       int `mapstructure:"lock_duration"`        // 锁定时长（秒）
+	CaptchaExpire      int `mapstructure:"captcha_expire"`       // 验证码有效期（秒）
+}
+
+type TwoFactorConfig struct {
+	Issuer          string `mapstructure:"issuer"`           // 身份验证器中显示的名称
+	ChallengeExpire int    `mapstructure:"challenge_expire"` // 登录第二步验证有效期（秒）
+}
+
+type AuditConfig struct {
+	RetentionDays   int `mapstructure:"retention_days"`   // 操作日志保留天数，0 表示不清理
+	CleanupInterval int `mapstructure:"cleanup_interval"` // 清理间隔（秒）
+}
+
+type PayoutConfig struct {
+	Provider  string            `mapstructure:"provider"`   // 出款渠道
+	MinAmount float64           `mapstructure:"min_amount"` // 单笔最低提现金额
+	Local     PayoutLocalConfig `mapstructure:"local"`
+}
+
+type PayoutLocalConfig struct {
+	CallbackSecret string `mapstructure:"callback_secret"` // 回调签名密钥
+	Result         string `mapstructure:"result"`          // 提交后直接返回的结果 paid / failed，为空表示处理中，等待回调
+}
+
+type CryptoConfig struct {
+	MasterKey     string            `mapstructure:"master_key"`      // 主密钥（base64，32 字节），用于加密数据密钥
+	MasterKeyFile string            `mapstructure:"master_key_file"` // 主密钥文件，配置后优先于 master_key
+	ActiveVersion int               `mapstructure:"active_version"`  // 加密新数据使用的数据密钥版本
+	DataKeys      []CryptoKeyConfig `mapstructure:"data_keys"`       // 数据密钥，旧版本保留到重新加密完成
+	BlindIndexKey string            `mapstructure:"blind_index_key"` // 盲索引密钥（主密钥加密）
+}
+
+type CryptoKeyConfig struct {
+	Version int    `mapstructure:"version"`
+	Key     string `mapstructure:"key"` // 主密钥加密后的数据密钥，使用 go run ./cmd/reencrypt -genkey 生成
+}
+
+type PlatformConfig struct {
+	TokenExpire int `mapstructure:"token_expire"` // 运营令牌有效期（秒），到期后需要重新登录
+	IdleTimeout int `mapstructure:"idle_timeout"` // 无操作超过该时间后令牌失效（秒）
+}
+
+type InventoryConfig struct {
+	ReservationTTL  int `mapstructure:"reservation_ttl"`  // 订单预占库存的默认有效期（秒），过期未扣减自动释放
+	ReleaseInterval int `mapstructure:"release_interval"` // 释放过期预占的检查间隔（秒）
 }
 
 type LoggerConfig struct {
@@ -74,6 +159,7 @@ var GlobalConfig *Config
 func LoadConfig(configPath string) (*Config, error) {
 	viper.SetConfigFile(configPath)
 	viper.SetConfigType("yaml")
+	setDefaults()
 
 	// 读取配置文件
 	if err := viper.ReadInConfig(); err != nil {
@@ -85,7 +171,47 @@ func LoadConfig(configPath string) (*Config, error) {
 	if err := viper.Unmarshal(&config); err != nil {
 		return nil, fmt.Errorf("解析配置文件失败: %w", err)
 	}
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
 
 	GlobalConfig = &config
 	return &config, nil
 }
+
+// setDefaults 有效期等配置的默认值，与 configs/config.yaml 相同。
+// 这些值用作 Redis 键的过期时间，为 0 时 Expire 会直接删除键
+func setDefaults() {
+	viper.SetDefault("jwt.expire", 1800)
+	viper.SetDefault("jwt.refresh_expire", 604800)
+	viper.SetDefault("password.reset_code_expire", 900)
+	viper.SetDefault("login_security.window", 900)
+	viper.SetDefault("login_security.captcha_threshold", 3)
+	viper.SetDefault("login_security.account_max_failures", 5)
+	viper.SetDefault("login_security.ip_max_failures", 20)
+	viper.SetDefault("login_security.lock_duration", 900)
+	viper.SetDefault("login_security.captcha_expire", 300)
+	viper.SetDefault("two_factor.challenge_expire", 300)
+}
+
+// validate 检查必须大于 0 的有效期配置
+func (c *Config) validate() error {
+	durations := []struct {
+		key   string
+		value int
+	}{
+		{"jwt.expire", c.JWT.Expire},
+		{"jwt.refresh_expire", c.JWT.RefreshExpire},
+		{"password.reset_code_expire", c.Password.ResetCodeExpire},
+		{"login_security.window", c.LoginSecurity.Window},
+		{"login_security.lock_duration", c.LoginSecurity.LockDuration},
+		{"login_security.captcha_expire", c.LoginSecurity.CaptchaExpire},
+		{"two_factor.challenge_expire", c.TwoFactor.ChallengeExpire},
+	}
+	for _, d := range durations {
+		if d.value <= 0 {
+			return fmt.Errorf("配置 %s 必须大于 0", d.key)
+		}
+	}
+	return nil
+}
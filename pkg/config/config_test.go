@@ -0,0 +1,43 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadConfigDefaults(t *testing.T) {
+	cfg, err := LoadConfig(writeConfig(t, "jwt:\n  issuer: merchant_api\n"))
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+	if cfg.LoginSecurity.Window != 900 {
+		t.Errorf("login_security.window = %d, want 900", cfg.LoginSecurity.Window)
+	}
+	if cfg.JWT.RefreshExpire != 604800 {
+		t.Errorf("jwt.refresh_expire = %d, want 604800", cfg.JWT.RefreshExpire)
+	}
+	if cfg.JWT.Issuer != "merchant_api" {
+		t.Errorf("jwt.issuer = %q, want merchant_api", cfg.JWT.Issuer)
+	}
+}
+
+func TestLoadConfigRejectsZeroDuration(t *testing.T) {
+	for _, content := range []string{
+		"login_security:\n  window: 0\n",
+		"jwt:\n  refresh_expire: -1\n",
+	} {
+		if _, err := LoadConfig(writeConfig(t, content)); err == nil {
+			t.Errorf("LoadConfig(%q) error = nil, want error", content)
+		}
+	}
+}